@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
 
@@ -27,6 +28,7 @@ import (
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	db "github.com/kubeflow/katib/pkg/db/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"k8s.io/klog"
 
 	"google.golang.org/grpc"
@@ -51,11 +53,42 @@ func (s *server) ReportObservationLog(ctx context.Context, in *api_pb.ReportObse
 	return &api_pb.ReportObservationLogReply{}, err
 }
 
-// Get all log of Observations for a Trial.
+// Stream logs of Observations for a Trial.
+// Every batch is stored as soon as it is received, so batches before an interrupted stream are kept.
+func (s *server) StreamObservationLog(stream api_pb.DBManager_StreamObservationLogServer) error {
+	var count int32
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&api_pb.StreamObservationLogReply{
+				MetricLogsCount: count,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		if in.ObservationLog == nil || len(in.ObservationLog.MetricLogs) == 0 {
+			continue
+		}
+		if err = dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog); err != nil {
+			return err
+		}
+		count += int32(len(in.ObservationLog.MetricLogs))
+	}
+}
+
+// Get log of Observations for a Trial.
+// The log can be paginated and downsampled, otherwise every log is returned in one reply.
 func (s *server) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime)
+	opts := &common.ObservationLogOptions{
+		PageSize:   in.PageSize,
+		PageToken:  in.PageToken,
+		Downsample: in.Downsample,
+	}
+	ol, nextPageToken, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime, opts)
 	return &api_pb.GetObservationLogReply{
 		ObservationLog: ol,
+		NextPageToken:  nextPageToken,
 	}, err
 }
 
@@ -106,7 +139,12 @@ func main() {
 
 	size := 1<<31 - 1
 	klog.Infof("Start Katib manager: %s", port)
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size))
+	tlsOpts, err := grpctls.ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	opts := append([]grpc.ServerOption{grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size)}, tlsOpts...)
+	s := grpc.NewServer(opts...)
 	api_pb.RegisterDBManagerServer(s, &server{})
 	health_pb.RegisterHealthServer(s, &server{})
 	reflection.Register(s)
@@ -18,12 +18,15 @@ package main
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
 )
 
@@ -76,6 +79,78 @@ func TestReportObservationLog(t *testing.T) {
 	}
 }
 
+type fakeStreamObservationLogServer struct {
+	grpc.ServerStream
+	requests []*api_pb.StreamObservationLogRequest
+	reply    *api_pb.StreamObservationLogReply
+}
+
+func (f *fakeStreamObservationLogServer) Recv() (*api_pb.StreamObservationLogRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeStreamObservationLogServer) SendAndClose(reply *api_pb.StreamObservationLogReply) error {
+	f.reply = reply
+	return nil
+}
+
+func TestStreamObservationLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	batch := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2019-02-03T04:05:06+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+			{
+				TimeStamp: "2019-02-03T04:05:07+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.4",
+				},
+			},
+		},
+	}
+	stream := &fakeStreamObservationLogServer{
+		requests: []*api_pb.StreamObservationLogRequest{
+			{
+				TrialName:      "test1-trial1",
+				ObservationLog: batch,
+			},
+			{
+				TrialName:      "test1-trial1",
+				ObservationLog: &api_pb.ObservationLog{},
+			},
+			{
+				TrialName:      "test1-trial1",
+				ObservationLog: batch,
+			},
+		},
+	}
+	// Empty batches are not stored.
+	mockDB.EXPECT().RegisterObservationLog("test1-trial1", batch).Return(nil).Times(2)
+	err := s.StreamObservationLog(stream)
+	if err != nil {
+		t.Fatalf("StreamObservationLog Error %v", err)
+	}
+	if stream.reply == nil || stream.reply.MetricLogsCount != 4 {
+		t.Fatalf("StreamObservationLog Test fail expect 4 stored metric logs, got %v", stream.reply)
+	}
+}
+
 func TestGetObservationLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -87,6 +162,11 @@ func TestGetObservationLog(t *testing.T) {
 		TrialName: "test1-trial1",
 		StartTime: "2019-02-03T03:05:06+09:00",
 		EndTime:   "2019-02-03T05:05:06+09:00",
+		PageSize:  4,
+		Downsample: &api_pb.DownsampleSpec{
+			Type:     api_pb.DownsampleType_EVERY_NTH,
+			EveryNth: 2,
+		},
 	}
 
 	obs := &api_pb.ObservationLog{
@@ -122,7 +202,12 @@ func TestGetObservationLog(t *testing.T) {
 		},
 	}
 
-	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
+	opts := &common.ObservationLogOptions{
+		PageSize:   req.PageSize,
+		PageToken:  req.PageToken,
+		Downsample: req.Downsample,
+	}
+	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime, opts).Return(obs, "token", nil)
 	ret, err := s.GetObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("GetObservationLog Error %v", err)
@@ -130,6 +215,9 @@ func TestGetObservationLog(t *testing.T) {
 	if len(obs.MetricLogs) != len(ret.ObservationLog.MetricLogs) {
 		t.Fatalf("GetObservationLog Test fail expect metrics number %d got %d", len(obs.MetricLogs), len(ret.ObservationLog.MetricLogs))
 	}
+	if ret.NextPageToken != "token" {
+		t.Fatalf("GetObservationLog Test fail expect next page token %v got %v", "token", ret.NextPageToken)
+	}
 }
 
 func TestDeleteObservationLog(t *testing.T) {
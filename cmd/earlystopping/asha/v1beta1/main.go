@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	earlystopping "github.com/kubeflow/katib/pkg/earlystopping/v1beta1/asha"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+)
+
+const (
+	address = "0.0.0.0:6788"
+
+	namespaceFile    = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	defaultNamespace = "default"
+)
+
+type healthService struct {
+}
+
+func (s *healthService) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*health_pb.HealthCheckResponse, error) {
+	return &health_pb.HealthCheckResponse{
+		Status: health_pb.HealthCheckResponse_SERVING,
+	}, nil
+}
+
+func main() {
+	// Assume that Trial namespace = Suggestion namespace.
+	namespace := defaultNamespace
+	if ns, err := ioutil.ReadFile(namespaceFile); err == nil {
+		namespace = strings.TrimSpace(string(ns))
+	} else {
+		klog.Infof("Service is not running in Kubernetes Pod, %v namespace is used", defaultNamespace)
+	}
+
+	kclient, err := katibclient.NewClient(client.Options{})
+	if err != nil {
+		klog.Fatalf("Failed to create Katib client: %v", err)
+	}
+
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		klog.Fatalf("Failed to listen: %v", err)
+	}
+	tlsOpts, err := grpctls.ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	srv := grpc.NewServer(tlsOpts...)
+	api_v1_beta1.RegisterEarlyStoppingServer(srv, earlystopping.NewEarlyStoppingService(kclient.GetClient(), namespace))
+	health_pb.RegisterHealthServer(srv, &healthService{})
+
+	klog.Infof("Start ASHA service: %s", address)
+	err = srv.Serve(l)
+	if err != nil {
+		klog.Fatalf("Failed to serve: %v", err)
+	}
+}
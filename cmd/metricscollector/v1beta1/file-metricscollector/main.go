@@ -33,6 +33,7 @@ For example, the objective value name is F1 and the metrics are loss, your train
      F1=0.7
      ---
 The metrics collector will collect all logs of metrics.
+If the format is JSON, each line should be JSON object with metrics, e.g. {"step": 1, "loss": 0.8, "ts": "2021-01-01T00:00:00Z"}.
 */
 
 package main
@@ -40,8 +41,6 @@ package main
 import (
 	"context"
 	"flag"
-	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -57,59 +56,29 @@ import (
 	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
-type stopRulesFlag []commonv1beta1.EarlyStoppingRule
-
-func (flag *stopRulesFlag) String() string {
-	stopRuleStrings := []string{}
-	for _, r := range *flag {
-		stopRuleStrings = append(stopRuleStrings, r.Name)
-		stopRuleStrings = append(stopRuleStrings, r.Value)
-		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
-		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
-	}
-	return strings.Join(stopRuleStrings, ";")
-}
-
-func (flag *stopRulesFlag) Set(value string) error {
-	stopRuleParsed := strings.Split(value, ";")
-	if len(stopRuleParsed) != 4 {
-		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
-	}
-
-	// Get int start step.
-	startStep, err := strconv.Atoi(stopRuleParsed[3])
-	if err != nil {
-		klog.Fatalf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
-	}
-
-	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
-	// Start step is equal to 0, if it's not defined.
-	stopRule := commonv1beta1.EarlyStoppingRule{
-		Name:       stopRuleParsed[0],
-		Value:      stopRuleParsed[1],
-		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
-		StartStep:  startStep,
-	}
-
-	*flag = append(*flag, stopRule)
-	return nil
-}
-
 var (
 	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
 	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	earlyStopServiceTLS  = flag.Bool("s-earlystop-tls", false, "Whether Katib Early Stopping service serves gRPC with TLS")
 	trialName            = flag.String("t", "", "Trial Name")
 	metricsFilePath      = flag.String("path", "", "Metrics File Path")
 	metricNames          = flag.String("m", "", "Metric names")
 	objectiveType        = flag.String("o-type", "", "Objective type")
 	metricFilters        = flag.String("f", "", "Metric filters")
+	fileFormat           = flag.String("format", string(commonv1beta1.TextFormat), "Format of the metrics file lines, TEXT or JSON")
 	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
 	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
 	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
-	stopRules            stopRulesFlag
+	streamBatchSize      = flag.Int("stream-batch-size", common.DefaultStreamBatchSize, "Number of metric logs streamed to DB Manager in one batch, 0 disables streaming")
+	streamFlushInterval  = flag.Duration("stream-flush-interval", common.DefaultStreamFlushInterval, "Interval to stream pending metric logs to DB Manager")
+	stopRulesRefresh     = flag.Duration("stop-rules-refresh-interval", common.DefaultStopRulesRefreshInterval, "Interval to refresh the early stopping rules from Early Stopping service, 0 disables refresh")
+	stopRules            common.StopRulesFlag
 	isEarlyStopped       = false
+	// streamer reports metric logs while the training is running, it is nil if streaming is disabled.
+	streamer *common.ObservationLogStreamer
 )
 
 func checkMetricFile(mFile string) {
@@ -125,7 +94,7 @@ func checkMetricFile(mFile string) {
 	}
 }
 
-func printMetricsFile(mFile string) {
+func printMetricsFile(mFile string, filters []string) {
 
 	// Check that metric file exists.
 	checkMetricFile(mFile)
@@ -134,27 +103,31 @@ func printMetricsFile(mFile string) {
 	t, _ := tail.TailFile(mFile, tail.Config{Follow: true})
 	for line := range t.Lines {
 		klog.Info(line.Text)
+		streamMetrics(line.Text, filters)
 	}
 }
 
-func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string) {
-
-	// metricStartStep is the dict where key = metric name, value = start step.
-	// We should apply early stopping rule only if metric is reported at least "start_step" times.
-	metricStartStep := make(map[string]int)
-	for _, stopRule := range stopRules {
-		if stopRule.StartStep != 0 {
-			metricStartStep[stopRule.Name] = stopRule.StartStep
-		}
+// streamMetrics sends the metric logs of the log line to DB Manager, if streaming is enabled.
+func streamMetrics(logText string, filters []string) {
+	if streamer == nil || len(*metricNames) == 0 {
+		return
 	}
+	metricList := strings.Split(*metricNames, ";")
+	mlogs := filemc.ParseMetricLogsWithFormat([]string{logText}, metricList, filemc.GetFilterRegexpList(filters), commonv1beta1.FileFormat(*fileFormat))
+	if len(mlogs) != 0 {
+		streamer.Add(mlogs)
+	}
+}
+
+func watchMetricsFile(mFile string, stopRules common.StopRulesFlag, filters []string) {
 
 	// First metric is objective in metricNames array.
 	objMetric := strings.Split(*metricNames, ";")[0]
 	objType := commonv1beta1.ObjectiveType(*objectiveType)
-	// For objective metric we calculate best optimal value from the recorded metrics.
-	// This is workaround for Median Stop algorithm.
-	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
-	var optimalObjValue *float64
+	checker, err := common.NewStopRulesChecker(stopRules, objMetric, objType)
+	if err != nil {
+		klog.Fatalf("Invalid Early Stopping rules: %v", err)
+	}
 
 	// Check that metric file exists.
 	checkMetricFile(mFile)
@@ -172,168 +145,87 @@ func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string) {
 	// Get list of regural expressions from filters.
 	metricRegList := filemc.GetFilterRegexpList(filters)
 
+	// Early stopping rules are refreshed during training, since the Early Stopping service
+	// updates the rules once more Trials are completed.
+	var refreshC <-chan time.Time
+	if *earlyStopServiceAddr != "" && *stopRulesRefresh > 0 {
+		refreshTicker := time.NewTicker(*stopRulesRefresh)
+		defer refreshTicker.Stop()
+		refreshC = refreshTicker.C
+	}
+
 	// Start watch log lines.
 	t, _ := tail.TailFile(mFile, tail.Config{Follow: true})
-	for line := range t.Lines {
+	for {
+		var line *tail.Line
+		select {
+		case <-refreshC:
+			refreshStopRules(checker)
+			continue
+		case line = <-t.Lines:
+		}
+		if line == nil {
+			return
+		}
 		logText := line.Text
 		// Print log line
 		klog.Info(logText)
+		streamMetrics(logText, filters)
 
-		// Check if log line contains metric from stop rules.
-		isRuleLine := false
-		for _, rule := range stopRules {
-			if strings.Contains(logText, rule.Name) {
-				isRuleLine = true
-				break
-			}
-		}
-		// If log line doesn't contain appropriate metric, continue track file.
-		if !isRuleLine {
-			continue
-		}
-
-		// If log line contains appropriate metric, find all submatches from metric filters.
-		for _, metricReg := range metricRegList {
-			matchStrings := metricReg.FindAllStringSubmatch(logText, -1)
-			for _, subMatchList := range matchStrings {
-				if len(subMatchList) < 3 {
-					continue
-				}
-				// Submatch must have metric name and float value
-				metricName := strings.TrimSpace(subMatchList[1])
-				metricValue, err := strconv.ParseFloat(strings.TrimSpace(subMatchList[2]), 64)
-				if err != nil {
-					klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, metricName)
-				}
-
-				// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-				// After rule is reached we delete appropriate element from the array.
-				for idx, rule := range stopRules {
-					if metricName != rule.Name {
-						continue
-					}
-
-					// Calculate optimalObjValue.
-					if metricName == objMetric {
-						if optimalObjValue == nil {
-							optimalObjValue = &metricValue
-						} else if objType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *optimalObjValue {
-							optimalObjValue = &metricValue
-						} else if objType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *optimalObjValue {
-							optimalObjValue = &metricValue
-						}
-						// Assign best optimal value to metric value.
-						metricValue = *optimalObjValue
-					}
-
-					// Reduce steps if appropriate metric is reported.
-					// Once rest steps are empty we apply early stopping rule.
-					if _, ok := metricStartStep[metricName]; ok {
-						metricStartStep[metricName]--
-						if metricStartStep[metricName] != 0 {
-							continue
-						}
-					}
-
-					ruleValue, err := strconv.ParseFloat(rule.Value, 64)
-					if err != nil {
-						klog.Fatalf("Unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
-					}
-
-					// Metric value can be equal, less or greater than stop rule.
-					// Deleting suitable stop rule from the array.
-					if rule.Comparison == commonv1beta1.ComparisonTypeEqual && metricValue == ruleValue {
-						stopRules = deleteStopRule(stopRules, idx)
-					} else if rule.Comparison == commonv1beta1.ComparisonTypeLess && metricValue < ruleValue {
-						stopRules = deleteStopRule(stopRules, idx)
-					} else if rule.Comparison == commonv1beta1.ComparisonTypeGreater && metricValue > ruleValue {
-						stopRules = deleteStopRule(stopRules, idx)
-					}
-				}
+		// Parse metrics of the stop rules from the log line.
+		// Line without appropriate metrics doesn't have metric logs.
+		for _, mlog := range filemc.ParseMetricLogsWithFormat([]string{logText}, checker.MetricNames(), metricRegList, commonv1beta1.FileFormat(*fileFormat)) {
+			// Metric must have name and float value
+			metricValue, err := strconv.ParseFloat(mlog.Metric.Value, 64)
+			if err != nil {
+				klog.Fatalf("Unable to parse value %v to float for metric %v", mlog.Metric.Value, mlog.Metric.Name)
 			}
+			checker.Check(mlog.Metric.Name, metricValue)
 		}
 
-		// If stopRules array is empty, Trial is early stopped.
-		if len(stopRules) == 0 {
+		// If all stop rules are reached, Trial is early stopped.
+		if checker.IsEarlyStopped() {
 			klog.Info("Training container is early stopped")
 			isEarlyStopped = true
 
-			// Create ".pid" file with "early-stopped" line.
-			// Which means that training is early stopped and Trial status is updated.
-			markFile := filepath.Join(filepath.Dir(mFile), fmt.Sprintf("%d.pid", mainProcPid))
-			_, err := os.Create(markFile)
-			if err != nil {
-				klog.Fatalf("Create mark file %v error: %v", markFile, err)
-			}
-
-			err = ioutil.WriteFile(markFile, []byte(common.TrainingEarlyStopped), 0)
-			if err != nil {
-				klog.Fatalf("Write to file %v error: %v", markFile, err)
-			}
-
-			// Get child proccess from main PID.
-			childProc, err := mainProc.Children()
-			if err != nil {
-				klog.Fatalf("Get children proceses for main PID: %v failed: %v", mainProcPid, err)
-			}
-
-			// TODO (andreyvelich): Currently support only single child process.
-			if len(childProc) != 1 {
-				klog.Fatalf("Multiple children processes are not supported. Children processes: %v", childProc)
-			}
-
-			// Terminate the child process.
-			err = childProc[0].Terminate()
-			if err != nil {
-				klog.Fatalf("Unable to terminate child process %v, error: %v", childProc[0], err)
+			if err = common.StopTraining(filepath.Dir(mFile), mainProc); err != nil {
+				klog.Fatalf("Failed to stop training: %v", err)
 			}
 
 			// Report metrics to DB.
 			reportMetrics(filters)
 
 			// Wait until main proccess is completed.
-			timeout := 60 * time.Second
-			endTime := time.Now().Add(timeout)
-			isProcRunning := true
-			for isProcRunning && time.Now().Before(endTime) {
-				isProcRunning, err = mainProc.IsRunning()
-				// Ignore "no such file error". It means that process is complete.
-				if err != nil && !os.IsNotExist(err) {
-					klog.Fatalf("Check process status for main PID: %v failed: %v", mainProcPid, err)
-				}
-			}
-
-			// Create connection and client for Early Stopping service.
-			conn, err := grpc.Dial(*earlyStopServiceAddr, grpc.WithInsecure())
-			if err != nil {
-				klog.Fatalf("Could not connect to Early Stopping service, error: %v", err)
-			}
-			defer conn.Close()
-			c := api.NewEarlyStoppingClient(conn)
-
-			setTrialStatusReq := &api.SetTrialStatusRequest{
-				TrialName: *trialName,
+			if err = common.WaitProcessCompleted(mainProc, 60*time.Second); err != nil {
+				klog.Fatal(err)
 			}
 
 			// Send request to change Trial status to early stopped.
-			_, err = c.SetTrialStatus(context.Background(), setTrialStatusReq)
-			if err != nil {
-				klog.Fatalf("Set Trial status error: %v", err)
+			if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *earlyStopServiceTLS, *trialName); err != nil {
+				klog.Fatal(err)
 			}
-
 			klog.Infof("Trial status is successfully updated")
-
+			return
 		}
 	}
 }
 
-func deleteStopRule(stopRules []commonv1beta1.EarlyStoppingRule, idx int) []commonv1beta1.EarlyStoppingRule {
-	if idx >= len(stopRules) {
-		klog.Fatalf("Index %v out of range stopRules: %v", idx, stopRules)
+// refreshStopRules gets the current rules of the Trial from the Early Stopping service.
+// Current rules are kept if the request fails.
+func refreshStopRules(checker *common.StopRulesChecker) {
+	rules, err := common.GetEarlyStoppingRules(*earlyStopServiceAddr, *earlyStopServiceTLS, *trialName)
+	if err != nil {
+		klog.Warningf("Failed to refresh Early Stopping rules: %v", err)
+		return
+	}
+	changed, err := checker.SetStopRules(rules)
+	if err != nil {
+		klog.Warningf("Invalid refreshed Early Stopping rules: %v", err)
+		return
+	}
+	if changed {
+		klog.Infof("Early Stopping rules are refreshed: %v", rules)
 	}
-	stopRules[idx] = stopRules[len(stopRules)-1]
-	stopRules[len(stopRules)-1] = commonv1beta1.EarlyStoppingRule{}
-	return stopRules[:len(stopRules)-1]
 }
 
 func main() {
@@ -346,11 +238,30 @@ func main() {
 		filters = strings.Split(*metricFilters, ";")
 	}
 
-	// If stop rule is set we need to parse metrics during run.
-	if len(stopRules) != 0 {
+	// Stream metrics during run, so that they are not lost if the training is interrupted.
+	if *streamBatchSize > 0 {
+		tlsOpt, err := grpctls.DialOption()
+		if err != nil {
+			klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+		}
+		conn, err := grpc.Dial(*dbManagerServiceAddr, tlsOpt)
+		if err != nil {
+			klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+		}
+		defer conn.Close()
+		streamer, err = common.NewObservationLogStreamer(api.NewDBManagerClient(conn), *trialName, *streamBatchSize, *streamFlushInterval)
+		if err != nil {
+			klog.Errorf("Metrics are reported after the training is completed: %v", err)
+			streamer = nil
+		}
+	}
+
+	// If stop rule or Early Stopping service is set we need to parse metrics during run.
+	// Trial without the rules at the start can get them when the rules are refreshed.
+	if len(stopRules) != 0 || *earlyStopServiceAddr != "" {
 		go watchMetricsFile(*metricsFilePath, stopRules, filters)
 	} else {
-		go printMetricsFile(*metricsFilePath)
+		go printMetricsFile(*metricsFilePath, filters)
 	}
 
 	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
@@ -373,7 +284,11 @@ func main() {
 
 func reportMetrics(filters []string) {
 
-	conn, err := grpc.Dial(*dbManagerServiceAddr, grpc.WithInsecure())
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	conn, err := grpc.Dial(*dbManagerServiceAddr, tlsOpt)
 	if err != nil {
 		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
 	}
@@ -384,10 +299,18 @@ func reportMetrics(filters []string) {
 	if len(*metricNames) != 0 {
 		metricList = strings.Split(*metricNames, ";")
 	}
-	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filters)
+	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filters, commonv1beta1.FileFormat(*fileFormat))
 	if err != nil {
 		klog.Fatalf("Failed to collect logs: %v", err)
 	}
+	if streamer != nil {
+		// Only metric logs which are not streamed yet are reported.
+		if err = streamer.Close(olog); err != nil {
+			klog.Fatalf("Failed to Report logs: %v", err)
+		}
+		klog.Infof("Metrics reported. :\n%v", olog)
+		return
+	}
 	reportreq := &api.ReportObservationLogRequest{
 		TrialName:      *trialName,
 		ObservationLog: olog,
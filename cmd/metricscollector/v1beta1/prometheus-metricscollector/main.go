@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Prometheus MetricsCollector scrapes metrics endpoint of the training container.
+Training code should expose metrics as gauges in Prometheus text format, e.g.
+     ---
+     # TYPE accuracy gauge
+     accuracy{phase="train"} 0.8
+     accuracy{phase="validation"} 0.7
+     ---
+Metric names in the Experiment may have label selectors, e.g. accuracy{phase="validation"}.
+The metrics collector reports each changed value of the gauges.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/process"
+	"google.golang.org/grpc"
+	"k8s.io/klog"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	prometheusmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	earlyStopServiceTLS  = flag.Bool("s-earlystop-tls", false, "Whether Katib Early Stopping service serves gRPC with TLS")
+	trialName            = flag.String("t", "", "Trial Name")
+	metricsDirPath       = flag.String("path", "", "Directory path to mark the completed training processes")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	scrapeURL            = flag.String("scrape-url", "", "URL of the training container metrics endpoint")
+	scrapeInterval       = flag.Duration("scrape-interval", prometheusmc.DefaultScrapeInterval, "Interval between the metrics endpoint scrapes")
+	scrapeTimeout        = flag.Duration("scrape-timeout", prometheusmc.DefaultScrapeTimeout, "Timeout of the metrics endpoint scrape")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	streamBatchSize      = flag.Int("stream-batch-size", common.DefaultStreamBatchSize, "Number of metric logs streamed to DB Manager in one batch, 0 disables streaming")
+	streamFlushInterval  = flag.Duration("stream-flush-interval", common.DefaultStreamFlushInterval, "Interval to stream pending metric logs to DB Manager")
+	stopRules            common.StopRulesFlag
+	isEarlyStopped       = false
+	// streamer reports metric logs while the training is running, it is nil if streaming is disabled.
+	streamer *common.ObservationLogStreamer
+
+	// mlogsMu guards mlogs, which contains all scraped metric logs.
+	mlogsMu sync.Mutex
+	mlogs   []*api.MetricLog
+)
+
+// scrapeMetrics scrapes the metrics endpoint until ctx is done or training is early stopped.
+func scrapeMetrics(ctx context.Context, scraper *prometheusmc.Scraper, checker *common.StopRulesChecker) {
+	var mainProc *psutil.Process
+	if checker != nil {
+		// Get Main proccess.
+		_, mainProcPid, err := common.GetMainProcesses(*metricsDirPath)
+		if err != nil {
+			klog.Fatalf("GetMainProcesses failed: %v", err)
+		}
+		mainProc, err = psutil.NewProcess(int32(mainProcPid))
+		if err != nil {
+			klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
+		}
+	}
+
+	ticker := time.NewTicker(*scrapeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Metrics endpoint is not available until the training code starts the server.
+		scraped, err := scraper.Scrape(ctx)
+		if err != nil {
+			klog.Infof("Failed to scrape metrics: %v", err)
+			continue
+		}
+		for _, mlog := range scraped {
+			klog.Infof("%v=%v", mlog.Metric.Name, mlog.Metric.Value)
+		}
+		mlogsMu.Lock()
+		mlogs = append(mlogs, scraped...)
+		mlogsMu.Unlock()
+		if streamer != nil && len(scraped) != 0 {
+			streamer.Add(scraped)
+		}
+
+		if checker == nil {
+			continue
+		}
+		for _, mlog := range scraped {
+			// Metric must have name and float value
+			metricValue, err := strconv.ParseFloat(mlog.Metric.Value, 64)
+			if err != nil {
+				klog.Fatalf("Unable to parse value %v to float for metric %v", mlog.Metric.Value, mlog.Metric.Name)
+			}
+			checker.Check(mlog.Metric.Name, metricValue)
+		}
+
+		// If all stop rules are reached, Trial is early stopped.
+		if checker.IsEarlyStopped() {
+			klog.Info("Training container is early stopped")
+			isEarlyStopped = true
+
+			if err = common.StopTraining(*metricsDirPath, mainProc); err != nil {
+				klog.Fatalf("Failed to stop training: %v", err)
+			}
+
+			// Report metrics to DB.
+			reportMetrics()
+
+			// Wait until main proccess is completed.
+			if err = common.WaitProcessCompleted(mainProc, 60*time.Second); err != nil {
+				klog.Fatal(err)
+			}
+
+			// Send request to change Trial status to early stopped.
+			if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *earlyStopServiceTLS, *trialName); err != nil {
+				klog.Fatal(err)
+			}
+			klog.Infof("Trial status is successfully updated")
+			return
+		}
+	}
+}
+
+func main() {
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+
+	metricList := strings.Split(*metricNames, ";")
+	selectors, err := prometheusmc.ParseMetricSelectors(metricList)
+	if err != nil {
+		klog.Fatalf("Invalid metric names: %v", err)
+	}
+
+	// If stop rule is set we need to check metrics during run.
+	var checker *common.StopRulesChecker
+	if len(stopRules) != 0 {
+		// First metric is objective in metricNames array.
+		checker, err = common.NewStopRulesChecker(stopRules, metricList[0], commonv1beta1.ObjectiveType(*objectiveType))
+		if err != nil {
+			klog.Fatalf("Invalid Early Stopping rules: %v", err)
+		}
+	}
+
+	// Stream metrics during run, so that they are not lost if the training is interrupted.
+	if *streamBatchSize > 0 {
+		tlsOpt, err := grpctls.DialOption()
+		if err != nil {
+			klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+		}
+		conn, err := grpc.Dial(*dbManagerServiceAddr, tlsOpt)
+		if err != nil {
+			klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+		}
+		defer conn.Close()
+		streamer, err = common.NewObservationLogStreamer(api.NewDBManagerClient(conn), *trialName, *streamBatchSize, *streamFlushInterval)
+		if err != nil {
+			klog.Errorf("Metrics are reported after the training is completed: %v", err)
+			streamer = nil
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scrapeDone := make(chan struct{})
+	go func() {
+		defer close(scrapeDone)
+		scrapeMetrics(ctx, prometheusmc.NewScraper(*scrapeURL, selectors, *scrapeTimeout), checker)
+	}()
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *metricsDirPath,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+	cancel()
+	<-scrapeDone
+
+	// If training was not early stopped, report the metrics.
+	if !isEarlyStopped {
+		reportMetrics()
+	}
+}
+
+func reportMetrics() {
+
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	conn, err := grpc.Dial(*dbManagerServiceAddr, tlsOpt)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewDBManagerClient(conn)
+	ctx := context.Background()
+
+	mlogsMu.Lock()
+	olog := prometheusmc.CollectObservationLog(mlogs, strings.Split(*metricNames, ";"))
+	mlogsMu.Unlock()
+	if streamer != nil {
+		// Only metric logs which are not streamed yet are reported.
+		if err = streamer.Close(olog); err != nil {
+			klog.Fatalf("Failed to Report logs: %v", err)
+		}
+		klog.Infof("Metrics reported. :\n%v", olog)
+		return
+	}
+	reportreq := &api.ReportObservationLogRequest{
+		TrialName:      *trialName,
+		ObservationLog: olog,
+	}
+	_, err = c.ReportObservationLog(ctx, reportreq)
+	if err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("Metrics reported. :\n%v", olog)
+}
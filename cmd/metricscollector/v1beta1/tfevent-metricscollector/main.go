@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+TF Event MetricsCollector collects metrics from the TensorFlow event files.
+It waits until the training processes are completed and reports scalar summaries
+of all event files in the directory, e.g. written by tf.summary.scalar.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"k8s.io/klog"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	tfeventmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/tfevent-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	metricsDirPath       = flag.String("path", commonv1beta1.DefaultTensorflowEventDirPath, "TensorFlow event files directory path")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	metricFilters        = flag.String("f", "", "Metric filters")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+)
+
+func main() {
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *metricsDirPath,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+
+	reportMetrics()
+}
+
+func reportMetrics() {
+
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	conn, err := grpc.Dial(*dbManagerServiceAddr, tlsOpt)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewDBManagerClient(conn)
+	ctx := context.Background()
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+	olog, err := tfeventmc.CollectObservationLog(*metricsDirPath, metricList)
+	if err != nil {
+		klog.Fatalf("Failed to collect logs: %v", err)
+	}
+	reportreq := &api.ReportObservationLogRequest{
+		TrialName:      *trialName,
+		ObservationLog: olog,
+	}
+	_, err = c.ReportObservationLog(ctx, reportreq)
+	if err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("In %v %v metrics are reported", *trialName, len(olog.MetricLogs))
+}
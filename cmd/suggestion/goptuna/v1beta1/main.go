@@ -18,11 +18,16 @@ package main
 
 import (
 	"context"
+	"flag"
 	"net"
+	"os"
+	"time"
 
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	suggestion "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"google.golang.org/grpc"
 	"k8s.io/klog"
 )
@@ -31,6 +36,12 @@ const (
 	address = "0.0.0.0:6789"
 )
 
+var (
+	shared           = flag.Bool("shared", false, "Serve many Experiments with a separate Goptuna study for each Experiment")
+	maxStudies       = flag.Int("max-studies", 1000, "Maximum number of the Goptuna studies in the shared service, 0 means no limit")
+	studyIdleTimeout = flag.Duration("study-idle-timeout", 24*time.Hour, "Idle time after which the Goptuna study is evicted from the shared service, 0 means no eviction")
+)
+
 type healthService struct {
 }
 
@@ -41,12 +52,30 @@ func (s *healthService) Check(ctx context.Context, in *health_pb.HealthCheckRequ
 }
 
 func main() {
+	flag.Parse()
 	l, err := net.Listen("tcp", address)
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
-	srv := grpc.NewServer()
-	api_v1_beta1.RegisterSuggestionServer(srv, suggestion.NewSuggestionService())
+	tlsOpts, err := grpctls.ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to load gRPC TLS configuration: %v", err)
+	}
+	srv := grpc.NewServer(tlsOpts...)
+
+	if *shared {
+		// Shared service is long-lived and keeps the studies of many Experiments in memory.
+		klog.Infof("Serve shared Goptuna studies, max studies: %d, idle timeout: %v", *maxStudies, *studyIdleTimeout)
+		api_v1_beta1.RegisterSuggestionServer(srv, suggestion.NewSharedSuggestionService(*maxStudies, *studyIdleTimeout))
+	} else {
+		// Suggestion volume is mounted if the Experiment can be resumed from the volume.
+		suggestionService := suggestion.NewSuggestionService()
+		if storageDir := os.Getenv(consts.SuggestionVolumeMountPathEnvName); storageDir != "" {
+			klog.Infof("Store Goptuna study in %s", storageDir)
+			suggestionService = suggestion.NewPersistentSuggestionService(storageDir)
+		}
+		api_v1_beta1.RegisterSuggestionServer(srv, suggestionService)
+	}
 	health_pb.RegisterHealthServer(srv, &healthService{})
 
 	klog.Infof("Start Goptuna suggestion service: %s", address)
@@ -110,6 +110,26 @@ type ObjectiveSpec struct {
 	// MetricStrategies defines various rules (min, max or latest) to extract metrics values.
 	// This field is allowed to missing, experiment defaulter (webhook) will fill it.
 	MetricStrategies []MetricStrategy `json:"metricStrategies,omitempty"`
+
+	// Objectives represents Experiment's objectives for multi-objective optimization.
+	// Each objective has its own metric and direction.
+	// The first objective is the primary one, experiment defaulter (webhook) sets Type and ObjectiveMetricName from it
+	// and adds metrics of the other objectives to AdditionalMetricNames.
+	Objectives []Objective `json:"objectives,omitempty"`
+}
+
+// Objective is one of the Experiment's objectives for multi-objective optimization.
+// +k8s:deepcopy-gen=true
+type Objective struct {
+	// Type for the objective optimization.
+	Type ObjectiveType `json:"type,omitempty"`
+
+	// Goal is the objective goal that should be reached.
+	// The goal of the Experiment is reached, when one Trial reaches the goals of all objectives.
+	Goal *float64 `json:"goal,omitempty"`
+
+	// ObjectiveMetricName represents metric to optimize.
+	ObjectiveMetricName string `json:"objectiveMetricName,omitempty"`
 }
 
 // ObjectiveType is the type of Experiment optimization, one of minimize or maximize.
@@ -121,6 +141,23 @@ const (
 	ObjectiveTypeMaximize ObjectiveType = "maximize"
 )
 
+// RetryPolicy describes how the failed trial run is retried.
+type RetryPolicy struct {
+	// Max number of times the failed trial run is created again.
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// List of retryable failure reasons.
+	// Failure is retryable if the reason or the message of the trial run failure condition contains one of them.
+	Reasons []string `json:"reasons,omitempty"`
+
+	// List of retryable exit codes.
+	// Failure is retryable if the terminated primary container of the trial run Pods has one of them.
+	// Only Pods owned by the trial run are checked, otherwise the exit code is taken from the message
+	// of the trial run failure condition, if it contains "exit code".
+	// If reasons and exit codes are omitted, all failures are retryable.
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+}
+
 type ParameterAssignment struct {
 	Name  string `json:"name,omitempty"`
 	Value string `json:"value,omitempty"`
@@ -188,10 +225,24 @@ const (
 	InvalidKind   FileSystemKind = "Invalid"
 )
 
+type FileFormat string
+
+const (
+	// TextFormat lines are parsed with the metrics format filters.
+	TextFormat FileFormat = "TEXT"
+	// JsonFormat lines are JSON objects, e.g. {"step": 10, "loss": 0.3, "timestamp": "2021-01-01T00:00:00Z"}.
+	// Nested keys are joined with ".", e.g. {"train": {"loss": 0.3}} reports the "train.loss" metric.
+	// Timestamp is taken from the "timestamp" or "ts" key, it can be RFC3339 string or Unix time in seconds.
+	JsonFormat FileFormat = "JSON"
+)
+
 // +k8s:deepcopy-gen=true
 type FileSystemPath struct {
 	Path string         `json:"path,omitempty"`
 	Kind FileSystemKind `json:"kind,omitempty"`
+	// Format of the metrics file lines, it is TEXT by default.
+	// Supported only for File metrics collector.
+	Format FileFormat `json:"format,omitempty"`
 }
 
 type CollectorKind string
@@ -230,6 +230,27 @@ func (in *MetricsCollectorSpec) DeepCopy() *MetricsCollectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Objective) DeepCopyInto(out *Objective) {
+	*out = *in
+	if in.Goal != nil {
+		in, out := &in.Goal, &out.Goal
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Objective.
+func (in *Objective) DeepCopy() *Objective {
+	if in == nil {
+		return nil
+	}
+	out := new(Objective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 	*out = *in
@@ -248,6 +269,13 @@ func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 		*out = make([]MetricStrategy, len(*in))
 		copy(*out, *in)
 	}
+	if in.Objectives != nil {
+		in, out := &in.Objectives, &out.Objectives
+		*out = make([]Objective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -298,6 +326,32 @@ func (in *ParameterAssignment) DeepCopy() *ParameterAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceSpec) DeepCopyInto(out *SourceSpec) {
 	*out = *in
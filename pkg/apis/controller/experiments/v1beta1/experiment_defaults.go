@@ -48,6 +48,24 @@ func (e *Experiment) setDefaultResumePolicy() {
 func (e *Experiment) setDefaultObjective() {
 	obj := e.Spec.Objective
 	if obj != nil {
+		// Primary objective and additional metrics are set from the list of objectives.
+		objectiveTypes := make(map[string]common.ObjectiveType)
+		if len(obj.Objectives) > 0 {
+			if obj.ObjectiveMetricName == "" {
+				obj.ObjectiveMetricName = obj.Objectives[0].ObjectiveMetricName
+			}
+			if obj.Type == "" {
+				obj.Type = obj.Objectives[0].Type
+			}
+			for _, objective := range obj.Objectives {
+				objectiveTypes[objective.ObjectiveMetricName] = objective.Type
+				if objective.ObjectiveMetricName == obj.ObjectiveMetricName || contains(obj.AdditionalMetricNames, objective.ObjectiveMetricName) {
+					continue
+				}
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, objective.ObjectiveMetricName)
+			}
+		}
+
 		if obj.MetricStrategies == nil {
 			obj.MetricStrategies = make([]common.MetricStrategy, 0)
 		}
@@ -75,11 +93,16 @@ func (e *Experiment) setDefaultObjective() {
 			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 		}
 
-		// Set default strategy of additional metrics to ExtractByLatest.
+		// Set default strategy of additional metrics according to ObjectiveType.
+		// Metrics of the other objectives use the type of their objective.
 		for _, metricName := range obj.AdditionalMetricNames {
 			if _, ok := metricsWithDefault[metricName]; !ok {
+				objectiveType, ok := objectiveTypes[metricName]
+				if !ok {
+					objectiveType = e.Spec.Objective.Type
+				}
 				var strategy common.MetricStrategy
-				switch e.Spec.Objective.Type {
+				switch objectiveType {
 				case common.ObjectiveTypeMinimize:
 					strategy = common.MetricStrategy{Name: metricName, Value: common.ExtractByMin}
 				case common.ObjectiveTypeMaximize:
@@ -93,6 +116,15 @@ func (e *Experiment) setDefaultObjective() {
 	}
 }
 
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Experiment) setDefaultTrialTemplate() {
 	t := e.Spec.TrialTemplate
 
@@ -158,6 +190,9 @@ func (e *Experiment) setDefaultMetricsCollector() {
 		if e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Path == "" {
 			e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Path = common.DefaultFilePath
 		}
+		if e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Format == "" {
+			e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Format = common.TextFormat
+		}
 	case common.TfEventCollector:
 		if e.Spec.MetricsCollectorSpec.Source == nil {
 			e.Spec.MetricsCollectorSpec.Source = &common.SourceSpec{}
@@ -28,6 +28,10 @@ type ExperimentSpec struct {
 	// List of hyperparameter configurations.
 	Parameters []ParameterSpec `json:"parameters,omitempty"`
 
+	// List of constraints on the hyperparameter assignments.
+	// Assignments, which don't satisfy the constraints, are rejected and new assignments are suggested.
+	Constraints []ParameterConstraint `json:"constraints,omitempty"`
+
 	// Describes the objective of the experiment.
 	Objective *common.ObjectiveSpec `json:"objective,omitempty"`
 
@@ -57,6 +61,42 @@ type ExperimentSpec struct {
 
 	// Describes resuming policy which usually take effect after experiment terminated.
 	ResumePolicy ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Describes the previous experiments, which trials are used to warm start the search.
+	WarmStart *WarmStartSpec `json:"warmStart,omitempty"`
+
+	// List of trials with the explicit parameter assignments, which are created before the suggested trials.
+	// New trials can be appended to the list when experiment is running.
+	EnqueuedTrials []EnqueuedTrial `json:"enqueuedTrials,omitempty"`
+
+	// Suspends the experiment. Suspended experiment doesn't create new trials and
+	// its suggestion deployment and service are deleted until the experiment is resumed.
+	// Only experiment with resumePolicy = FromVolume can be suspended, because the suggestion
+	// state is restored from the volume when experiment is resumed.
+	Suspended bool `json:"suspended,omitempty"`
+
+	// Describes what happens with the active trials when experiment is suspended.
+	// Defaults to Drain.
+	SuspendPolicy SuspendPolicyType `json:"suspendPolicy,omitempty"`
+
+	// Max duration of the experiment from its start time.
+	// New trials are not created and experiment is succeeded when duration is exceeded.
+	// Active trials are timed out with the observations collected so far.
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// Stops the experiment when the best objective value is not improved in the last completed trials.
+	StoppingPolicy *StoppingPolicy `json:"stoppingPolicy,omitempty"`
+}
+
+// StoppingPolicy describes when the experiment is succeeded because the best objective value has reached a plateau.
+type StoppingPolicy struct {
+	// Number of the last succeeded trials without improvement of the best objective value to stop the experiment.
+	// Only trials with the objective metric value are counted.
+	Patience int32 `json:"patience"`
+
+	// Min change of the best objective value, which is counted as improvement.
+	// Defaults to 0, so any improvement is counted.
+	Tolerance float64 `json:"tolerance,omitempty"`
 }
 
 // ExperimentStatus is the current status of an Experiment.
@@ -80,8 +120,13 @@ type ExperimentStatus struct {
 	Conditions []ExperimentCondition `json:"conditions,omitempty"`
 
 	// Current optimal trial parameters and observations.
+	// For multi-objective Experiment it is the Pareto optimal trial with the best value of the first objective.
 	CurrentOptimalTrial OptimalTrial `json:"currentOptimalTrial,omitempty"`
 
+	// Current Pareto optimal trials of multi-objective Experiment.
+	// Trial is Pareto optimal, if no other trial is better in one objective and not worse in all other objectives.
+	ParetoOptimalTrials []OptimalTrial `json:"paretoOptimalTrials,omitempty"`
+
 	// List of trial names which are running.
 	RunningTrialList []string `json:"runningTrialList,omitempty"`
 
@@ -100,6 +145,9 @@ type ExperimentStatus struct {
 	// List of trial names which have been early stopped.
 	EarlyStoppedTrialList []string `json:"earlyStoppedTrialList,omitempty"`
 
+	// List of trial names which have been timed out.
+	TimedOutTrialList []string `json:"timedOutTrialList,omitempty"`
+
 	// Trials is the total number of trials owned by the experiment.
 	Trials int32 `json:"trials,omitempty"`
 
@@ -120,6 +168,29 @@ type ExperimentStatus struct {
 
 	// How many trials are currently early stopped.
 	TrialsEarlyStopped int32 `json:"trialsEarlyStopped,omitempty"`
+
+	// How many trials have been timed out.
+	TrialsTimedOut int32 `json:"trialsTimedOut,omitempty"`
+
+	// History of the best objective value improvements in the trials completion order.
+	// It is set only if the stopping policy is specified.
+	ObjectiveImprovementHistory []ObjectiveImprovement `json:"objectiveImprovementHistory,omitempty"`
+
+	// How many trials have succeeded after the last improvement of the best objective value.
+	// It is set only if the stopping policy is specified.
+	TrialsWithoutImprovement int32 `json:"trialsWithoutImprovement,omitempty"`
+}
+
+// ObjectiveImprovement is the improvement of the best objective value by the trial.
+type ObjectiveImprovement struct {
+	// Name of the trial, which improved the best objective value.
+	TrialName string `json:"trialName"`
+
+	// New best objective value.
+	Value float64 `json:"value"`
+
+	// Number of the succeeded trials with the objective metric value, including this trial.
+	CompletedTrials int32 `json:"completedTrials"`
 }
 
 // OptimalTrial is the metrics and assignments of the best trial.
@@ -164,6 +235,7 @@ const (
 	ExperimentRestarting ExperimentConditionType = "Restarting"
 	ExperimentSucceeded  ExperimentConditionType = "Succeeded"
 	ExperimentFailed     ExperimentConditionType = "Failed"
+	ExperimentSuspended  ExperimentConditionType = "Suspended"
 )
 
 // ResumePolicyType describes how the experiment should be resumed.
@@ -183,10 +255,72 @@ const (
 	FromVolume ResumePolicyType = "FromVolume"
 )
 
+// SuspendPolicyType describes what happens with the active trials when experiment is suspended.
+// If none of the following policies is specified, the default one is Drain.
+type SuspendPolicyType string
+
+const (
+	// DrainTrials indicates that active trials are running until they are completed.
+	DrainTrials SuspendPolicyType = "Drain"
+	// DeleteTrials indicates that active trials are deleted.
+	DeleteTrials SuspendPolicyType = "Delete"
+)
+
 type ParameterSpec struct {
 	Name          string        `json:"name,omitempty"`
 	ParameterType ParameterType `json:"parameterType,omitempty"`
 	FeasibleSpace FeasibleSpace `json:"feasibleSpace,omitempty"`
+
+	// Condition makes the parameter active only for the given values of another parameter.
+	// Inactive parameters are not assigned in the Trial.
+	Condition *ParameterCondition `json:"condition,omitempty"`
+}
+
+// ParameterConstraint is the constraint on the parameter assignments, e.g. "batch_size * grad_accum <= 512".
+// Constraints, which reference inactive conditional parameters, are not checked.
+type ParameterConstraint struct {
+	// Name of the constraint, which is reported when assignments are rejected.
+	Name string `json:"name,omitempty"`
+
+	// Expression must be true for the feasible assignments.
+	Expression string `json:"expression,omitempty"`
+}
+
+// EnqueuedTrial is the trial with the explicit parameter assignments.
+type EnqueuedTrial struct {
+	// Assignments of the parameters from the search space. Inactive conditional parameters must be omitted.
+	// Values of the parameters with step must be on the step.
+	ParameterAssignments []common.ParameterAssignment `json:"parameterAssignments,omitempty"`
+}
+
+// WarmStartSpec is the specification of the warm start.
+// Succeeded trials of the experiments, which assignments are in the search space, are sent
+// to the suggestion service as completed trials.
+type WarmStartSpec struct {
+	// List of the previous experiments.
+	Experiments []ExperimentReference `json:"experiments,omitempty"`
+}
+
+// ExperimentReference is the reference to the experiment.
+type ExperimentReference struct {
+	// Name of the experiment.
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the experiment. It must be the namespace of this experiment, if it is set.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ParameterCondition describes when the conditional parameter is active.
+type ParameterCondition struct {
+	// Name of the categorical or discrete parameter, which this parameter depends on.
+	Parameter string `json:"parameter,omitempty"`
+
+	// Values of the parent parameter, for which this parameter is active.
+	Values []string `json:"values,omitempty"`
+
+	// InactiveValue replaces the parameter in the Trial template when the parameter is inactive.
+	// Empty string is used by default.
+	InactiveValue string `json:"inactiveValue,omitempty"`
 }
 
 type ParameterType string
@@ -204,8 +338,25 @@ type FeasibleSpace struct {
 	Min  string   `json:"min,omitempty"`
 	List []string `json:"list,omitempty"`
 	Step string   `json:"step,omitempty"`
+
+	// Distribution of the int or double parameter values, uniform by default.
+	// Values of the log-uniform parameter with step are rounded to the multiple of the step.
+	Distribution Distribution `json:"distribution,omitempty"`
+	// Mean of the normal distribution.
+	// Values of the normal parameter are sampled between min and max.
+	Mean string `json:"mean,omitempty"`
+	// Standard deviation of the normal distribution.
+	Std string `json:"std,omitempty"`
 }
 
+type Distribution string
+
+const (
+	DistributionUniform    Distribution = "uniform"
+	DistributionLogUniform Distribution = "logUniform"
+	DistributionNormal     Distribution = "normal"
+)
+
 // TrialTemplate describes structure of trial template
 type TrialTemplate struct {
 	// Retain indicates that trial resources must be not cleanup
@@ -233,6 +384,14 @@ type TrialTemplate struct {
 	// Condition must be in GJSON format, ref https://github.com/tidwall/gjson.
 	// For example for BatchJob: status.conditions.#(type=="Failed")#|#(status=="True")#
 	FailureCondition string `json:"failureCondition,omitempty"`
+
+	// Describes how the failed trial run is retried.
+	// Trial is failed only after retries are exhausted.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Max duration of each trial from its start time.
+	// Trial run is killed and trial is timed out when duration is exceeded.
+	MaxTrialDuration *metav1.Duration `json:"maxTrialDuration,omitempty"`
 }
 
 // TrialSource represent the source for trial template
@@ -268,6 +427,10 @@ type TrialParameterSpec struct {
 
 	// Reference to the parameter in search space
 	Reference string `json:"reference,omitempty"`
+
+	// Expression to derive the parameter value from the search space parameters, e.g. "round(0.1 * total_steps)".
+	// It is used instead of the Reference.
+	Expression string `json:"expression,omitempty"`
 }
 
 // +genclient
@@ -90,6 +90,10 @@ func (exp *Experiment) IsRestarting() bool {
 	return hasCondition(exp, ExperimentRestarting)
 }
 
+func (exp *Experiment) IsSuspended() bool {
+	return hasCondition(exp, ExperimentSuspended)
+}
+
 func (exp *Experiment) IsCompleted() bool {
 	return exp.IsSucceeded() || exp.IsFailed()
 }
@@ -162,3 +166,18 @@ func (exp *Experiment) MarkExperimentStatusFailed(reason, message string) {
 	}
 	exp.setCondition(ExperimentFailed, v1.ConditionTrue, reason, message)
 }
+
+// MarkExperimentStatusSuspended sets experiment Suspended status to true.
+// When experiment is suspended experiment Running status is false.
+func (exp *Experiment) MarkExperimentStatusSuspended(reason, message string) {
+	currentCond := getCondition(exp, ExperimentRunning)
+	if currentCond != nil {
+		exp.setCondition(ExperimentRunning, v1.ConditionFalse, currentCond.Reason, currentCond.Message)
+	}
+	exp.setCondition(ExperimentSuspended, v1.ConditionTrue, reason, message)
+}
+
+// MarkExperimentStatusResumed sets experiment Suspended status to false.
+func (exp *Experiment) MarkExperimentStatusResumed(reason, message string) {
+	exp.setCondition(ExperimentSuspended, v1.ConditionFalse, reason, message)
+}
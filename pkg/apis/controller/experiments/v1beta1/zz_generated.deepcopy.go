@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -41,6 +42,27 @@ func (in *ConfigMapSource) DeepCopy() *ConfigMapSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnqueuedTrial) DeepCopyInto(out *EnqueuedTrial) {
+	*out = *in
+	if in.ParameterAssignments != nil {
+		in, out := &in.ParameterAssignments, &out.ParameterAssignments
+		*out = make([]commonv1beta1.ParameterAssignment, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnqueuedTrial.
+func (in *EnqueuedTrial) DeepCopy() *EnqueuedTrial {
+	if in == nil {
+		return nil
+	}
+	out := new(EnqueuedTrial)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Experiment) DeepCopyInto(out *Experiment) {
 	*out = *in
@@ -120,6 +142,22 @@ func (in *ExperimentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentReference) DeepCopyInto(out *ExperimentReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentReference.
+func (in *ExperimentReference) DeepCopy() *ExperimentReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	*out = *in
@@ -130,6 +168,11 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]ParameterConstraint, len(*in))
+		copy(*out, *in)
+	}
 	if in.Objective != nil {
 		in, out := &in.Objective, &out.Objective
 		*out = new(commonv1beta1.ObjectiveSpec)
@@ -175,6 +218,28 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(NasConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmStart != nil {
+		in, out := &in.WarmStart, &out.WarmStart
+		*out = new(WarmStartSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnqueuedTrials != nil {
+		in, out := &in.EnqueuedTrials, &out.EnqueuedTrials
+		*out = make([]EnqueuedTrial, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StoppingPolicy != nil {
+		in, out := &in.StoppingPolicy, &out.StoppingPolicy
+		*out = new(StoppingPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -211,6 +276,13 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		}
 	}
 	in.CurrentOptimalTrial.DeepCopyInto(&out.CurrentOptimalTrial)
+	if in.ParetoOptimalTrials != nil {
+		in, out := &in.ParetoOptimalTrials, &out.ParetoOptimalTrials
+		*out = make([]OptimalTrial, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RunningTrialList != nil {
 		in, out := &in.RunningTrialList, &out.RunningTrialList
 		*out = make([]string, len(*in))
@@ -241,6 +313,16 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TimedOutTrialList != nil {
+		in, out := &in.TimedOutTrialList, &out.TimedOutTrialList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectiveImprovementHistory != nil {
+		in, out := &in.ObjectiveImprovementHistory, &out.ObjectiveImprovementHistory
+		*out = make([]ObjectiveImprovement, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -330,6 +412,22 @@ func (in *NasConfig) DeepCopy() *NasConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectiveImprovement) DeepCopyInto(out *ObjectiveImprovement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectiveImprovement.
+func (in *ObjectiveImprovement) DeepCopy() *ObjectiveImprovement {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectiveImprovement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -375,10 +473,52 @@ func (in *OptimalTrial) DeepCopy() *OptimalTrial {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterCondition) DeepCopyInto(out *ParameterCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterCondition.
+func (in *ParameterCondition) DeepCopy() *ParameterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterConstraint) DeepCopyInto(out *ParameterConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterConstraint.
+func (in *ParameterConstraint) DeepCopy() *ParameterConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterSpec) DeepCopyInto(out *ParameterSpec) {
 	*out = *in
 	in.FeasibleSpace.DeepCopyInto(&out.FeasibleSpace)
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(ParameterCondition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -392,6 +532,22 @@ func (in *ParameterSpec) DeepCopy() *ParameterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoppingPolicy) DeepCopyInto(out *StoppingPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoppingPolicy.
+func (in *StoppingPolicy) DeepCopy() *StoppingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StoppingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrialParameterSpec) DeepCopyInto(out *TrialParameterSpec) {
 	*out = *in
@@ -449,6 +605,16 @@ func (in *TrialTemplate) DeepCopyInto(out *TrialTemplate) {
 			(*out)[key] = val
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(commonv1beta1.RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxTrialDuration != nil {
+		in, out := &in.MaxTrialDuration, &out.MaxTrialDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -461,3 +627,24 @@ func (in *TrialTemplate) DeepCopy() *TrialTemplate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmStartSpec) DeepCopyInto(out *WarmStartSpec) {
+	*out = *in
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]ExperimentReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmStartSpec.
+func (in *WarmStartSpec) DeepCopy() *WarmStartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmStartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
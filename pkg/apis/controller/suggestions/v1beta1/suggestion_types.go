@@ -54,6 +54,10 @@ type SuggestionStatus struct {
 	// Suggestion results
 	Suggestions []TrialAssignment `json:"suggestions,omitempty"`
 
+	// Latest suggestion results, which are rejected by the Experiment constraints.
+	// Trials are not created for these assignments.
+	InfeasibleSuggestions []TrialAssignment `json:"infeasibleSuggestions,omitempty"`
+
 	// Represents time when the Suggestion was acknowledged by the Suggestion controller.
 	// It is not guaranteed to be set in happens-before order across separate operations.
 	// It is represented in RFC3339 form and is in UTC.
@@ -117,6 +121,8 @@ const (
 	SuggestionRunning         SuggestionConditionType = "Running"
 	SuggestionSucceeded       SuggestionConditionType = "Succeeded"
 	SuggestionFailed          SuggestionConditionType = "Failed"
+	// SuggestionExhausted means that the algorithm has suggested all assignments of the search space.
+	SuggestionExhausted SuggestionConditionType = "Exhausted"
 )
 
 // +genclient
@@ -24,6 +24,8 @@ import (
 const (
 	// SuggestionRestartReason is the reason for suggestion status when experiment is restarting
 	SuggestionRestartReason = "Experiment is restarting"
+	// SuggestionExhaustedReason is the reason for suggestion status when search space is exhausted
+	SuggestionExhaustedReason = "SuggestionExhausted"
 )
 
 func getCondition(suggestion *Suggestion, condType SuggestionConditionType) *SuggestionCondition {
@@ -94,6 +96,11 @@ func (suggestion *Suggestion) IsRestarting() bool {
 	return false
 }
 
+// IsExhausted returns true if suggestion service has suggested all assignments of the search space.
+func (suggestion *Suggestion) IsExhausted() bool {
+	return hasCondition(suggestion, SuggestionExhausted)
+}
+
 func (suggestion *Suggestion) IsDeploymentReady() bool {
 	return hasCondition(suggestion, SuggestionDeploymentReady)
 }
@@ -162,6 +169,12 @@ func (suggestion *Suggestion) MarkSuggestionStatusFailed(reason, message string)
 	suggestion.setCondition(SuggestionFailed, v1.ConditionTrue, reason, message)
 }
 
+// MarkSuggestionStatusExhausted sets suggestion Exhausted status to true.
+// Exhausted suggestion doesn't request new assignments from the suggestion service.
+func (suggestion *Suggestion) MarkSuggestionStatusExhausted(reason, message string) {
+	suggestion.setCondition(SuggestionExhausted, v1.ConditionTrue, reason, message)
+}
+
 func (suggestion *Suggestion) MarkSuggestionStatusDeploymentReady(status v1.ConditionStatus, reason, message string) {
 	suggestion.setCondition(SuggestionDeploymentReady, status, reason, message)
 }
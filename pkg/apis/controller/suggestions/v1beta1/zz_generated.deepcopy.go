@@ -145,6 +145,13 @@ func (in *SuggestionStatus) DeepCopyInto(out *SuggestionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InfeasibleSuggestions != nil {
+		in, out := &in.InfeasibleSuggestions, &out.InfeasibleSuggestions
+		*out = make([]TrialAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.StartTime != nil {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
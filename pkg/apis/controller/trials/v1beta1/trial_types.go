@@ -61,6 +61,13 @@ type TrialSpec struct {
 	// Condition must be in GJSON format, ref https://github.com/tidwall/gjson.
 	// For example for BatchJob: status.conditions.#(type=="Failed")#|#(status=="True")#
 	FailureCondition string `json:"failureCondition,omitempty"`
+
+	// Describes how the failed trial run is retried.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Max duration of the trial from its start time.
+	// Trial run is killed and trial is timed out when duration is exceeded.
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
 }
 
 // TrialStatus is the current status of a Trial.
@@ -85,6 +92,9 @@ type TrialStatus struct {
 
 	// Results of the Trial - objectives and other metrics values.
 	Observation *common.Observation `json:"observation,omitempty"`
+
+	// How many times the failed trial run has been created again.
+	Retries int32 `json:"retries,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -119,6 +129,7 @@ const (
 	TrialKilled       TrialConditionType = "Killed"
 	TrialFailed       TrialConditionType = "Failed"
 	TrialEarlyStopped TrialConditionType = "EarlyStopped"
+	TrialTimedOut     TrialConditionType = "TimedOut"
 )
 
 // +genclient
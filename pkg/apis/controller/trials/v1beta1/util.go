@@ -94,13 +94,17 @@ func (trial *Trial) IsMetricsUnavailable() bool {
 }
 
 func (trial *Trial) IsCompleted() bool {
-	return trial.IsSucceeded() || trial.IsFailed() || trial.IsKilled() || trial.IsEarlyStopped()
+	return trial.IsSucceeded() || trial.IsFailed() || trial.IsKilled() || trial.IsEarlyStopped() || trial.IsTimedOut()
 }
 
 func (trial *Trial) IsEarlyStopped() bool {
 	return hasCondition(trial, TrialEarlyStopped)
 }
 
+func (trial *Trial) IsTimedOut() bool {
+	return hasCondition(trial, TrialTimedOut)
+}
+
 func (trial *Trial) GetLastConditionType() (TrialConditionType, error) {
 	if len(trial.Status.Conditions) > 0 {
 		return trial.Status.Conditions[len(trial.Status.Conditions)-1].Type, nil
@@ -158,3 +162,15 @@ func (trial *Trial) MarkTrialStatusKilled(reason, message string) {
 	}
 	trial.setCondition(TrialKilled, v1.ConditionTrue, reason, message)
 }
+
+func (trial *Trial) MarkTrialStatusTimedOut(reason, message string) {
+	currentCond := getCondition(trial, TrialRunning)
+	if currentCond != nil {
+		trial.setCondition(TrialRunning, v1.ConditionFalse, currentCond.Reason, currentCond.Message)
+	}
+	trial.setCondition(TrialTimedOut, v1.ConditionTrue, reason, message)
+}
+
+func (trial *Trial) MarkTrialStatusEarlyStopped(reason, message string) {
+	trial.setCondition(TrialEarlyStopped, v1.ConditionTrue, reason, message)
+}
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -134,6 +135,16 @@ func (in *TrialSpec) DeepCopyInto(out *TrialSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(commonv1beta1.RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -11,8 +11,10 @@ It has these top-level messages:
 	Experiment
 	ExperimentSpec
 	ParameterSpec
+	ParameterCondition
 	FeasibleSpace
 	ObjectiveSpec
+	Objective
 	AlgorithmSpec
 	AlgorithmSetting
 	EarlyStoppingSpec
@@ -28,9 +30,12 @@ It has these top-level messages:
 	Metric
 	ReportObservationLogRequest
 	ReportObservationLogReply
+	StreamObservationLogRequest
+	StreamObservationLogReply
 	ObservationLog
 	MetricLog
 	GetObservationLogRequest
+	DownsampleSpec
 	GetObservationLogReply
 	DeleteObservationLogRequest
 	DeleteObservationLogReply
@@ -43,6 +48,8 @@ It has these top-level messages:
 	EarlyStoppingRule
 	SetTrialStatusRequest
 	SetTrialStatusReply
+	ValidateEarlyStoppingSettingsRequest
+	ValidateEarlyStoppingSettingsReply
 */
 package api_v1_beta1
 
@@ -98,6 +105,32 @@ func (x ParameterType) String() string {
 }
 func (ParameterType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// *
+// Distribution of the parameter values.
+type Distribution int32
+
+const (
+	Distribution_UNIFORM     Distribution = 0
+	Distribution_LOG_UNIFORM Distribution = 1
+	Distribution_NORMAL      Distribution = 2
+)
+
+var Distribution_name = map[int32]string{
+	0: "UNIFORM",
+	1: "LOG_UNIFORM",
+	2: "NORMAL",
+}
+var Distribution_value = map[string]int32{
+	"UNIFORM":     0,
+	"LOG_UNIFORM": 1,
+	"NORMAL":      2,
+}
+
+func (x Distribution) String() string {
+	return proto.EnumName(Distribution_name, int32(x))
+}
+func (Distribution) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 // *
 // Direction of optimization. Minimize or Maximize.
 type ObjectiveType int32
@@ -122,7 +155,39 @@ var ObjectiveType_value = map[string]int32{
 func (x ObjectiveType) String() string {
 	return proto.EnumName(ObjectiveType_name, int32(x))
 }
-func (ObjectiveType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (ObjectiveType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+// *
+// Downsampling method of metric logs.
+type DownsampleType int32
+
+const (
+	DownsampleType_NO_DOWNSAMPLE DownsampleType = 0
+	DownsampleType_EVERY_NTH     DownsampleType = 1
+	DownsampleType_BUCKET_MIN    DownsampleType = 2
+	DownsampleType_BUCKET_MAX    DownsampleType = 3
+	DownsampleType_BUCKET_MEAN   DownsampleType = 4
+)
+
+var DownsampleType_name = map[int32]string{
+	0: "NO_DOWNSAMPLE",
+	1: "EVERY_NTH",
+	2: "BUCKET_MIN",
+	3: "BUCKET_MAX",
+	4: "BUCKET_MEAN",
+}
+var DownsampleType_value = map[string]int32{
+	"NO_DOWNSAMPLE": 0,
+	"EVERY_NTH":     1,
+	"BUCKET_MIN":    2,
+	"BUCKET_MAX":    3,
+	"BUCKET_MEAN":   4,
+}
+
+func (x DownsampleType) String() string {
+	return proto.EnumName(DownsampleType_name, int32(x))
+}
+func (DownsampleType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type ComparisonType int32
 
@@ -149,7 +214,7 @@ var ComparisonType_value = map[string]int32{
 func (x ComparisonType) String() string {
 	return proto.EnumName(ComparisonType_name, int32(x))
 }
-func (ComparisonType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (ComparisonType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 // Trial can be in one of 6 conditions.
 // TODO (andreyvelich): Remove unused conditions.
@@ -188,7 +253,7 @@ func (x TrialStatus_TrialConditionType) String() string {
 	return proto.EnumName(TrialStatus_TrialConditionType_name, int32(x))
 }
 func (TrialStatus_TrialConditionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{15, 0}
+	return fileDescriptor0, []int{17, 0}
 }
 
 // *
@@ -309,9 +374,10 @@ func (m *ExperimentSpec_ParameterSpecs) GetParameters() []*ParameterSpec {
 // Config for a hyperparameter.
 // Katib will create each Hyper parameter from this config.
 type ParameterSpec struct {
-	Name          string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	ParameterType ParameterType  `protobuf:"varint,2,opt,name=parameter_type,json=parameterType,enum=api.v1.beta1.ParameterType" json:"parameter_type,omitempty"`
-	FeasibleSpace *FeasibleSpace `protobuf:"bytes,3,opt,name=feasible_space,json=feasibleSpace" json:"feasible_space,omitempty"`
+	Name          string              `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	ParameterType ParameterType       `protobuf:"varint,2,opt,name=parameter_type,json=parameterType,enum=api.v1.beta1.ParameterType" json:"parameter_type,omitempty"`
+	FeasibleSpace *FeasibleSpace      `protobuf:"bytes,3,opt,name=feasible_space,json=feasibleSpace" json:"feasible_space,omitempty"`
+	Condition     *ParameterCondition `protobuf:"bytes,4,opt,name=condition" json:"condition,omitempty"`
 }
 
 func (m *ParameterSpec) Reset()                    { *m = ParameterSpec{} }
@@ -340,21 +406,58 @@ func (m *ParameterSpec) GetFeasibleSpace() *FeasibleSpace {
 	return nil
 }
 
+func (m *ParameterSpec) GetCondition() *ParameterCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+// *
+// Condition of the conditional parameter.
+// The parameter is active only if the parent parameter has one of the values.
+type ParameterCondition struct {
+	Parameter string   `protobuf:"bytes,1,opt,name=parameter" json:"parameter,omitempty"`
+	Values    []string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *ParameterCondition) Reset()                    { *m = ParameterCondition{} }
+func (m *ParameterCondition) String() string            { return proto.CompactTextString(m) }
+func (*ParameterCondition) ProtoMessage()               {}
+func (*ParameterCondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ParameterCondition) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *ParameterCondition) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 // *
 // Feasible space for optimization.
 // Int and Double type use Max/Min.
 // Discrete and Categorical type use List.
 type FeasibleSpace struct {
-	Max  string   `protobuf:"bytes,1,opt,name=max" json:"max,omitempty"`
-	Min  string   `protobuf:"bytes,2,opt,name=min" json:"min,omitempty"`
-	List []string `protobuf:"bytes,3,rep,name=list" json:"list,omitempty"`
-	Step string   `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	Max          string       `protobuf:"bytes,1,opt,name=max" json:"max,omitempty"`
+	Min          string       `protobuf:"bytes,2,opt,name=min" json:"min,omitempty"`
+	List         []string     `protobuf:"bytes,3,rep,name=list" json:"list,omitempty"`
+	Step         string       `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	Distribution Distribution `protobuf:"varint,5,opt,name=distribution,enum=api.v1.beta1.Distribution" json:"distribution,omitempty"`
+	Mean         string       `protobuf:"bytes,6,opt,name=mean" json:"mean,omitempty"`
+	Std          string       `protobuf:"bytes,7,opt,name=std" json:"std,omitempty"`
 }
 
 func (m *FeasibleSpace) Reset()                    { *m = FeasibleSpace{} }
 func (m *FeasibleSpace) String() string            { return proto.CompactTextString(m) }
 func (*FeasibleSpace) ProtoMessage()               {}
-func (*FeasibleSpace) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*FeasibleSpace) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *FeasibleSpace) GetMax() string {
 	if m != nil {
@@ -384,6 +487,27 @@ func (m *FeasibleSpace) GetStep() string {
 	return ""
 }
 
+func (m *FeasibleSpace) GetDistribution() Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return Distribution_UNIFORM
+}
+
+func (m *FeasibleSpace) GetMean() string {
+	if m != nil {
+		return m.Mean
+	}
+	return ""
+}
+
+func (m *FeasibleSpace) GetStd() string {
+	if m != nil {
+		return m.Std
+	}
+	return ""
+}
+
 // *
 // Objective specification.
 type ObjectiveSpec struct {
@@ -393,12 +517,15 @@ type ObjectiveSpec struct {
 	// List of additional metrics to record from Trial.
 	// This can be empty if we only care about the objective metric.
 	AdditionalMetricNames []string `protobuf:"bytes,4,rep,name=additional_metric_names,json=additionalMetricNames" json:"additional_metric_names,omitempty"`
+	// List of objectives for multi-objective optimization.
+	// The first objective is the same as type and objective_metric_name.
+	Objectives []*Objective `protobuf:"bytes,5,rep,name=objectives" json:"objectives,omitempty"`
 }
 
 func (m *ObjectiveSpec) Reset()                    { *m = ObjectiveSpec{} }
 func (m *ObjectiveSpec) String() string            { return proto.CompactTextString(m) }
 func (*ObjectiveSpec) ProtoMessage()               {}
-func (*ObjectiveSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ObjectiveSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *ObjectiveSpec) GetType() ObjectiveType {
 	if m != nil {
@@ -428,6 +555,47 @@ func (m *ObjectiveSpec) GetAdditionalMetricNames() []string {
 	return nil
 }
 
+func (m *ObjectiveSpec) GetObjectives() []*Objective {
+	if m != nil {
+		return m.Objectives
+	}
+	return nil
+}
+
+// *
+// One of the objectives for multi-objective optimization.
+type Objective struct {
+	Type                ObjectiveType `protobuf:"varint,1,opt,name=type,enum=api.v1.beta1.ObjectiveType" json:"type,omitempty"`
+	Goal                float64       `protobuf:"fixed64,2,opt,name=goal" json:"goal,omitempty"`
+	ObjectiveMetricName string        `protobuf:"bytes,3,opt,name=objective_metric_name,json=objectiveMetricName" json:"objective_metric_name,omitempty"`
+}
+
+func (m *Objective) Reset()                    { *m = Objective{} }
+func (m *Objective) String() string            { return proto.CompactTextString(m) }
+func (*Objective) ProtoMessage()               {}
+func (*Objective) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Objective) GetType() ObjectiveType {
+	if m != nil {
+		return m.Type
+	}
+	return ObjectiveType_UNKNOWN
+}
+
+func (m *Objective) GetGoal() float64 {
+	if m != nil {
+		return m.Goal
+	}
+	return 0
+}
+
+func (m *Objective) GetObjectiveMetricName() string {
+	if m != nil {
+		return m.ObjectiveMetricName
+	}
+	return ""
+}
+
 // *
 // HP or NAS algorithm specification.
 type AlgorithmSpec struct {
@@ -438,7 +606,7 @@ type AlgorithmSpec struct {
 func (m *AlgorithmSpec) Reset()                    { *m = AlgorithmSpec{} }
 func (m *AlgorithmSpec) String() string            { return proto.CompactTextString(m) }
 func (*AlgorithmSpec) ProtoMessage()               {}
-func (*AlgorithmSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*AlgorithmSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *AlgorithmSpec) GetAlgorithmName() string {
 	if m != nil {
@@ -464,7 +632,7 @@ type AlgorithmSetting struct {
 func (m *AlgorithmSetting) Reset()                    { *m = AlgorithmSetting{} }
 func (m *AlgorithmSetting) String() string            { return proto.CompactTextString(m) }
 func (*AlgorithmSetting) ProtoMessage()               {}
-func (*AlgorithmSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*AlgorithmSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *AlgorithmSetting) GetName() string {
 	if m != nil {
@@ -490,7 +658,7 @@ type EarlyStoppingSpec struct {
 func (m *EarlyStoppingSpec) Reset()                    { *m = EarlyStoppingSpec{} }
 func (m *EarlyStoppingSpec) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingSpec) ProtoMessage()               {}
-func (*EarlyStoppingSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*EarlyStoppingSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *EarlyStoppingSpec) GetAlgorithmName() string {
 	if m != nil {
@@ -516,7 +684,7 @@ type EarlyStoppingSetting struct {
 func (m *EarlyStoppingSetting) Reset()                    { *m = EarlyStoppingSetting{} }
 func (m *EarlyStoppingSetting) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingSetting) ProtoMessage()               {}
-func (*EarlyStoppingSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*EarlyStoppingSetting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *EarlyStoppingSetting) GetName() string {
 	if m != nil {
@@ -542,7 +710,7 @@ type NasConfig struct {
 func (m *NasConfig) Reset()                    { *m = NasConfig{} }
 func (m *NasConfig) String() string            { return proto.CompactTextString(m) }
 func (*NasConfig) ProtoMessage()               {}
-func (*NasConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*NasConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *NasConfig) GetGraphConfig() *GraphConfig {
 	if m != nil {
@@ -565,7 +733,7 @@ type NasConfig_Operations struct {
 func (m *NasConfig_Operations) Reset()                    { *m = NasConfig_Operations{} }
 func (m *NasConfig_Operations) String() string            { return proto.CompactTextString(m) }
 func (*NasConfig_Operations) ProtoMessage()               {}
-func (*NasConfig_Operations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
+func (*NasConfig_Operations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 0} }
 
 func (m *NasConfig_Operations) GetOperation() []*Operation {
 	if m != nil {
@@ -585,7 +753,7 @@ type GraphConfig struct {
 func (m *GraphConfig) Reset()                    { *m = GraphConfig{} }
 func (m *GraphConfig) String() string            { return proto.CompactTextString(m) }
 func (*GraphConfig) ProtoMessage()               {}
-func (*GraphConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*GraphConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GraphConfig) GetNumLayers() int32 {
 	if m != nil {
@@ -618,7 +786,7 @@ type Operation struct {
 func (m *Operation) Reset()                    { *m = Operation{} }
 func (m *Operation) String() string            { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()               {}
-func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *Operation) GetOperationType() string {
 	if m != nil {
@@ -643,7 +811,7 @@ type Operation_ParameterSpecs struct {
 func (m *Operation_ParameterSpecs) Reset()                    { *m = Operation_ParameterSpecs{} }
 func (m *Operation_ParameterSpecs) String() string            { return proto.CompactTextString(m) }
 func (*Operation_ParameterSpecs) ProtoMessage()               {}
-func (*Operation_ParameterSpecs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11, 0} }
+func (*Operation_ParameterSpecs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13, 0} }
 
 func (m *Operation_ParameterSpecs) GetParameters() []*ParameterSpec {
 	if m != nil {
@@ -663,7 +831,7 @@ type Trial struct {
 func (m *Trial) Reset()                    { *m = Trial{} }
 func (m *Trial) String() string            { return proto.CompactTextString(m) }
 func (*Trial) ProtoMessage()               {}
-func (*Trial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*Trial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *Trial) GetName() string {
 	if m != nil {
@@ -696,7 +864,7 @@ type TrialSpec struct {
 func (m *TrialSpec) Reset()                    { *m = TrialSpec{} }
 func (m *TrialSpec) String() string            { return proto.CompactTextString(m) }
 func (*TrialSpec) ProtoMessage()               {}
-func (*TrialSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*TrialSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *TrialSpec) GetObjective() *ObjectiveSpec {
 	if m != nil {
@@ -722,7 +890,7 @@ func (m *TrialSpec_ParameterAssignments) Reset()         { *m = TrialSpec_Parame
 func (m *TrialSpec_ParameterAssignments) String() string { return proto.CompactTextString(m) }
 func (*TrialSpec_ParameterAssignments) ProtoMessage()    {}
 func (*TrialSpec_ParameterAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{13, 0}
+	return fileDescriptor0, []int{15, 0}
 }
 
 func (m *TrialSpec_ParameterAssignments) GetAssignments() []*ParameterAssignment {
@@ -740,7 +908,7 @@ type ParameterAssignment struct {
 func (m *ParameterAssignment) Reset()                    { *m = ParameterAssignment{} }
 func (m *ParameterAssignment) String() string            { return proto.CompactTextString(m) }
 func (*ParameterAssignment) ProtoMessage()               {}
-func (*ParameterAssignment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ParameterAssignment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *ParameterAssignment) GetName() string {
 	if m != nil {
@@ -768,7 +936,7 @@ type TrialStatus struct {
 func (m *TrialStatus) Reset()                    { *m = TrialStatus{} }
 func (m *TrialStatus) String() string            { return proto.CompactTextString(m) }
 func (*TrialStatus) ProtoMessage()               {}
-func (*TrialStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*TrialStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *TrialStatus) GetStartTime() string {
 	if m != nil {
@@ -805,7 +973,7 @@ type Observation struct {
 func (m *Observation) Reset()                    { *m = Observation{} }
 func (m *Observation) String() string            { return proto.CompactTextString(m) }
 func (*Observation) ProtoMessage()               {}
-func (*Observation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*Observation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *Observation) GetMetrics() []*Metric {
 	if m != nil {
@@ -822,7 +990,7 @@ type Metric struct {
 func (m *Metric) Reset()                    { *m = Metric{} }
 func (m *Metric) String() string            { return proto.CompactTextString(m) }
 func (*Metric) ProtoMessage()               {}
-func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*Metric) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Metric) GetName() string {
 	if m != nil {
@@ -846,7 +1014,7 @@ type ReportObservationLogRequest struct {
 func (m *ReportObservationLogRequest) Reset()                    { *m = ReportObservationLogRequest{} }
 func (m *ReportObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReportObservationLogRequest) ProtoMessage()               {}
-func (*ReportObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*ReportObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *ReportObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -868,7 +1036,47 @@ type ReportObservationLogReply struct {
 func (m *ReportObservationLogReply) Reset()                    { *m = ReportObservationLogReply{} }
 func (m *ReportObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*ReportObservationLogReply) ProtoMessage()               {}
-func (*ReportObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*ReportObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+type StreamObservationLogRequest struct {
+	TrialName      string          `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	ObservationLog *ObservationLog `protobuf:"bytes,2,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
+}
+
+func (m *StreamObservationLogRequest) Reset()                    { *m = StreamObservationLogRequest{} }
+func (m *StreamObservationLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamObservationLogRequest) ProtoMessage()               {}
+func (*StreamObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *StreamObservationLogRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *StreamObservationLogRequest) GetObservationLog() *ObservationLog {
+	if m != nil {
+		return m.ObservationLog
+	}
+	return nil
+}
+
+type StreamObservationLogReply struct {
+	MetricLogsCount int32 `protobuf:"varint,1,opt,name=metric_logs_count,json=metricLogsCount" json:"metric_logs_count,omitempty"`
+}
+
+func (m *StreamObservationLogReply) Reset()                    { *m = StreamObservationLogReply{} }
+func (m *StreamObservationLogReply) String() string            { return proto.CompactTextString(m) }
+func (*StreamObservationLogReply) ProtoMessage()               {}
+func (*StreamObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *StreamObservationLogReply) GetMetricLogsCount() int32 {
+	if m != nil {
+		return m.MetricLogsCount
+	}
+	return 0
+}
 
 type ObservationLog struct {
 	MetricLogs []*MetricLog `protobuf:"bytes,1,rep,name=metric_logs,json=metricLogs" json:"metric_logs,omitempty"`
@@ -877,7 +1085,7 @@ type ObservationLog struct {
 func (m *ObservationLog) Reset()                    { *m = ObservationLog{} }
 func (m *ObservationLog) String() string            { return proto.CompactTextString(m) }
 func (*ObservationLog) ProtoMessage()               {}
-func (*ObservationLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ObservationLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *ObservationLog) GetMetricLogs() []*MetricLog {
 	if m != nil {
@@ -894,7 +1102,7 @@ type MetricLog struct {
 func (m *MetricLog) Reset()                    { *m = MetricLog{} }
 func (m *MetricLog) String() string            { return proto.CompactTextString(m) }
 func (*MetricLog) ProtoMessage()               {}
-func (*MetricLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*MetricLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *MetricLog) GetTimeStamp() string {
 	if m != nil {
@@ -911,16 +1119,19 @@ func (m *MetricLog) GetMetric() *Metric {
 }
 
 type GetObservationLogRequest struct {
-	TrialName  string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
-	MetricName string `protobuf:"bytes,2,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
-	StartTime  string `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
-	EndTime    string `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	TrialName  string          `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	MetricName string          `protobuf:"bytes,2,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	StartTime  string          `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	EndTime    string          `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	PageSize   int32           `protobuf:"varint,5,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken  string          `protobuf:"bytes,6,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	Downsample *DownsampleSpec `protobuf:"bytes,7,opt,name=downsample" json:"downsample,omitempty"`
 }
 
 func (m *GetObservationLogRequest) Reset()                    { *m = GetObservationLogRequest{} }
 func (m *GetObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObservationLogRequest) ProtoMessage()               {}
-func (*GetObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*GetObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *GetObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -950,14 +1161,72 @@ func (m *GetObservationLogRequest) GetEndTime() string {
 	return ""
 }
 
+func (m *GetObservationLogRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetObservationLogRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetObservationLogRequest) GetDownsample() *DownsampleSpec {
+	if m != nil {
+		return m.Downsample
+	}
+	return nil
+}
+
+// *
+// Downsampling specification of GetObservationLog.
+// Time buckets are aligned to the Unix epoch and the time stamp of an aggregated metric log is the start of its bucket.
+// Values of bucket aggregations must be numbers, other values are skipped.
+type DownsampleSpec struct {
+	Type           DownsampleType `protobuf:"varint,1,opt,name=type,enum=api.v1.beta1.DownsampleType" json:"type,omitempty"`
+	EveryNth       int32          `protobuf:"varint,2,opt,name=every_nth,json=everyNth" json:"every_nth,omitempty"`
+	BucketDuration string         `protobuf:"bytes,3,opt,name=bucket_duration,json=bucketDuration" json:"bucket_duration,omitempty"`
+}
+
+func (m *DownsampleSpec) Reset()                    { *m = DownsampleSpec{} }
+func (m *DownsampleSpec) String() string            { return proto.CompactTextString(m) }
+func (*DownsampleSpec) ProtoMessage()               {}
+func (*DownsampleSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *DownsampleSpec) GetType() DownsampleType {
+	if m != nil {
+		return m.Type
+	}
+	return DownsampleType_NO_DOWNSAMPLE
+}
+
+func (m *DownsampleSpec) GetEveryNth() int32 {
+	if m != nil {
+		return m.EveryNth
+	}
+	return 0
+}
+
+func (m *DownsampleSpec) GetBucketDuration() string {
+	if m != nil {
+		return m.BucketDuration
+	}
+	return ""
+}
+
 type GetObservationLogReply struct {
 	ObservationLog *ObservationLog `protobuf:"bytes,1,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
+	NextPageToken  string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
 }
 
 func (m *GetObservationLogReply) Reset()                    { *m = GetObservationLogReply{} }
 func (m *GetObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*GetObservationLogReply) ProtoMessage()               {}
-func (*GetObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*GetObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetObservationLogReply) GetObservationLog() *ObservationLog {
 	if m != nil {
@@ -966,6 +1235,13 @@ func (m *GetObservationLogReply) GetObservationLog() *ObservationLog {
 	return nil
 }
 
+func (m *GetObservationLogReply) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type DeleteObservationLogRequest struct {
 	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
 }
@@ -973,7 +1249,7 @@ type DeleteObservationLogRequest struct {
 func (m *DeleteObservationLogRequest) Reset()                    { *m = DeleteObservationLogRequest{} }
 func (m *DeleteObservationLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObservationLogRequest) ProtoMessage()               {}
-func (*DeleteObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeleteObservationLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *DeleteObservationLogRequest) GetTrialName() string {
 	if m != nil {
@@ -988,18 +1264,20 @@ type DeleteObservationLogReply struct {
 func (m *DeleteObservationLogReply) Reset()                    { *m = DeleteObservationLogReply{} }
 func (m *DeleteObservationLogReply) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObservationLogReply) ProtoMessage()               {}
-func (*DeleteObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteObservationLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 type GetSuggestionsRequest struct {
-	Experiment    *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
-	Trials        []*Trial    `protobuf:"bytes,2,rep,name=trials" json:"trials,omitempty"`
-	RequestNumber int32       `protobuf:"varint,3,opt,name=request_number,json=requestNumber" json:"request_number,omitempty"`
+	Experiment       *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
+	Trials           []*Trial    `protobuf:"bytes,2,rep,name=trials" json:"trials,omitempty"`
+	RequestNumber    int32       `protobuf:"varint,3,opt,name=request_number,json=requestNumber" json:"request_number,omitempty"`
+	InfeasibleTrials []*Trial    `protobuf:"bytes,4,rep,name=infeasible_trials,json=infeasibleTrials" json:"infeasible_trials,omitempty"`
+	ExperimentId     string      `protobuf:"bytes,5,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty"`
 }
 
 func (m *GetSuggestionsRequest) Reset()                    { *m = GetSuggestionsRequest{} }
 func (m *GetSuggestionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsRequest) ProtoMessage()               {}
-func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *GetSuggestionsRequest) GetExperiment() *Experiment {
 	if m != nil {
@@ -1022,16 +1300,31 @@ func (m *GetSuggestionsRequest) GetRequestNumber() int32 {
 	return 0
 }
 
+func (m *GetSuggestionsRequest) GetInfeasibleTrials() []*Trial {
+	if m != nil {
+		return m.InfeasibleTrials
+	}
+	return nil
+}
+
+func (m *GetSuggestionsRequest) GetExperimentId() string {
+	if m != nil {
+		return m.ExperimentId
+	}
+	return ""
+}
+
 type GetSuggestionsReply struct {
 	ParameterAssignments []*GetSuggestionsReply_ParameterAssignments `protobuf:"bytes,1,rep,name=parameter_assignments,json=parameterAssignments" json:"parameter_assignments,omitempty"`
 	Algorithm            *AlgorithmSpec                              `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
 	EarlyStoppingRules   []*EarlyStoppingRule                        `protobuf:"bytes,3,rep,name=early_stopping_rules,json=earlyStoppingRules" json:"early_stopping_rules,omitempty"`
+	SearchSpaceExhausted bool                                        `protobuf:"varint,4,opt,name=search_space_exhausted,json=searchSpaceExhausted" json:"search_space_exhausted,omitempty"`
 }
 
 func (m *GetSuggestionsReply) Reset()                    { *m = GetSuggestionsReply{} }
 func (m *GetSuggestionsReply) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply) ProtoMessage()               {}
-func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *GetSuggestionsReply) GetParameterAssignments() []*GetSuggestionsReply_ParameterAssignments {
 	if m != nil {
@@ -1054,8 +1347,16 @@ func (m *GetSuggestionsReply) GetEarlyStoppingRules() []*EarlyStoppingRule {
 	return nil
 }
 
+func (m *GetSuggestionsReply) GetSearchSpaceExhausted() bool {
+	if m != nil {
+		return m.SearchSpaceExhausted
+	}
+	return false
+}
+
 type GetSuggestionsReply_ParameterAssignments struct {
 	Assignments []*ParameterAssignment `protobuf:"bytes,1,rep,name=assignments" json:"assignments,omitempty"`
+	TrialName   string                 `protobuf:"bytes,2,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
 }
 
 func (m *GetSuggestionsReply_ParameterAssignments) Reset() {
@@ -1064,7 +1365,7 @@ func (m *GetSuggestionsReply_ParameterAssignments) Reset() {
 func (m *GetSuggestionsReply_ParameterAssignments) String() string { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply_ParameterAssignments) ProtoMessage()    {}
 func (*GetSuggestionsReply_ParameterAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{27, 0}
+	return fileDescriptor0, []int{32, 0}
 }
 
 func (m *GetSuggestionsReply_ParameterAssignments) GetAssignments() []*ParameterAssignment {
@@ -1074,6 +1375,13 @@ func (m *GetSuggestionsReply_ParameterAssignments) GetAssignments() []*Parameter
 	return nil
 }
 
+func (m *GetSuggestionsReply_ParameterAssignments) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
 type ValidateAlgorithmSettingsRequest struct {
 	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
 }
@@ -1082,7 +1390,7 @@ func (m *ValidateAlgorithmSettingsRequest) Reset()         { *m = ValidateAlgori
 func (m *ValidateAlgorithmSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsRequest) ProtoMessage()    {}
 func (*ValidateAlgorithmSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28}
+	return fileDescriptor0, []int{33}
 }
 
 func (m *ValidateAlgorithmSettingsRequest) GetExperiment() *Experiment {
@@ -1100,18 +1408,23 @@ type ValidateAlgorithmSettingsReply struct {
 func (m *ValidateAlgorithmSettingsReply) Reset()                    { *m = ValidateAlgorithmSettingsReply{} }
 func (m *ValidateAlgorithmSettingsReply) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsReply) ProtoMessage()               {}
-func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
+// *
+// Suggestion controller sends the Experiment and Trials to get the rules for the new Trials.
+// Metrics collector sends only the Trial name to refresh the rules of the running Trial,
+// in that case the Early Stopping service uses the Experiment from the last controller request.
 type GetEarlyStoppingRulesRequest struct {
 	Experiment       *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
 	Trials           []*Trial    `protobuf:"bytes,2,rep,name=trials" json:"trials,omitempty"`
 	DbManagerAddress string      `protobuf:"bytes,3,opt,name=db_manager_address,json=dbManagerAddress" json:"db_manager_address,omitempty"`
+	TrialNames       []string    `protobuf:"bytes,4,rep,name=trial_names,json=trialNames" json:"trial_names,omitempty"`
 }
 
 func (m *GetEarlyStoppingRulesRequest) Reset()                    { *m = GetEarlyStoppingRulesRequest{} }
 func (m *GetEarlyStoppingRulesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetEarlyStoppingRulesRequest) ProtoMessage()               {}
-func (*GetEarlyStoppingRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*GetEarlyStoppingRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *GetEarlyStoppingRulesRequest) GetExperiment() *Experiment {
 	if m != nil {
@@ -1134,14 +1447,22 @@ func (m *GetEarlyStoppingRulesRequest) GetDbManagerAddress() string {
 	return ""
 }
 
+func (m *GetEarlyStoppingRulesRequest) GetTrialNames() []string {
+	if m != nil {
+		return m.TrialNames
+	}
+	return nil
+}
+
 type GetEarlyStoppingRulesReply struct {
-	EarlyStoppingRules []*EarlyStoppingRule `protobuf:"bytes,1,rep,name=early_stopping_rules,json=earlyStoppingRules" json:"early_stopping_rules,omitempty"`
+	EarlyStoppingRules      []*EarlyStoppingRule                                  `protobuf:"bytes,1,rep,name=early_stopping_rules,json=earlyStoppingRules" json:"early_stopping_rules,omitempty"`
+	TrialEarlyStoppingRules []*GetEarlyStoppingRulesReply_TrialEarlyStoppingRules `protobuf:"bytes,2,rep,name=trial_early_stopping_rules,json=trialEarlyStoppingRules" json:"trial_early_stopping_rules,omitempty"`
 }
 
 func (m *GetEarlyStoppingRulesReply) Reset()                    { *m = GetEarlyStoppingRulesReply{} }
 func (m *GetEarlyStoppingRulesReply) String() string            { return proto.CompactTextString(m) }
 func (*GetEarlyStoppingRulesReply) ProtoMessage()               {}
-func (*GetEarlyStoppingRulesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*GetEarlyStoppingRulesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *GetEarlyStoppingRulesReply) GetEarlyStoppingRules() []*EarlyStoppingRule {
 	if m != nil {
@@ -1150,6 +1471,45 @@ func (m *GetEarlyStoppingRulesReply) GetEarlyStoppingRules() []*EarlyStoppingRul
 	return nil
 }
 
+func (m *GetEarlyStoppingRulesReply) GetTrialEarlyStoppingRules() []*GetEarlyStoppingRulesReply_TrialEarlyStoppingRules {
+	if m != nil {
+		return m.TrialEarlyStoppingRules
+	}
+	return nil
+}
+
+// *
+// Early stopping rules of the single Trial.
+type GetEarlyStoppingRulesReply_TrialEarlyStoppingRules struct {
+	TrialName          string               `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	EarlyStoppingRules []*EarlyStoppingRule `protobuf:"bytes,2,rep,name=early_stopping_rules,json=earlyStoppingRules" json:"early_stopping_rules,omitempty"`
+}
+
+func (m *GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) Reset() {
+	*m = GetEarlyStoppingRulesReply_TrialEarlyStoppingRules{}
+}
+func (m *GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) ProtoMessage() {}
+func (*GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{36, 0}
+}
+
+func (m *GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *GetEarlyStoppingRulesReply_TrialEarlyStoppingRules) GetEarlyStoppingRules() []*EarlyStoppingRule {
+	if m != nil {
+		return m.EarlyStoppingRules
+	}
+	return nil
+}
+
 // *
 // EarlyStoppingRule represents single early stopping rule.
 type EarlyStoppingRule struct {
@@ -1164,7 +1524,7 @@ type EarlyStoppingRule struct {
 func (m *EarlyStoppingRule) Reset()                    { *m = EarlyStoppingRule{} }
 func (m *EarlyStoppingRule) String() string            { return proto.CompactTextString(m) }
 func (*EarlyStoppingRule) ProtoMessage()               {}
-func (*EarlyStoppingRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*EarlyStoppingRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *EarlyStoppingRule) GetName() string {
 	if m != nil {
@@ -1201,7 +1561,7 @@ type SetTrialStatusRequest struct {
 func (m *SetTrialStatusRequest) Reset()                    { *m = SetTrialStatusRequest{} }
 func (m *SetTrialStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetTrialStatusRequest) ProtoMessage()               {}
-func (*SetTrialStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*SetTrialStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *SetTrialStatusRequest) GetTrialName() string {
 	if m != nil {
@@ -1216,15 +1576,47 @@ type SetTrialStatusReply struct {
 func (m *SetTrialStatusReply) Reset()                    { *m = SetTrialStatusReply{} }
 func (m *SetTrialStatusReply) String() string            { return proto.CompactTextString(m) }
 func (*SetTrialStatusReply) ProtoMessage()               {}
-func (*SetTrialStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*SetTrialStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+type ValidateEarlyStoppingSettingsRequest struct {
+	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
+}
+
+func (m *ValidateEarlyStoppingSettingsRequest) Reset()         { *m = ValidateEarlyStoppingSettingsRequest{} }
+func (m *ValidateEarlyStoppingSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateEarlyStoppingSettingsRequest) ProtoMessage()    {}
+func (*ValidateEarlyStoppingSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{40}
+}
+
+func (m *ValidateEarlyStoppingSettingsRequest) GetExperiment() *Experiment {
+	if m != nil {
+		return m.Experiment
+	}
+	return nil
+}
+
+// *
+// Return INVALID_ARGUMENT Error if Early Stopping Settings are not Valid
+type ValidateEarlyStoppingSettingsReply struct {
+}
+
+func (m *ValidateEarlyStoppingSettingsReply) Reset()         { *m = ValidateEarlyStoppingSettingsReply{} }
+func (m *ValidateEarlyStoppingSettingsReply) String() string { return proto.CompactTextString(m) }
+func (*ValidateEarlyStoppingSettingsReply) ProtoMessage()    {}
+func (*ValidateEarlyStoppingSettingsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41}
+}
 
 func init() {
 	proto.RegisterType((*Experiment)(nil), "api.v1.beta1.Experiment")
 	proto.RegisterType((*ExperimentSpec)(nil), "api.v1.beta1.ExperimentSpec")
 	proto.RegisterType((*ExperimentSpec_ParameterSpecs)(nil), "api.v1.beta1.ExperimentSpec.ParameterSpecs")
 	proto.RegisterType((*ParameterSpec)(nil), "api.v1.beta1.ParameterSpec")
+	proto.RegisterType((*ParameterCondition)(nil), "api.v1.beta1.ParameterCondition")
 	proto.RegisterType((*FeasibleSpace)(nil), "api.v1.beta1.FeasibleSpace")
 	proto.RegisterType((*ObjectiveSpec)(nil), "api.v1.beta1.ObjectiveSpec")
+	proto.RegisterType((*Objective)(nil), "api.v1.beta1.Objective")
 	proto.RegisterType((*AlgorithmSpec)(nil), "api.v1.beta1.AlgorithmSpec")
 	proto.RegisterType((*AlgorithmSetting)(nil), "api.v1.beta1.AlgorithmSetting")
 	proto.RegisterType((*EarlyStoppingSpec)(nil), "api.v1.beta1.EarlyStoppingSpec")
@@ -1243,9 +1635,12 @@ func init() {
 	proto.RegisterType((*Metric)(nil), "api.v1.beta1.Metric")
 	proto.RegisterType((*ReportObservationLogRequest)(nil), "api.v1.beta1.ReportObservationLogRequest")
 	proto.RegisterType((*ReportObservationLogReply)(nil), "api.v1.beta1.ReportObservationLogReply")
+	proto.RegisterType((*StreamObservationLogRequest)(nil), "api.v1.beta1.StreamObservationLogRequest")
+	proto.RegisterType((*StreamObservationLogReply)(nil), "api.v1.beta1.StreamObservationLogReply")
 	proto.RegisterType((*ObservationLog)(nil), "api.v1.beta1.ObservationLog")
 	proto.RegisterType((*MetricLog)(nil), "api.v1.beta1.MetricLog")
 	proto.RegisterType((*GetObservationLogRequest)(nil), "api.v1.beta1.GetObservationLogRequest")
+	proto.RegisterType((*DownsampleSpec)(nil), "api.v1.beta1.DownsampleSpec")
 	proto.RegisterType((*GetObservationLogReply)(nil), "api.v1.beta1.GetObservationLogReply")
 	proto.RegisterType((*DeleteObservationLogRequest)(nil), "api.v1.beta1.DeleteObservationLogRequest")
 	proto.RegisterType((*DeleteObservationLogReply)(nil), "api.v1.beta1.DeleteObservationLogReply")
@@ -1256,11 +1651,16 @@ func init() {
 	proto.RegisterType((*ValidateAlgorithmSettingsReply)(nil), "api.v1.beta1.ValidateAlgorithmSettingsReply")
 	proto.RegisterType((*GetEarlyStoppingRulesRequest)(nil), "api.v1.beta1.GetEarlyStoppingRulesRequest")
 	proto.RegisterType((*GetEarlyStoppingRulesReply)(nil), "api.v1.beta1.GetEarlyStoppingRulesReply")
+	proto.RegisterType((*GetEarlyStoppingRulesReply_TrialEarlyStoppingRules)(nil), "api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules")
 	proto.RegisterType((*EarlyStoppingRule)(nil), "api.v1.beta1.EarlyStoppingRule")
 	proto.RegisterType((*SetTrialStatusRequest)(nil), "api.v1.beta1.SetTrialStatusRequest")
 	proto.RegisterType((*SetTrialStatusReply)(nil), "api.v1.beta1.SetTrialStatusReply")
+	proto.RegisterType((*ValidateEarlyStoppingSettingsRequest)(nil), "api.v1.beta1.ValidateEarlyStoppingSettingsRequest")
+	proto.RegisterType((*ValidateEarlyStoppingSettingsReply)(nil), "api.v1.beta1.ValidateEarlyStoppingSettingsReply")
 	proto.RegisterEnum("api.v1.beta1.ParameterType", ParameterType_name, ParameterType_value)
+	proto.RegisterEnum("api.v1.beta1.Distribution", Distribution_name, Distribution_value)
 	proto.RegisterEnum("api.v1.beta1.ObjectiveType", ObjectiveType_name, ObjectiveType_value)
+	proto.RegisterEnum("api.v1.beta1.DownsampleType", DownsampleType_name, DownsampleType_value)
 	proto.RegisterEnum("api.v1.beta1.ComparisonType", ComparisonType_name, ComparisonType_value)
 	proto.RegisterEnum("api.v1.beta1.TrialStatus_TrialConditionType", TrialStatus_TrialConditionType_name, TrialStatus_TrialConditionType_value)
 }
@@ -1283,6 +1683,11 @@ type DBManagerClient interface {
 	// You can see accuracy curve or other metric logs on UI.
 	ReportObservationLog(ctx context.Context, in *ReportObservationLogRequest, opts ...grpc.CallOption) (*ReportObservationLogReply, error)
 	// *
+	// Stream logs of Observations for a Trial while it is running.
+	// Each request carries a batch of metric logs, which is stored as soon as it is received.
+	// Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+	StreamObservationLog(ctx context.Context, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error)
+	// *
 	// Get all log of Observations for a Trial.
 	GetObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (*GetObservationLogReply, error)
 	// *
@@ -1307,6 +1712,40 @@ func (c *dBManagerClient) ReportObservationLog(ctx context.Context, in *ReportOb
 	return out, nil
 }
 
+func (c *dBManagerClient) StreamObservationLog(ctx context.Context, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DBManager_serviceDesc.Streams[0], c.cc, "/api.v1.beta1.DBManager/StreamObservationLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dBManagerStreamObservationLogClient{stream}
+	return x, nil
+}
+
+type DBManager_StreamObservationLogClient interface {
+	Send(*StreamObservationLogRequest) error
+	CloseAndRecv() (*StreamObservationLogReply, error)
+	grpc.ClientStream
+}
+
+type dBManagerStreamObservationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *dBManagerStreamObservationLogClient) Send(m *StreamObservationLogRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dBManagerStreamObservationLogClient) CloseAndRecv() (*StreamObservationLogReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StreamObservationLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dBManagerClient) GetObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (*GetObservationLogReply, error) {
 	out := new(GetObservationLogReply)
 	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetObservationLog", in, out, c.cc, opts...)
@@ -1335,6 +1774,11 @@ type DBManagerServer interface {
 	// You can see accuracy curve or other metric logs on UI.
 	ReportObservationLog(context.Context, *ReportObservationLogRequest) (*ReportObservationLogReply, error)
 	// *
+	// Stream logs of Observations for a Trial while it is running.
+	// Each request carries a batch of metric logs, which is stored as soon as it is received.
+	// Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+	StreamObservationLog(DBManager_StreamObservationLogServer) error
+	// *
 	// Get all log of Observations for a Trial.
 	GetObservationLog(context.Context, *GetObservationLogRequest) (*GetObservationLogReply, error)
 	// *
@@ -1364,6 +1808,32 @@ func _DBManager_ReportObservationLog_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_StreamObservationLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DBManagerServer).StreamObservationLog(&dBManagerStreamObservationLogServer{stream})
+}
+
+type DBManager_StreamObservationLogServer interface {
+	SendAndClose(*StreamObservationLogReply) error
+	Recv() (*StreamObservationLogRequest, error)
+	grpc.ServerStream
+}
+
+type dBManagerStreamObservationLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *dBManagerStreamObservationLogServer) SendAndClose(m *StreamObservationLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dBManagerStreamObservationLogServer) Recv() (*StreamObservationLogRequest, error) {
+	m := new(StreamObservationLogRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _DBManager_GetObservationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetObservationLogRequest)
 	if err := dec(in); err != nil {
@@ -1417,7 +1887,13 @@ var _DBManager_serviceDesc = grpc.ServiceDesc{
 			Handler:    _DBManager_DeleteObservationLog_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamObservationLog",
+			Handler:       _DBManager_StreamObservationLog_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
@@ -1523,6 +1999,7 @@ var _Suggestion_serviceDesc = grpc.ServiceDesc{
 type EarlyStoppingClient interface {
 	GetEarlyStoppingRules(ctx context.Context, in *GetEarlyStoppingRulesRequest, opts ...grpc.CallOption) (*GetEarlyStoppingRulesReply, error)
 	SetTrialStatus(ctx context.Context, in *SetTrialStatusRequest, opts ...grpc.CallOption) (*SetTrialStatusReply, error)
+	ValidateEarlyStoppingSettings(ctx context.Context, in *ValidateEarlyStoppingSettingsRequest, opts ...grpc.CallOption) (*ValidateEarlyStoppingSettingsReply, error)
 }
 
 type earlyStoppingClient struct {
@@ -1551,11 +2028,21 @@ func (c *earlyStoppingClient) SetTrialStatus(ctx context.Context, in *SetTrialSt
 	return out, nil
 }
 
+func (c *earlyStoppingClient) ValidateEarlyStoppingSettings(ctx context.Context, in *ValidateEarlyStoppingSettingsRequest, opts ...grpc.CallOption) (*ValidateEarlyStoppingSettingsReply, error) {
+	out := new(ValidateEarlyStoppingSettingsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.EarlyStopping/ValidateEarlyStoppingSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for EarlyStopping service
 
 type EarlyStoppingServer interface {
 	GetEarlyStoppingRules(context.Context, *GetEarlyStoppingRulesRequest) (*GetEarlyStoppingRulesReply, error)
 	SetTrialStatus(context.Context, *SetTrialStatusRequest) (*SetTrialStatusReply, error)
+	ValidateEarlyStoppingSettings(context.Context, *ValidateEarlyStoppingSettingsRequest) (*ValidateEarlyStoppingSettingsReply, error)
 }
 
 func RegisterEarlyStoppingServer(s *grpc.Server, srv EarlyStoppingServer) {
@@ -1598,6 +2085,24 @@ func _EarlyStopping_SetTrialStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EarlyStopping_ValidateEarlyStoppingSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateEarlyStoppingSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EarlyStoppingServer).ValidateEarlyStoppingSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.EarlyStopping/ValidateEarlyStoppingSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EarlyStoppingServer).ValidateEarlyStoppingSettings(ctx, req.(*ValidateEarlyStoppingSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EarlyStopping_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.v1.beta1.EarlyStopping",
 	HandlerType: (*EarlyStoppingServer)(nil),
@@ -1610,6 +2115,10 @@ var _EarlyStopping_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetTrialStatus",
 			Handler:    _EarlyStopping_SetTrialStatus_Handler,
 		},
+		{
+			MethodName: "ValidateEarlyStoppingSettings",
+			Handler:    _EarlyStopping_ValidateEarlyStoppingSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -1618,117 +2127,151 @@ var _EarlyStopping_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xcf, 0xe8, 0x8f, 0x9d, 0x79, 0xb2, 0xe4, 0x49, 0x5b, 0xde, 0x95, 0x9d, 0x65, 0xe3, 0x0c,
-	0x90, 0x0d, 0x49, 0x4a, 0x6c, 0x4c, 0x91, 0x0a, 0xb5, 0xa1, 0x40, 0x91, 0x26, 0x2e, 0x65, 0x65,
-	0xc9, 0x69, 0xc9, 0x90, 0x65, 0xa9, 0x9a, 0x6a, 0x49, 0x1d, 0xed, 0x84, 0xf9, 0xc7, 0x4c, 0x2b,
-	0x15, 0xc1, 0x91, 0x5a, 0x6e, 0xdc, 0x38, 0x71, 0xe7, 0xc4, 0x91, 0x2f, 0xc0, 0x89, 0x0f, 0x40,
-	0x71, 0xe0, 0xca, 0x07, 0xe0, 0x3b, 0x50, 0xdd, 0x33, 0x9a, 0x7f, 0x1a, 0x29, 0xb6, 0x03, 0x7b,
-	0x9b, 0x7e, 0xef, 0xf7, 0xba, 0xdf, 0x7b, 0xfd, 0xfe, 0xf5, 0x80, 0x4c, 0x5c, 0xa3, 0xe9, 0x7a,
-	0x0e, 0x73, 0xd0, 0x0e, 0xff, 0x7c, 0xf3, 0xb0, 0x39, 0xa6, 0x8c, 0x3c, 0x54, 0x31, 0x80, 0xf6,
-	0xd6, 0xa5, 0x9e, 0x61, 0x51, 0x9b, 0x21, 0x04, 0x25, 0x9b, 0x58, 0xb4, 0x21, 0x1d, 0x49, 0x77,
-	0x65, 0x2c, 0xbe, 0xd1, 0xa7, 0x50, 0xf2, 0x5d, 0x3a, 0x69, 0x14, 0x8e, 0xa4, 0xbb, 0x95, 0xe3,
-	0x8f, 0x9a, 0x49, 0xf1, 0x66, 0x2c, 0x3b, 0x74, 0xe9, 0x04, 0x0b, 0xa4, 0xfa, 0x75, 0x09, 0x6a,
-	0x69, 0x06, 0x1a, 0xc1, 0xae, 0x4b, 0x3c, 0x62, 0x51, 0x46, 0x3d, 0x9d, 0x83, 0x7c, 0x71, 0x46,
-	0xe5, 0xf8, 0xfe, 0xa6, 0xfd, 0x9a, 0x67, 0x4b, 0x19, 0xbe, 0xf2, 0x71, 0xcd, 0x4d, 0xad, 0xd1,
-	0x8f, 0x40, 0x76, 0xc6, 0xaf, 0xe9, 0x84, 0x19, 0x6f, 0x68, 0xa8, 0xdf, 0xcd, 0xf4, 0x7e, 0x83,
-	0x25, 0x5b, 0xa8, 0x17, 0xa3, 0xb9, 0x28, 0x31, 0x67, 0x8e, 0x67, 0xb0, 0xaf, 0xac, 0x46, 0x31,
-	0x4f, 0xb4, 0xb5, 0x64, 0x07, 0xa2, 0x11, 0x1a, 0x3d, 0x83, 0x1a, 0x25, 0x9e, 0xb9, 0xd0, 0x7d,
-	0xe6, 0xb8, 0xae, 0x61, 0xcf, 0x1a, 0x25, 0x21, 0x7f, 0x2b, 0x63, 0x0a, 0xc7, 0x0c, 0x43, 0x88,
-	0xd8, 0xa3, 0x4a, 0x93, 0x24, 0xf4, 0x29, 0xd4, 0xb9, 0x3d, 0xa6, 0x49, 0x4d, 0x9d, 0x79, 0x06,
-	0x31, 0xf5, 0x89, 0x33, 0xb7, 0x59, 0xa3, 0x7c, 0x24, 0xdd, 0x2d, 0x63, 0xb4, 0xe4, 0x8d, 0x38,
-	0xab, 0xcd, 0x39, 0xe8, 0x0e, 0xec, 0x5a, 0xe4, 0x6d, 0x0a, 0xbc, 0x25, 0xc0, 0x55, 0x8b, 0xbc,
-	0x4d, 0xe0, 0x1e, 0x01, 0xd8, 0xc4, 0xd7, 0x27, 0x8e, 0xfd, 0xca, 0x98, 0x35, 0xb6, 0x85, 0x76,
-	0x1f, 0xa6, 0xb5, 0xeb, 0x13, 0xbf, 0x2d, 0xd8, 0x58, 0xb6, 0x97, 0x9f, 0x87, 0xa7, 0x50, 0x4b,
-	0x7b, 0x1c, 0x7d, 0x06, 0x10, 0xf9, 0x9c, 0x5f, 0x59, 0x71, 0xd5, 0x4f, 0x29, 0x09, 0x9c, 0x80,
-	0xab, 0x7f, 0x91, 0xa0, 0x9a, 0xe2, 0xe6, 0xc6, 0xd7, 0x53, 0x88, 0xaf, 0x55, 0x67, 0x0b, 0x37,
-	0xb8, 0xc9, 0xda, 0xda, 0x63, 0x46, 0x0b, 0x97, 0xe2, 0xaa, 0x9b, 0x5c, 0xf2, 0x3d, 0x5e, 0x51,
-	0xe2, 0x1b, 0x63, 0x93, 0xea, 0xbe, 0x4b, 0x26, 0x34, 0xff, 0x4a, 0x9f, 0x85, 0x98, 0x21, 0x87,
-	0xe0, 0xea, 0xab, 0xe4, 0x52, 0xfd, 0x12, 0xaa, 0x29, 0x3e, 0x52, 0xa0, 0x68, 0x91, 0xb7, 0xa1,
-	0xae, 0xfc, 0x53, 0x50, 0x0c, 0x5b, 0xe8, 0xc7, 0x29, 0x86, 0xcd, 0x0d, 0x32, 0x0d, 0x9f, 0x35,
-	0x8a, 0x47, 0x45, 0x6e, 0x10, 0xff, 0xe6, 0x34, 0x9f, 0x51, 0x57, 0x44, 0x85, 0x8c, 0xc5, 0xb7,
-	0xfa, 0x37, 0x09, 0xaa, 0xa9, 0x58, 0x44, 0xdf, 0x87, 0x92, 0x30, 0x56, 0xca, 0x33, 0x36, 0x82,
-	0x0a, 0x63, 0x05, 0x90, 0x6f, 0x3b, 0x73, 0x88, 0x29, 0x4e, 0x97, 0xb0, 0xf8, 0x46, 0xc7, 0xb0,
-	0x1f, 0x85, 0xb4, 0x6e, 0x51, 0xe6, 0x19, 0x13, 0x5d, 0x38, 0xb8, 0x28, 0xce, 0xde, 0x8b, 0x98,
-	0xa7, 0x82, 0xd7, 0xe7, 0xfe, 0x7e, 0x04, 0x1f, 0x92, 0xe9, 0xd4, 0x60, 0x86, 0x63, 0x13, 0x33,
-	0x29, 0xe4, 0x37, 0x4a, 0xc2, 0x8a, 0xfd, 0x98, 0x1d, 0x8b, 0xf9, 0xea, 0xd7, 0x12, 0x54, 0x53,
-	0x39, 0x81, 0xbe, 0x0b, 0xb5, 0x28, 0x2b, 0xf4, 0xc4, 0xbd, 0x56, 0x23, 0xaa, 0x38, 0xf0, 0x14,
-	0x50, 0x0c, 0xf3, 0x29, 0x63, 0x86, 0x3d, 0xf3, 0x1b, 0x05, 0x11, 0x4b, 0x1f, 0xaf, 0xcb, 0xb9,
-	0x00, 0x86, 0x6f, 0x90, 0x0c, 0xc5, 0x57, 0x9f, 0x80, 0x92, 0x85, 0xe5, 0xc6, 0x55, 0x1d, 0xca,
-	0x6f, 0x88, 0x39, 0xa7, 0xe1, 0x75, 0x05, 0x0b, 0xf5, 0x0f, 0x12, 0xdc, 0x58, 0xc9, 0xcc, 0x8b,
-	0x5a, 0xf2, 0x62, 0x83, 0x25, 0xea, 0xa6, 0xec, 0x5f, 0x6f, 0xcd, 0x4f, 0xa1, 0x9e, 0x07, 0xbd,
-	0x84, 0x45, 0xff, 0x90, 0x40, 0x8e, 0xb2, 0x19, 0x3d, 0x81, 0x9d, 0x99, 0x47, 0xdc, 0xaf, 0x96,
-	0xc9, 0x1f, 0x54, 0xd9, 0x83, 0xb4, 0x72, 0x27, 0x1c, 0x11, 0xa6, 0x7f, 0x65, 0x16, 0x2f, 0xd0,
-	0x53, 0x00, 0xc7, 0xa5, 0x1e, 0xe1, 0xb7, 0xef, 0x87, 0x15, 0x55, 0x5d, 0x53, 0x38, 0x9a, 0x83,
-	0x08, 0x89, 0x13, 0x52, 0x87, 0x6d, 0x80, 0x98, 0x83, 0x7e, 0x08, 0x72, 0xc4, 0x0b, 0xeb, 0x47,
-	0xa6, 0x12, 0x45, 0x60, 0x1c, 0x23, 0x55, 0x17, 0x2a, 0x09, 0x25, 0xd1, 0xb7, 0x00, 0xec, 0xb9,
-	0xa5, 0x9b, 0x64, 0x11, 0x94, 0x21, 0x5e, 0xf3, 0x64, 0x7b, 0x6e, 0xf5, 0x04, 0x01, 0xdd, 0x82,
-	0x8a, 0x61, 0xbb, 0x73, 0xa6, 0xfb, 0xc6, 0x6f, 0x68, 0x70, 0x21, 0x65, 0x0c, 0x82, 0x34, 0xe4,
-	0x14, 0x74, 0x1b, 0x76, 0x9c, 0x39, 0x8b, 0x11, 0x45, 0x81, 0xa8, 0x04, 0x34, 0x01, 0x11, 0x6e,
-	0x8c, 0x54, 0xe1, 0x01, 0x11, 0x29, 0xa3, 0x47, 0x79, 0x2a, 0xe3, 0x6a, 0x44, 0x15, 0x75, 0x67,
-	0xb0, 0xda, 0xd6, 0x02, 0xa7, 0xdd, 0x59, 0x63, 0xe3, 0x3b, 0x3a, 0xda, 0xff, 0xba, 0x02, 0xff,
-	0x16, 0xca, 0xa2, 0x2d, 0xe4, 0x86, 0xd3, 0xfd, 0x54, 0x63, 0xcf, 0xdc, 0x8a, 0x10, 0x8b, 0x7b,
-	0x3a, 0x7a, 0x08, 0x5b, 0x3e, 0x23, 0x6c, 0xee, 0x87, 0x95, 0xf5, 0x20, 0x0f, 0x2e, 0x00, 0x38,
-	0x04, 0xaa, 0xbf, 0x2f, 0x80, 0x1c, 0x6d, 0xf3, 0x3e, 0xbd, 0x9a, 0xc0, 0x7e, 0xec, 0x65, 0xe2,
-	0xfb, 0xc6, 0xcc, 0xe6, 0x13, 0xc2, 0x52, 0x95, 0x07, 0x6b, 0x34, 0x8f, 0xfd, 0xd2, 0x8a, 0x65,
-	0x70, 0xdd, 0xcd, 0xa1, 0x1e, 0x7e, 0x09, 0xf5, 0x3c, 0x34, 0x6a, 0x43, 0x25, 0x79, 0x60, 0xe0,
-	0xfe, 0xdb, 0x6b, 0xdc, 0x1f, 0x0b, 0xe2, 0xa4, 0x94, 0xfa, 0x13, 0xd8, 0xcb, 0xc1, 0x5c, 0x22,
-	0xc5, 0xff, 0x59, 0x80, 0x4a, 0xc2, 0xc3, 0x3c, 0x1d, 0x7c, 0x46, 0x3c, 0xa6, 0x33, 0x23, 0x92,
-	0x97, 0x05, 0x65, 0x64, 0x58, 0x14, 0x7d, 0x02, 0xbb, 0x13, 0xc7, 0x72, 0x4d, 0x1a, 0x44, 0x2f,
-	0xc7, 0x04, 0xdb, 0xd5, 0x62, 0xb2, 0x00, 0x3e, 0x07, 0x79, 0xe2, 0xd8, 0x41, 0xb1, 0x17, 0xce,
-	0xac, 0xe5, 0x3b, 0x53, 0x9c, 0xda, 0x0c, 0x07, 0x8c, 0x10, 0x2f, 0x3a, 0x53, 0x2c, 0x8e, 0x3e,
-	0x83, 0x8a, 0x33, 0xf6, 0xa9, 0xf7, 0x26, 0x48, 0xf5, 0x52, 0x5e, 0x94, 0x0c, 0x62, 0x00, 0x4e,
-	0xa2, 0x55, 0x06, 0x68, 0x75, 0x77, 0x54, 0x81, 0xed, 0x36, 0xd6, 0x5a, 0x23, 0xad, 0xa3, 0x5c,
-	0xe3, 0x0b, 0x7c, 0xde, 0xef, 0x77, 0xfb, 0x27, 0x8a, 0x84, 0xaa, 0x20, 0x0f, 0xcf, 0xdb, 0x6d,
-	0x4d, 0xeb, 0x68, 0x1d, 0xa5, 0x80, 0x00, 0xb6, 0x3e, 0xef, 0xf6, 0x7a, 0x5a, 0x47, 0x29, 0xf2,
-	0xef, 0x67, 0xad, 0x2e, 0xff, 0x2e, 0x21, 0x05, 0x76, 0xb4, 0x16, 0xee, 0x7d, 0x31, 0x1c, 0x0d,
-	0xce, 0xce, 0xb4, 0x8e, 0x52, 0xe6, 0xbb, 0x9c, 0xf7, 0x3f, 0xef, 0x0f, 0x7e, 0xde, 0x57, 0xb6,
-	0xd4, 0x1f, 0x43, 0x25, 0xa1, 0x11, 0x6a, 0xc2, 0x76, 0xd0, 0x0d, 0x97, 0xf7, 0x5c, 0x4f, 0x6b,
-	0x1f, 0x34, 0x43, 0xbc, 0x04, 0xa9, 0xc7, 0xb0, 0x15, 0x90, 0x2e, 0x71, 0x93, 0xbf, 0x93, 0xe0,
-	0x26, 0xa6, 0xae, 0xe3, 0xb1, 0xc4, 0xc9, 0x3d, 0x67, 0x86, 0xe9, 0xaf, 0xe7, 0xd4, 0x67, 0xfc,
-	0x66, 0x83, 0xe9, 0x2e, 0xb1, 0x9f, 0x2c, 0x28, 0xa2, 0x01, 0x69, 0xb0, 0x9b, 0x70, 0x9b, 0x6e,
-	0x3a, 0xb3, 0xfc, 0xb1, 0x3c, 0xb3, 0x79, 0xcd, 0x49, 0xad, 0xd5, 0x9b, 0x70, 0x90, 0xaf, 0x84,
-	0x6b, 0x2e, 0xd4, 0xe7, 0x50, 0x4b, 0x93, 0xd1, 0x63, 0xa8, 0x84, 0x63, 0x82, 0xe9, 0xcc, 0xfc,
-	0xfc, 0x2a, 0x1e, 0x78, 0x82, 0x6f, 0x02, 0xd6, 0xf2, 0xd3, 0x57, 0x5f, 0x82, 0x1c, 0x31, 0x84,
-	0x6d, 0x86, 0x45, 0x75, 0x9f, 0x11, 0xcb, 0x8d, 0x6c, 0x33, 0x2c, 0x3a, 0xe4, 0x04, 0xf4, 0x00,
-	0xb6, 0x02, 0xc9, 0xd0, 0xa4, 0x7c, 0xef, 0x87, 0x18, 0xf5, 0x8f, 0x12, 0x34, 0x4e, 0xe8, 0xd5,
-	0xbc, 0x78, 0x2b, 0xb2, 0x47, 0xf0, 0x83, 0x0b, 0x0a, 0xd5, 0x16, 0x80, 0x74, 0x7e, 0x15, 0xb3,
-	0xf9, 0x75, 0x00, 0xd7, 0xa9, 0x3d, 0x0d, 0x98, 0xc1, 0x90, 0xb7, 0x4d, 0xed, 0x29, 0x67, 0xa9,
-	0x3a, 0x7c, 0x90, 0xa3, 0x95, 0x6b, 0x2e, 0xf2, 0xae, 0x4e, 0xba, 0xc2, 0xd5, 0x3d, 0x81, 0x9b,
-	0x1d, 0x6a, 0x52, 0x46, 0xaf, 0x62, 0x39, 0xbf, 0xf8, 0x7c, 0x69, 0x7e, 0xf1, 0x7f, 0x96, 0x60,
-	0xff, 0x84, 0xb2, 0xe1, 0x7c, 0x36, 0xa3, 0x7e, 0xd0, 0xd7, 0xc3, 0x5d, 0x1f, 0x03, 0xd0, 0xe8,
-	0x61, 0x16, 0xaa, 0xdd, 0x58, 0xf7, 0x70, 0xc3, 0x09, 0x2c, 0xba, 0x0f, 0x5b, 0xe2, 0xf4, 0xe5,
-	0x94, 0xb4, 0x97, 0x53, 0x5e, 0x70, 0x08, 0xe1, 0x4d, 0xd7, 0x0b, 0x4e, 0xd4, 0xed, 0xb9, 0x35,
-	0xa6, 0x9e, 0x70, 0x7d, 0x19, 0x57, 0x43, 0x6a, 0x5f, 0x10, 0xd5, 0xff, 0x14, 0x60, 0x2f, 0xab,
-	0x27, 0xf7, 0xf0, 0xaf, 0xd6, 0xb5, 0x89, 0x20, 0x60, 0x1f, 0x65, 0x66, 0xa0, 0xd5, 0x1d, 0x2e,
-	0xd1, 0x30, 0xd2, 0xef, 0xc7, 0xc2, 0xa5, 0xde, 0x8f, 0x2f, 0xa0, 0x9e, 0x7e, 0x3f, 0xea, 0xde,
-	0xdc, 0x0c, 0x87, 0x92, 0xcd, 0xaf, 0x48, 0x3c, 0x37, 0x29, 0x46, 0x34, 0x4b, 0xfa, 0x3f, 0xb7,
-	0xaf, 0x5f, 0xc2, 0xd1, 0xcf, 0x88, 0x69, 0x4c, 0x09, 0xa3, 0xd9, 0xc1, 0xfb, 0xfd, 0x23, 0x44,
-	0x3d, 0x82, 0x8f, 0x37, 0xec, 0xce, 0xe3, 0xf2, 0xaf, 0x12, 0x7c, 0x74, 0x42, 0xd9, 0x8a, 0x27,
-	0xbe, 0xe9, 0xf0, 0x7c, 0x00, 0x68, 0x3a, 0xd6, 0x2d, 0x62, 0x93, 0x19, 0x0f, 0xb0, 0xe9, 0xd4,
-	0xa3, 0xbe, 0x1f, 0x56, 0x07, 0x65, 0x3a, 0x3e, 0x0d, 0x18, 0xad, 0x80, 0xae, 0x3a, 0x70, 0xb8,
-	0x46, 0x69, 0x1e, 0xab, 0xeb, 0x62, 0x40, 0xba, 0x72, 0x0c, 0xa8, 0x7f, 0xca, 0xbe, 0x6c, 0x38,
-	0xf9, 0xe2, 0xad, 0x09, 0x3d, 0x01, 0xe0, 0xe3, 0x01, 0xf1, 0x0c, 0x3f, 0x9a, 0x06, 0x32, 0xb5,
-	0xa9, 0x1d, 0xf1, 0x45, 0xf7, 0x4f, 0xe0, 0xe3, 0x92, 0x19, 0x3d, 0x7d, 0xcb, 0x61, 0xc9, 0x1c,
-	0xf2, 0xf7, 0xef, 0x23, 0xd8, 0x1f, 0x52, 0x96, 0x9c, 0x12, 0x2f, 0x56, 0xb0, 0xf6, 0x61, 0x2f,
-	0x2b, 0xe7, 0x9a, 0x8b, 0x7b, 0xe7, 0x89, 0x1f, 0x0b, 0x62, 0x54, 0x50, 0x60, 0x27, 0xec, 0xeb,
-	0xfa, 0xe8, 0x8b, 0x33, 0x4d, 0xb9, 0xc6, 0xe7, 0x80, 0xce, 0xe0, 0xfc, 0x69, 0x4f, 0x53, 0x24,
-	0xb4, 0x0d, 0xc5, 0x6e, 0x7f, 0xa4, 0x14, 0xd0, 0x0e, 0x5c, 0xef, 0x74, 0x87, 0x6d, 0xac, 0x8d,
-	0x34, 0xa5, 0x88, 0x76, 0xa1, 0xd2, 0x6e, 0x8d, 0xb4, 0x93, 0x01, 0xee, 0xb6, 0x5b, 0x3d, 0xa5,
-	0x74, 0xef, 0x71, 0xe2, 0x91, 0xbe, 0x9c, 0x40, 0x96, 0xe3, 0xc2, 0x35, 0x2e, 0x7c, 0xda, 0xed,
-	0x77, 0x4f, 0xbb, 0xbf, 0xe0, 0x7b, 0xf2, 0x55, 0xeb, 0x65, 0xb0, 0x2a, 0xdc, 0x7b, 0x0e, 0xb5,
-	0xb4, 0x73, 0xd0, 0x07, 0x80, 0x96, 0x1a, 0xb5, 0x07, 0xa7, 0x67, 0x2d, 0xdc, 0x1d, 0x0e, 0xf8,
-	0x2e, 0x32, 0x94, 0xb5, 0x17, 0xe7, 0xad, 0x9e, 0x22, 0xa1, 0xeb, 0x50, 0xea, 0x69, 0xc3, 0xa1,
-	0x52, 0xe0, 0xe7, 0x9c, 0x88, 0x49, 0x07, 0x2b, 0xc5, 0xe3, 0xbf, 0x17, 0x40, 0xee, 0x3c, 0x0d,
-	0xc3, 0x09, 0xbd, 0x86, 0x7a, 0x5e, 0xaf, 0x46, 0xdf, 0x4b, 0x5f, 0xcd, 0x86, 0xa1, 0xe2, 0xf0,
-	0x93, 0x8b, 0x40, 0x79, 0x54, 0x12, 0xb8, 0xb1, 0xd2, 0xbd, 0xd0, 0x9d, 0x95, 0xba, 0x99, 0x7f,
-	0xca, 0x77, 0xde, 0x89, 0xe3, 0x47, 0xbc, 0x86, 0x7a, 0x5e, 0x07, 0xca, 0x9a, 0xb3, 0xa1, 0xc7,
-	0x65, 0xcd, 0x59, 0xdb, 0xd0, 0x8e, 0xff, 0x2d, 0x01, 0xc4, 0x35, 0x1e, 0xbd, 0x84, 0x5a, 0xba,
-	0xe8, 0xa3, 0x6f, 0x6f, 0x6e, 0x09, 0xc1, 0x71, 0xb7, 0xdf, 0xd9, 0x37, 0xd0, 0x02, 0x0e, 0xd6,
-	0xd6, 0x30, 0xd4, 0x4c, 0xcb, 0xbf, 0xab, 0x94, 0x1e, 0x3e, 0xb8, 0x30, 0x9e, 0xdb, 0xf8, 0x2f,
-	0x09, 0xaa, 0xa9, 0xac, 0x47, 0x96, 0xe8, 0xe2, 0xab, 0x85, 0x07, 0xdd, 0x5b, 0x31, 0x64, 0x6d,
-	0x49, 0x3d, 0xbc, 0x7b, 0x21, 0x2c, 0xb7, 0xfd, 0x25, 0xd4, 0xd2, 0x19, 0x9a, 0xf5, 0x6a, 0x6e,
-	0xde, 0x67, 0xbd, 0x9a, 0x93, 0xe4, 0xe3, 0x2d, 0xf1, 0xbf, 0xfa, 0x07, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xa9, 0x26, 0x35, 0x4f, 0xbc, 0x16, 0x00, 0x00,
+	// 2334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x6d, 0x73, 0x1b, 0x49,
+	0xf1, 0xcf, 0x4a, 0x96, 0xed, 0x6d, 0x59, 0xf2, 0x7a, 0x62, 0x27, 0xb2, 0x7c, 0x0f, 0xce, 0x5e,
+	0xfe, 0x89, 0xff, 0x4e, 0xca, 0x24, 0x06, 0x42, 0xa8, 0x0b, 0x47, 0x14, 0x69, 0x63, 0x94, 0xe8,
+	0xc1, 0x19, 0xc9, 0x77, 0x09, 0x50, 0xb5, 0xac, 0xa5, 0x89, 0xbc, 0xc9, 0x6a, 0x77, 0xd9, 0x5d,
+	0x05, 0xeb, 0x28, 0x5e, 0x41, 0x80, 0x2a, 0x1e, 0x3e, 0x00, 0x9f, 0x83, 0x2f, 0x00, 0x1f, 0x81,
+	0xba, 0x0f, 0x00, 0xaf, 0xf9, 0x04, 0x54, 0xf1, 0x82, 0x9a, 0x99, 0x7d, 0x94, 0x56, 0xb2, 0x9d,
+	0x3b, 0xb8, 0x77, 0x3b, 0xdd, 0xbf, 0xee, 0xe9, 0xe9, 0xe9, 0xe9, 0xee, 0x99, 0x05, 0x51, 0xb3,
+	0xf5, 0x3d, 0xdb, 0xb1, 0x3c, 0x0b, 0xad, 0xd0, 0xcf, 0x37, 0x77, 0xf7, 0x8e, 0x89, 0xa7, 0xdd,
+	0x95, 0x31, 0x80, 0x72, 0x6a, 0x13, 0x47, 0x1f, 0x12, 0xd3, 0x43, 0x08, 0x16, 0x4c, 0x6d, 0x48,
+	0x4a, 0xc2, 0xb6, 0xb0, 0x23, 0x62, 0xf6, 0x8d, 0xee, 0xc0, 0x82, 0x6b, 0x93, 0x5e, 0x29, 0xb3,
+	0x2d, 0xec, 0xe4, 0xf7, 0xdf, 0xdb, 0x8b, 0x8b, 0xef, 0x45, 0xb2, 0x1d, 0x9b, 0xf4, 0x30, 0x43,
+	0xca, 0x6f, 0x17, 0xa0, 0x98, 0x64, 0xa0, 0x2e, 0xac, 0xda, 0x9a, 0xa3, 0x0d, 0x89, 0x47, 0x1c,
+	0x95, 0x82, 0x5c, 0x36, 0x47, 0x7e, 0xff, 0xd6, 0x3c, 0x7d, 0x7b, 0x87, 0x81, 0x0c, 0x1d, 0xb9,
+	0xb8, 0x68, 0x27, 0xc6, 0xe8, 0xbb, 0x20, 0x5a, 0xc7, 0xaf, 0x48, 0xcf, 0xd3, 0xdf, 0x10, 0xdf,
+	0xbe, 0xad, 0xa4, 0xbe, 0x76, 0xc0, 0x66, 0xe6, 0x45, 0x68, 0x2a, 0xaa, 0x19, 0x03, 0xcb, 0xd1,
+	0xbd, 0x93, 0x61, 0x29, 0x9b, 0x26, 0x5a, 0x09, 0xd8, 0x5c, 0x34, 0x44, 0xa3, 0xc7, 0x50, 0x24,
+	0x9a, 0x63, 0x8c, 0x55, 0xd7, 0xb3, 0x6c, 0x5b, 0x37, 0x07, 0xa5, 0x05, 0x26, 0xff, 0xe1, 0xc4,
+	0x52, 0x28, 0xa6, 0xe3, 0x43, 0x98, 0x8e, 0x02, 0x89, 0x93, 0xd0, 0x1d, 0x58, 0xa7, 0xeb, 0x31,
+	0x0c, 0x62, 0xa8, 0x9e, 0xa3, 0x6b, 0x86, 0xda, 0xb3, 0x46, 0xa6, 0x57, 0xca, 0x6d, 0x0b, 0x3b,
+	0x39, 0x8c, 0x02, 0x5e, 0x97, 0xb2, 0xaa, 0x94, 0x83, 0x6e, 0xc0, 0xea, 0x50, 0x3b, 0x4d, 0x80,
+	0x17, 0x19, 0xb8, 0x30, 0xd4, 0x4e, 0x63, 0xb8, 0x7b, 0x00, 0xa6, 0xe6, 0xaa, 0x3d, 0xcb, 0x7c,
+	0xa9, 0x0f, 0x4a, 0x4b, 0xcc, 0xba, 0xab, 0x49, 0xeb, 0x5a, 0x9a, 0x5b, 0x65, 0x6c, 0x2c, 0x9a,
+	0xc1, 0x67, 0xb9, 0x09, 0xc5, 0xa4, 0xc7, 0xd1, 0xc7, 0x00, 0xa1, 0xcf, 0xe9, 0x96, 0x65, 0xa7,
+	0xfd, 0x94, 0x90, 0xc0, 0x31, 0xb8, 0xfc, 0x4f, 0x01, 0x0a, 0x09, 0x6e, 0x6a, 0x7c, 0x3d, 0x82,
+	0x68, 0x5b, 0x55, 0x6f, 0x6c, 0xf3, 0x9d, 0x2c, 0xce, 0x9c, 0xa6, 0x3b, 0xb6, 0x09, 0x2e, 0xd8,
+	0xf1, 0x21, 0xd5, 0xf1, 0x92, 0x68, 0xae, 0x7e, 0x6c, 0x10, 0xd5, 0xb5, 0xb5, 0x1e, 0x49, 0xdf,
+	0xd2, 0xc7, 0x3e, 0xa6, 0x43, 0x21, 0xb8, 0xf0, 0x32, 0x3e, 0x44, 0x9f, 0x80, 0xd8, 0xb3, 0xcc,
+	0xbe, 0xee, 0xe9, 0x96, 0xe9, 0xef, 0xe8, 0xf6, 0x0c, 0x13, 0xaa, 0x01, 0x0e, 0x47, 0x22, 0xf2,
+	0x13, 0x40, 0xd3, 0x00, 0xf4, 0x1e, 0x88, 0xa1, 0xa9, 0xfe, 0xb2, 0x23, 0x02, 0xba, 0x02, 0x8b,
+	0x6f, 0x34, 0x63, 0x44, 0xdc, 0x52, 0x66, 0x3b, 0xbb, 0x23, 0x62, 0x7f, 0x24, 0xff, 0x45, 0x80,
+	0x42, 0xc2, 0x58, 0x24, 0x41, 0x76, 0xa8, 0x9d, 0xfa, 0x1a, 0xe8, 0x27, 0xa3, 0xe8, 0x66, 0x29,
+	0xe3, 0x53, 0x74, 0x93, 0x7a, 0xd7, 0xd0, 0x5d, 0xaf, 0x94, 0x65, 0xba, 0xd8, 0x37, 0xa5, 0xb9,
+	0x1e, 0xb1, 0xd9, 0x82, 0x44, 0xcc, 0xbe, 0xd1, 0x27, 0xb0, 0xd2, 0xd7, 0x5d, 0xcf, 0xd1, 0x8f,
+	0x47, 0x6c, 0xb1, 0x39, 0xe6, 0xef, 0x72, 0x72, 0xb1, 0xb5, 0x18, 0x02, 0x27, 0xf0, 0x54, 0xe7,
+	0x90, 0x68, 0x26, 0x8b, 0x3d, 0x11, 0xb3, 0x6f, 0x6a, 0x8d, 0xeb, 0xf5, 0x59, 0xac, 0x89, 0x98,
+	0x7e, 0xca, 0xff, 0x12, 0xa0, 0x90, 0x38, 0x7e, 0xe8, 0x1b, 0xb0, 0xc0, 0xf6, 0x57, 0x48, 0xdb,
+	0xdf, 0x10, 0xca, 0xf6, 0x97, 0x01, 0xe9, 0x44, 0x03, 0x4b, 0x33, 0xd8, 0x1a, 0x05, 0xcc, 0xbe,
+	0xd1, 0x3e, 0x6c, 0x84, 0xa7, 0x58, 0x1d, 0x12, 0xcf, 0xd1, 0x7b, 0x2a, 0x8b, 0xa9, 0x2c, 0x9b,
+	0xfa, 0x72, 0xc8, 0x6c, 0x32, 0x5e, 0x8b, 0x86, 0xd8, 0x3d, 0xb8, 0xaa, 0xf5, 0xf9, 0x86, 0x68,
+	0x46, 0x5c, 0xc8, 0x2d, 0x2d, 0x30, 0x5f, 0x6d, 0x44, 0xec, 0x48, 0xcc, 0x45, 0xdf, 0x01, 0x08,
+	0xd5, 0xb9, 0xa5, 0xdc, 0x76, 0x76, 0xfa, 0x1c, 0x85, 0x66, 0xe3, 0x18, 0x54, 0xfe, 0x95, 0x00,
+	0x62, 0xc8, 0xf9, 0xda, 0xd6, 0x2d, 0xbf, 0x15, 0xa0, 0x90, 0x48, 0x63, 0xe8, 0xff, 0xa0, 0x18,
+	0x26, 0x32, 0x35, 0x76, 0x14, 0x0b, 0x21, 0x95, 0x39, 0xac, 0x09, 0x28, 0x82, 0xb9, 0xc4, 0xf3,
+	0x74, 0x73, 0xc0, 0x63, 0x34, 0xbf, 0xff, 0xc1, 0xac, 0x34, 0xc9, 0x61, 0x78, 0x4d, 0x9b, 0xa0,
+	0xb8, 0xf2, 0x03, 0x90, 0x26, 0x61, 0xa9, 0xa9, 0x60, 0x1d, 0x72, 0xec, 0x00, 0xf8, 0x41, 0xcd,
+	0x07, 0xf2, 0x1f, 0x04, 0x58, 0x9b, 0x4a, 0xa6, 0xe7, 0x5d, 0xc9, 0xb3, 0x39, 0x2b, 0x91, 0xe7,
+	0x25, 0xec, 0xd9, 0xab, 0x79, 0x08, 0xeb, 0x69, 0xd0, 0x0b, 0xac, 0xe8, 0x6f, 0x02, 0x88, 0x61,
+	0x02, 0x46, 0x0f, 0x60, 0x65, 0xe0, 0x68, 0xf6, 0x49, 0x90, 0xaf, 0x79, 0x61, 0xdc, 0x4c, 0x1a,
+	0x77, 0x40, 0x11, 0x5c, 0x00, 0xe7, 0x07, 0xd1, 0x00, 0x3d, 0x02, 0xb0, 0x6c, 0xe2, 0x68, 0x34,
+	0x7a, 0x5d, 0xbf, 0x08, 0xca, 0x33, 0x72, 0xfd, 0x5e, 0x3b, 0x44, 0xe2, 0x98, 0x54, 0xb9, 0x0a,
+	0x10, 0x71, 0xd0, 0xb7, 0x41, 0x0c, 0x79, 0x25, 0x21, 0x35, 0xe8, 0x03, 0x36, 0x8e, 0x90, 0xb2,
+	0x0d, 0xf9, 0x98, 0x91, 0xe8, 0x7d, 0x00, 0x73, 0x34, 0x54, 0x0d, 0x6d, 0xcc, 0x2b, 0x07, 0x2d,
+	0x53, 0xa2, 0x39, 0x1a, 0x36, 0x18, 0x01, 0x7d, 0x08, 0x79, 0xdd, 0xb4, 0x47, 0x9e, 0xea, 0xea,
+	0x9f, 0xfb, 0xe9, 0x2f, 0x87, 0x81, 0x91, 0x3a, 0x94, 0x82, 0xae, 0xc1, 0x8a, 0x35, 0xf2, 0x22,
+	0x44, 0x96, 0x21, 0xf2, 0x9c, 0xc6, 0x20, 0xcc, 0x8d, 0xa1, 0x29, 0x34, 0x20, 0x42, 0x63, 0xd4,
+	0xf0, 0xbc, 0x89, 0xb8, 0x10, 0x52, 0x59, 0xa9, 0x68, 0x4f, 0x77, 0x22, 0xdc, 0x69, 0x37, 0x66,
+	0xac, 0xf1, 0x8c, 0x26, 0xe4, 0xab, 0x2e, 0x9a, 0x3f, 0x87, 0x1c, 0xab, 0xe4, 0xa9, 0xe1, 0x74,
+	0x2b, 0xd1, 0x8b, 0x4d, 0xec, 0x0a, 0x13, 0x8b, 0xda, 0x30, 0x74, 0x17, 0x16, 0x5d, 0x4f, 0xf3,
+	0x46, 0x6e, 0x29, 0x9b, 0x16, 0x51, 0x1c, 0xce, 0x00, 0xd8, 0x07, 0xca, 0xbf, 0xce, 0x80, 0x18,
+	0xaa, 0xf9, 0x32, 0xed, 0x95, 0x06, 0x1b, 0x91, 0x97, 0x35, 0xd7, 0xd5, 0x07, 0x26, 0x6d, 0xea,
+	0x02, 0x53, 0x6e, 0xcf, 0xb0, 0x3c, 0xf2, 0x4b, 0x25, 0x92, 0xc1, 0xeb, 0x76, 0x0a, 0xb5, 0xfc,
+	0x23, 0x58, 0x4f, 0x43, 0xa3, 0x2a, 0xe4, 0xe3, 0x13, 0x72, 0xf7, 0x5f, 0x9b, 0xe1, 0xfe, 0x48,
+	0x10, 0xc7, 0xa5, 0xe4, 0xef, 0xc3, 0xe5, 0x14, 0xcc, 0x05, 0x8e, 0xf8, 0x17, 0x19, 0xc8, 0xc7,
+	0x3c, 0x4c, 0x8f, 0x83, 0xeb, 0x69, 0x8e, 0xa7, 0x7a, 0x7a, 0x28, 0x2f, 0x32, 0x4a, 0x57, 0x1f,
+	0x12, 0x74, 0x13, 0x56, 0x7b, 0xd6, 0xd0, 0x36, 0x08, 0x8f, 0x5e, 0x7d, 0x18, 0xa8, 0x2b, 0x46,
+	0x64, 0x06, 0x7c, 0x12, 0xef, 0x52, 0xb2, 0xac, 0xa0, 0xdc, 0x9e, 0xb9, 0xaf, 0x7b, 0x7e, 0x4f,
+	0xe8, 0xe3, 0x59, 0x85, 0x89, 0xc4, 0xd1, 0xc7, 0x90, 0xb7, 0x8e, 0x5d, 0xe2, 0xbc, 0xd1, 0x62,
+	0x3d, 0xcf, 0xe6, 0xe4, 0x0e, 0x87, 0x00, 0x1c, 0x47, 0xcb, 0x1e, 0xa0, 0x69, 0xed, 0x28, 0x0f,
+	0x4b, 0x55, 0xac, 0x54, 0xba, 0x4a, 0x4d, 0xba, 0x44, 0x07, 0xf8, 0xa8, 0xd5, 0xaa, 0xb7, 0x0e,
+	0x24, 0x01, 0x15, 0x40, 0xec, 0x1c, 0x55, 0xab, 0x8a, 0x52, 0x53, 0x6a, 0x52, 0x06, 0x01, 0x2c,
+	0x3e, 0xad, 0x37, 0x1a, 0x4a, 0x4d, 0xca, 0xd2, 0xef, 0xc7, 0x95, 0x3a, 0xfd, 0x5e, 0x40, 0x12,
+	0xac, 0x28, 0x15, 0xdc, 0x78, 0xd1, 0xe9, 0xb6, 0x0f, 0x0f, 0x95, 0x9a, 0x94, 0xa3, 0x5a, 0x8e,
+	0x5a, 0x4f, 0x5b, 0xed, 0xcf, 0x5a, 0xd2, 0xa2, 0xfc, 0x3d, 0xc8, 0xc7, 0x2c, 0x42, 0x7b, 0xb0,
+	0xc4, 0x4b, 0x61, 0xb0, 0xcf, 0xeb, 0x49, 0xeb, 0x79, 0x2d, 0xc4, 0x01, 0x48, 0xde, 0x87, 0x45,
+	0x4e, 0xba, 0xc0, 0x4e, 0xfe, 0x52, 0x80, 0x2d, 0x4c, 0x6c, 0xcb, 0xf1, 0x62, 0x33, 0x37, 0xac,
+	0x01, 0x26, 0x3f, 0x1d, 0x11, 0xd7, 0xa3, 0x3b, 0xcb, 0x1b, 0xf2, 0x98, 0x3e, 0x91, 0x51, 0x58,
+	0x01, 0x52, 0x60, 0x35, 0xe6, 0x36, 0xd5, 0xb0, 0x06, 0xe9, 0x37, 0xa9, 0x09, 0xe5, 0x45, 0x2b,
+	0x31, 0x96, 0xb7, 0x60, 0x33, 0xdd, 0x08, 0xdb, 0x18, 0x33, 0x13, 0x3b, 0x9e, 0x43, 0xb4, 0xe1,
+	0xd7, 0x69, 0xe2, 0x01, 0x6c, 0xa6, 0x1b, 0x61, 0x1b, 0x63, 0xb4, 0x0b, 0x6b, 0x7e, 0xd3, 0x62,
+	0x58, 0x03, 0xd7, 0xbf, 0xbc, 0xf0, 0xaa, 0xb0, 0xca, 0x19, 0x0d, 0x6b, 0xe0, 0xb2, 0xeb, 0x8b,
+	0xfc, 0x04, 0x8a, 0x49, 0x15, 0xe8, 0x3e, 0xe4, 0x63, 0xd2, 0xe9, 0x45, 0xa9, 0x19, 0x68, 0xc1,
+	0x10, 0x29, 0x94, 0x9f, 0x83, 0x18, 0x32, 0x98, 0x1f, 0xf4, 0x21, 0x51, 0x5d, 0x4f, 0x1b, 0xda,
+	0xa1, 0x1f, 0xf4, 0x21, 0xe9, 0x50, 0x02, 0xba, 0x0d, 0x8b, 0x5c, 0xd2, 0x5f, 0x7e, 0x7a, 0x30,
+	0xf9, 0x18, 0xf9, 0x8f, 0x19, 0x28, 0x1d, 0x90, 0x77, 0x0b, 0x8a, 0x0f, 0xc3, 0xf5, 0x30, 0x3e,
+	0x8f, 0x37, 0xdf, 0x6c, 0x06, 0x48, 0xa6, 0x8b, 0xec, 0x64, 0xba, 0xd8, 0x84, 0x65, 0x62, 0xf6,
+	0x39, 0x93, 0x77, 0xf6, 0x4b, 0xc4, 0xec, 0x33, 0xd6, 0x16, 0xbd, 0x70, 0x0c, 0x08, 0xab, 0x9a,
+	0xfe, 0x55, 0x72, 0x99, 0x12, 0x68, 0xc9, 0xa4, 0x6a, 0x19, 0xd3, 0xb3, 0x5e, 0x93, 0xa0, 0x7f,
+	0x67, 0xf0, 0x2e, 0x25, 0xa0, 0x07, 0x00, 0x7d, 0xeb, 0x67, 0xa6, 0xab, 0xd1, 0x94, 0x53, 0x5a,
+	0x4a, 0x8b, 0x81, 0x5a, 0xc8, 0xe7, 0x95, 0x2b, 0xc2, 0xcb, 0xbf, 0x15, 0xa0, 0x98, 0x64, 0xd3,
+	0xb7, 0x83, 0x58, 0xe7, 0x3b, 0x53, 0x55, 0xac, 0xf5, 0xdd, 0x02, 0x91, 0xbc, 0x21, 0xce, 0x58,
+	0x35, 0xbd, 0x13, 0xe6, 0x97, 0x1c, 0x5e, 0x66, 0x84, 0x96, 0x77, 0x42, 0xb3, 0xe4, 0xf1, 0xa8,
+	0xf7, 0x9a, 0x78, 0x6a, 0x7f, 0xe4, 0xf7, 0x27, 0xdc, 0x35, 0x45, 0x4e, 0xae, 0xf9, 0x54, 0xf9,
+	0x37, 0x02, 0x5c, 0x49, 0xd9, 0x1b, 0x1a, 0x88, 0x29, 0xc1, 0x2e, 0x5c, 0x3c, 0xd8, 0xe9, 0x55,
+	0xdc, 0x24, 0xa7, 0x9e, 0x1a, 0x73, 0x27, 0xdf, 0xc5, 0x02, 0x25, 0x1f, 0x06, 0x2e, 0x95, 0x1f,
+	0xc0, 0x56, 0x8d, 0x18, 0xc4, 0x23, 0xef, 0x12, 0x27, 0xf4, 0xd4, 0xa7, 0x4b, 0xd3, 0x53, 0xff,
+	0xfb, 0x0c, 0x6c, 0x1c, 0x10, 0xaf, 0x33, 0x1a, 0x0c, 0x88, 0xcb, 0x9b, 0x3a, 0x5f, 0xeb, 0x7d,
+	0x00, 0x12, 0x3e, 0xa4, 0xf8, 0xcb, 0x2b, 0xcd, 0x7a, 0x68, 0xc1, 0x31, 0x2c, 0xba, 0x05, 0x8b,
+	0x6c, 0xf6, 0xa0, 0x45, 0xbe, 0x9c, 0x52, 0x5b, 0xb0, 0x0f, 0xa1, 0x1d, 0x97, 0xc3, 0x67, 0x54,
+	0xcd, 0xd1, 0xf0, 0x98, 0x38, 0x6c, 0x37, 0x72, 0xb8, 0xe0, 0x53, 0x5b, 0x8c, 0x88, 0x1e, 0xc2,
+	0x9a, 0x6e, 0x86, 0xd7, 0x73, 0x5f, 0xfd, 0xc2, 0x6c, 0xf5, 0x52, 0x84, 0xee, 0xf2, 0x89, 0x3e,
+	0x82, 0x42, 0x64, 0xa3, 0xaa, 0xf7, 0x59, 0x5c, 0x8b, 0x78, 0x25, 0x22, 0xd6, 0xfb, 0xf2, 0x5f,
+	0xb3, 0x70, 0x79, 0xd2, 0x1d, 0x74, 0xc3, 0x5f, 0xcf, 0x6a, 0x45, 0x78, 0x16, 0xb9, 0x37, 0xd1,
+	0x67, 0x4f, 0x6b, 0xb8, 0x40, 0x53, 0x92, 0x7c, 0x56, 0xca, 0x5c, 0xe8, 0x59, 0xe9, 0x19, 0xac,
+	0x27, 0x9f, 0x95, 0x54, 0x67, 0x64, 0xf8, 0x8d, 0xef, 0xfc, 0xc7, 0x25, 0x3c, 0x32, 0x08, 0x46,
+	0x64, 0x92, 0xe4, 0xa2, 0x6f, 0xc1, 0x15, 0x97, 0x68, 0x4e, 0xef, 0x84, 0x3f, 0x8a, 0xa8, 0xe4,
+	0xf4, 0x44, 0x1b, 0xb9, 0x1e, 0xe9, 0xb3, 0xa4, 0xb1, 0x8c, 0xd7, 0x39, 0x97, 0xbd, 0x30, 0x28,
+	0x01, 0xaf, 0xfc, 0xf9, 0x7f, 0xb1, 0xb1, 0x9a, 0x08, 0xf8, 0xcc, 0x64, 0xc0, 0xff, 0x18, 0xb6,
+	0x3f, 0xd5, 0x0c, 0xbd, 0xaf, 0x79, 0x64, 0xf2, 0xc6, 0xf8, 0xe5, 0xa3, 0x5b, 0xde, 0x86, 0x0f,
+	0xe6, 0x68, 0xa7, 0x67, 0xea, 0x0b, 0x01, 0xde, 0x3b, 0x20, 0xde, 0x94, 0x7b, 0xff, 0xd7, 0x47,
+	0xeb, 0x36, 0xa0, 0xfe, 0xb1, 0x3a, 0xd4, 0x4c, 0x6d, 0x40, 0xa3, 0xb6, 0xdf, 0x77, 0x88, 0xeb,
+	0xfa, 0xc9, 0x4e, 0xea, 0x1f, 0x37, 0x39, 0xa3, 0xc2, 0xe9, 0xb4, 0x9c, 0x44, 0x4e, 0x0d, 0xde,
+	0x34, 0x20, 0xf4, 0xaa, 0x2b, 0xff, 0x3b, 0x03, 0xe5, 0x19, 0xcb, 0xa2, 0x47, 0x64, 0x56, 0xe8,
+	0x09, 0xef, 0x1e, 0x7a, 0xbf, 0x80, 0x32, 0x37, 0x29, 0x55, 0x31, 0xf7, 0xc0, 0xc3, 0xa9, 0xa3,
+	0x37, 0xc3, 0x40, 0xee, 0x9c, 0x14, 0xe6, 0x55, 0x2f, 0x9d, 0x51, 0xfe, 0x9d, 0x00, 0x57, 0x67,
+	0x08, 0x9d, 0x55, 0x9b, 0x67, 0x39, 0x23, 0xf3, 0xce, 0xce, 0x90, 0xff, 0x34, 0xf9, 0x82, 0x41,
+	0xc9, 0xe7, 0x6f, 0x41, 0x69, 0x5d, 0xa6, 0xd7, 0x00, 0xcd, 0xd1, 0xdd, 0xb0, 0xeb, 0x9f, 0x28,
+	0x57, 0xd5, 0x90, 0xcf, 0x8a, 0x69, 0x0c, 0x1f, 0xf5, 0x12, 0xe1, 0x43, 0x60, 0xce, 0xef, 0x25,
+	0x3a, 0x1e, 0xb1, 0xe5, 0x7b, 0xb0, 0xd1, 0x21, 0x5e, 0xfc, 0x36, 0x78, 0xbe, 0xda, 0xb4, 0x01,
+	0x97, 0x27, 0xe5, 0xe8, 0x09, 0xfa, 0x09, 0x5c, 0x0f, 0xce, 0x58, 0xda, 0x2b, 0xc9, 0x57, 0x70,
+	0x8a, 0xaf, 0x83, 0x7c, 0xc6, 0x0c, 0xb6, 0x31, 0xde, 0x3d, 0x8a, 0xbd, 0x3d, 0xb3, 0xab, 0x89,
+	0x04, 0x2b, 0xfe, 0x3d, 0x42, 0xed, 0xbe, 0x38, 0x54, 0xa4, 0x4b, 0xf4, 0xde, 0x51, 0x6b, 0x1f,
+	0x3d, 0x6a, 0x28, 0x92, 0x80, 0x96, 0x20, 0x5b, 0x6f, 0x75, 0xa5, 0x0c, 0x5a, 0x81, 0xe5, 0x5a,
+	0xbd, 0x53, 0xc5, 0x4a, 0x57, 0x91, 0xb2, 0x68, 0x15, 0xf2, 0xd5, 0x4a, 0x57, 0x39, 0x68, 0xe3,
+	0x7a, 0xb5, 0xd2, 0x90, 0x16, 0x76, 0xef, 0xc3, 0x4a, 0xfc, 0x65, 0x94, 0xdf, 0x4e, 0xea, 0x8f,
+	0xdb, 0xb8, 0x29, 0x5d, 0xa2, 0xe8, 0x46, 0xfb, 0x40, 0x0d, 0x08, 0x02, 0x9d, 0xa1, 0xd5, 0xc6,
+	0xcd, 0x4a, 0x43, 0xca, 0xec, 0xde, 0x8f, 0x3d, 0x87, 0x06, 0x77, 0xa5, 0xe0, 0x62, 0x73, 0x89,
+	0x4e, 0xdb, 0xac, 0xb7, 0xea, 0xcd, 0xfa, 0x0f, 0xa9, 0x35, 0x74, 0x54, 0x79, 0xce, 0x47, 0x99,
+	0xdd, 0x5e, 0xbc, 0xaf, 0x62, 0xa2, 0x6b, 0x50, 0x68, 0xb5, 0xd5, 0x5a, 0xfb, 0xb3, 0x56, 0xa7,
+	0xd2, 0x3c, 0x6c, 0xd0, 0xc5, 0x14, 0x40, 0x54, 0x3e, 0x55, 0xf0, 0x0b, 0xb5, 0xd5, 0xfd, 0x81,
+	0x24, 0xa0, 0x22, 0xc0, 0xa3, 0xa3, 0xea, 0x53, 0xa5, 0xab, 0x36, 0xeb, 0x2d, 0x29, 0x13, 0x1f,
+	0x57, 0x9e, 0xf3, 0x85, 0x05, 0x63, 0xa5, 0xd2, 0x92, 0x16, 0x76, 0x9f, 0x40, 0x31, 0x19, 0x43,
+	0xe8, 0x0a, 0xa0, 0xc0, 0x61, 0xd5, 0x76, 0xf3, 0xb0, 0x82, 0xeb, 0x9d, 0x36, 0x35, 0x55, 0x84,
+	0x9c, 0xf2, 0xec, 0xa8, 0xd2, 0x90, 0x04, 0xb4, 0x0c, 0x0b, 0x0d, 0xa5, 0xd3, 0x91, 0x32, 0x74,
+	0x31, 0x07, 0xec, 0xe2, 0x87, 0xa5, 0xec, 0xfe, 0x9f, 0xb3, 0x20, 0xd6, 0x1e, 0xf9, 0x49, 0x0a,
+	0xbd, 0x82, 0xf5, 0xb4, 0xab, 0x0b, 0xfa, 0xff, 0xe4, 0x6e, 0xcf, 0xb9, 0x63, 0x95, 0x6f, 0x9e,
+	0x07, 0x4a, 0x33, 0x99, 0x01, 0xeb, 0x69, 0x77, 0x90, 0xc9, 0xb9, 0xe6, 0x5c, 0x96, 0xca, 0x37,
+	0xcf, 0x03, 0xb5, 0x8d, 0xf1, 0x8e, 0x80, 0x34, 0x58, 0x9b, 0xea, 0x32, 0xd1, 0x8d, 0xa9, 0xac,
+	0x96, 0x3e, 0xcf, 0xf5, 0x33, 0x71, 0x74, 0x41, 0xaf, 0x60, 0x3d, 0xad, 0x03, 0x9c, 0x5c, 0xd0,
+	0x9c, 0x1e, 0xb3, 0x7c, 0xf3, 0x3c, 0x50, 0xdb, 0x18, 0xef, 0xff, 0x43, 0x00, 0x88, 0x9a, 0x1f,
+	0xf4, 0x1c, 0x8a, 0xc9, 0x6e, 0x08, 0x7d, 0x34, 0xbf, 0x57, 0xe2, 0xd3, 0x5d, 0x3b, 0xb3, 0xa1,
+	0x42, 0x63, 0xd8, 0x9c, 0x59, 0x87, 0xd1, 0x5e, 0x52, 0xfe, 0xac, 0x76, 0xa0, 0x7c, 0xfb, 0xdc,
+	0x78, 0xba, 0xc6, 0xbf, 0x67, 0xa0, 0x90, 0xc8, 0x1a, 0x68, 0xc8, 0xba, 0xe8, 0x94, 0x3a, 0xb1,
+	0x7b, 0xae, 0xf2, 0xc4, 0x8d, 0xd8, 0x39, 0x6f, 0x29, 0xa3, 0x5e, 0x4d, 0xa6, 0xcd, 0x49, 0xaf,
+	0xa6, 0x26, 0xe3, 0xf2, 0xb5, 0xf9, 0x20, 0xaa, 0xf9, 0xad, 0x00, 0xef, 0xcf, 0x4d, 0x8c, 0x68,
+	0x3f, 0xdd, 0x55, 0xf3, 0xf2, 0x74, 0xf9, 0xce, 0x85, 0x64, 0x6c, 0x63, 0x7c, 0xbc, 0xc8, 0xfe,
+	0x33, 0x7f, 0xf3, 0x3f, 0x03, 0x00, 0x57, 0xc4, 0x30, 0x4e, 0x74, 0x1e, 0x00, 0x00,
 }
@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='api.proto',
   package='api.v1.beta1',
   syntax='proto3',
-  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\"F\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\"\x96\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x37\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\x12\x1c\n\x14parallel_trial_count\x18\x05 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x06 \x01(\x05\x12+\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\x87\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\"E\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\"\x88\x01\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\"c\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"k\n\x11\x45\x61rlyStoppingSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12>\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSetting\"3\n\x14\x45\x61rlyStoppingSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"\xd8\x01\n\tTrialSpec\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xa1\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"t\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x05\x12\x0b\n\x07UNKNOWN\x10\x06\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"h\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x1b\n\x19ReportObservationLogReply\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"E\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\"i\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\"O\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"1\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"\x82\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x16\n\x0erequest_number\x18\x03 \x01(\x05\"\xab\x02\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12=\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply\"\x8d\x01\n\x1cGetEarlyStoppingRulesRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x12\x64\x62_manager_address\x18\x03 \x01(\t\"[\n\x1aGetEarlyStoppingRulesReply\x12=\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\"v\n\x11\x45\x61rlyStoppingRule\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x30\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonType\x12\x12\n\nstart_step\x18\x04 \x01(\x05\"+\n\x15SetTrialStatusRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x15\n\x13SetTrialStatusReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03\x32\xc6\x02\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xd8\x01\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReplyb\x06proto3')
+  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\"F\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\"\x96\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x37\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\x12\x1c\n\x14parallel_trial_count\x18\x05 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x06 \x01(\x05\x12+\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\xbc\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\x12\x33\n\tcondition\x18\x04 \x01(\x0b\x32 .api.v1.beta1.ParameterCondition\"7\n\x12ParameterCondition\x12\x11\n\tparameter\x18\x01 \x01(\t\x12\x0e\n\x06values\x18\x02 \x03(\t\"\x92\x01\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\x12\x30\n\x0c\x64istribution\x18\x05 \x01(\x0e\x32\x1a.api.v1.beta1.Distribution\x12\x0c\n\x04mean\x18\x06 \x01(\t\x12\x0b\n\x03std\x18\x07 \x01(\t\"\xb5\x01\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\x12+\n\nobjectives\x18\x05 \x03(\x0b\x32\x17.api.v1.beta1.Objective\"c\n\tObjective\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\"c\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"k\n\x11\x45\x61rlyStoppingSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12>\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSetting\"3\n\x14\x45\x61rlyStoppingSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"\xd8\x01\n\tTrialSpec\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\xa1\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"t\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x05\x12\x0b\n\x07UNKNOWN\x10\x06\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"h\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x1b\n\x19ReportObservationLogReply\"h\n\x1bStreamObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"6\n\x19StreamObservationLogReply\x12\x19\n\x11metric_logs_count\x18\x01 \x01(\x05\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"E\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\"\xc2\x01\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\x12\x11\n\tpage_size\x18\x05 \x01(\x05\x12\x12\n\npage_token\x18\x06 \x01(\t\x12\x30\n\ndownsample\x18\x07 \x01(\x0b\x32\x1c.api.v1.beta1.DownsampleSpec\"h\n\x0e\x44ownsampleSpec\x12*\n\x04type\x18\x01 \x01(\x0e\x32\x1c.api.v1.beta1.DownsampleType\x12\x11\n\tevery_nth\x18\x02 \x01(\x05\x12\x17\n\x0f\x62ucket_duration\x18\x03 \x01(\t\"h\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"1\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"\xc9\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x16\n\x0erequest_number\x18\x03 \x01(\x05\x12.\n\x11infeasible_trials\x18\x04 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x15\n\rexperiment_id\x18\x05 \x01(\t\"\xdf\x02\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12=\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\x12\x1e\n\x16search_space_exhausted\x18\x04 \x01(\x08\x1a\x62\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\x12\x12\n\ntrial_name\x18\x02 \x01(\t\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply\"\xa2\x01\n\x1cGetEarlyStoppingRulesRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x1a\n\x12\x64\x62_manager_address\x18\x03 \x01(\t\x12\x13\n\x0btrial_names\x18\x04 \x03(\t\"\xaf\x02\n\x1aGetEarlyStoppingRulesReply\x12=\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\x12\x64\n\x1atrial_early_stopping_rules\x18\x02 \x03(\x0b\x32@.api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules\x1al\n\x17TrialEarlyStoppingRules\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12=\n\x14\x65\x61rly_stopping_rules\x18\x02 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRule\"v\n\x11\x45\x61rlyStoppingRule\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x30\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonType\x12\x12\n\nstart_step\x18\x04 \x01(\x05\"+\n\x15SetTrialStatusRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x15\n\x13SetTrialStatusReply\"T\n$ValidateEarlyStoppingSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\"$\n\"ValidateEarlyStoppingSettingsReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*8\n\x0c\x44istribution\x12\x0b\n\x07UNIFORM\x10\x00\x12\x0f\n\x0bLOG_UNIFORM\x10\x01\x12\n\n\x06NORMAL\x10\x02*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*c\n\x0e\x44ownsampleType\x12\x11\n\rNO_DOWNSAMPLE\x10\x00\x12\r\n\tEVERY_NTH\x10\x01\x12\x0e\n\nBUCKET_MIN\x10\x02\x12\x0e\n\nBUCKET_MAX\x10\x03\x12\x0f\n\x0b\x42UCKET_MEAN\x10\x04*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03\x32\xb4\x03\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12l\n\x14StreamObservationLog\x12).api.v1.beta1.StreamObservationLogRequest\x1a\'.api.v1.beta1.StreamObservationLogReply(\x01\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xe0\x02\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReply\x12\x85\x01\n\x1dValidateEarlyStoppingSettings\x12\x32.api.v1.beta1.ValidateEarlyStoppingSettingsRequest\x1a\x30.api.v1.beta1.ValidateEarlyStoppingSettingsReplyb\x06proto3')
 )
 
 _PARAMETERTYPE = _descriptor.EnumDescriptor(
@@ -52,12 +52,39 @@ _PARAMETERTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=3868,
-  serialized_end=3953,
+  serialized_start=5066,
+  serialized_end=5151,
 )
 _sym_db.RegisterEnumDescriptor(_PARAMETERTYPE)
 
 ParameterType = enum_type_wrapper.EnumTypeWrapper(_PARAMETERTYPE)
+_DISTRIBUTION = _descriptor.EnumDescriptor(
+  name='Distribution',
+  full_name='api.v1.beta1.Distribution',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='UNIFORM', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_UNIFORM', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='NORMAL', index=2, number=2,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=5153,
+  serialized_end=5209,
+)
+_sym_db.RegisterEnumDescriptor(_DISTRIBUTION)
+
+Distribution = enum_type_wrapper.EnumTypeWrapper(_DISTRIBUTION)
 _OBJECTIVETYPE = _descriptor.EnumDescriptor(
   name='ObjectiveType',
   full_name='api.v1.beta1.ObjectiveType',
@@ -79,12 +106,47 @@ _OBJECTIVETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=3955,
-  serialized_end=4011,
+  serialized_start=5211,
+  serialized_end=5267,
 )
 _sym_db.RegisterEnumDescriptor(_OBJECTIVETYPE)
 
 ObjectiveType = enum_type_wrapper.EnumTypeWrapper(_OBJECTIVETYPE)
+_DOWNSAMPLETYPE = _descriptor.EnumDescriptor(
+  name='DownsampleType',
+  full_name='api.v1.beta1.DownsampleType',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='NO_DOWNSAMPLE', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='EVERY_NTH', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BUCKET_MIN', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BUCKET_MAX', index=3, number=3,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='BUCKET_MEAN', index=4, number=4,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=5269,
+  serialized_end=5368,
+)
+_sym_db.RegisterEnumDescriptor(_DOWNSAMPLETYPE)
+
+DownsampleType = enum_type_wrapper.EnumTypeWrapper(_DOWNSAMPLETYPE)
 _COMPARISONTYPE = _descriptor.EnumDescriptor(
   name='ComparisonType',
   full_name='api.v1.beta1.ComparisonType',
@@ -110,8 +172,8 @@ _COMPARISONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=4013,
-  serialized_end=4087,
+  serialized_start=5370,
+  serialized_end=5444,
 )
 _sym_db.RegisterEnumDescriptor(_COMPARISONTYPE)
 
@@ -121,9 +183,17 @@ DOUBLE = 1
 INT = 2
 DISCRETE = 3
 CATEGORICAL = 4
+UNIFORM = 0
+LOG_UNIFORM = 1
+NORMAL = 2
 UNKNOWN = 0
 MINIMIZE = 1
 MAXIMIZE = 2
+NO_DOWNSAMPLE = 0
+EVERY_NTH = 1
+BUCKET_MIN = 2
+BUCKET_MAX = 3
+BUCKET_MEAN = 4
 UNKNOWN_COMPARISON = 0
 EQUAL = 1
 LESS = 2
@@ -167,8 +237,8 @@ _TRIALSTATUS_TRIALCONDITIONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=2143,
-  serialized_end=2259,
+  serialized_start=2477,
+  serialized_end=2593,
 )
 _sym_db.RegisterEnumDescriptor(_TRIALSTATUS_TRIALCONDITIONTYPE)
 
@@ -342,6 +412,13 @@ _PARAMETERSPEC = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='condition', full_name='api.v1.beta1.ParameterSpec.condition', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -355,7 +432,45 @@ _PARAMETERSPEC = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=509,
-  serialized_end=644,
+  serialized_end=697,
+)
+
+
+_PARAMETERCONDITION = _descriptor.Descriptor(
+  name='ParameterCondition',
+  full_name='api.v1.beta1.ParameterCondition',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='parameter', full_name='api.v1.beta1.ParameterCondition.parameter', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='api.v1.beta1.ParameterCondition.values', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=699,
+  serialized_end=754,
 )
 
 
@@ -394,6 +509,27 @@ _FEASIBLESPACE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='distribution', full_name='api.v1.beta1.FeasibleSpace.distribution', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='mean', full_name='api.v1.beta1.FeasibleSpace.mean', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='std', full_name='api.v1.beta1.FeasibleSpace.std', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -406,8 +542,8 @@ _FEASIBLESPACE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=646,
-  serialized_end=715,
+  serialized_start=757,
+  serialized_end=903,
 )
 
 
@@ -446,6 +582,13 @@ _OBJECTIVESPEC = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='objectives', full_name='api.v1.beta1.ObjectiveSpec.objectives', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -458,8 +601,53 @@ _OBJECTIVESPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=718,
-  serialized_end=854,
+  serialized_start=906,
+  serialized_end=1087,
+)
+
+
+_OBJECTIVE = _descriptor.Descriptor(
+  name='Objective',
+  full_name='api.v1.beta1.Objective',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='api.v1.beta1.Objective.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='goal', full_name='api.v1.beta1.Objective.goal', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='objective_metric_name', full_name='api.v1.beta1.Objective.objective_metric_name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1089,
+  serialized_end=1188,
 )
 
 
@@ -496,8 +684,8 @@ _ALGORITHMSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=856,
-  serialized_end=955,
+  serialized_start=1190,
+  serialized_end=1289,
 )
 
 
@@ -534,8 +722,8 @@ _ALGORITHMSETTING = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=957,
-  serialized_end=1004,
+  serialized_start=1291,
+  serialized_end=1338,
 )
 
 
@@ -572,8 +760,8 @@ _EARLYSTOPPINGSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1006,
-  serialized_end=1113,
+  serialized_start=1340,
+  serialized_end=1447,
 )
 
 
@@ -610,8 +798,8 @@ _EARLYSTOPPINGSETTING = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1115,
-  serialized_end=1166,
+  serialized_start=1449,
+  serialized_end=1500,
 )
 
 
@@ -641,8 +829,8 @@ _NASCONFIG_OPERATIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1287,
-  serialized_end=1343,
+  serialized_start=1621,
+  serialized_end=1677,
 )
 
 _NASCONFIG = _descriptor.Descriptor(
@@ -678,8 +866,8 @@ _NASCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1169,
-  serialized_end=1343,
+  serialized_start=1503,
+  serialized_end=1677,
 )
 
 
@@ -723,8 +911,8 @@ _GRAPHCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1345,
-  serialized_end=1421,
+  serialized_start=1679,
+  serialized_end=1755,
 )
 
 
@@ -791,8 +979,8 @@ _OPERATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1424,
-  serialized_end=1591,
+  serialized_start=1758,
+  serialized_end=1925,
 )
 
 
@@ -836,8 +1024,8 @@ _TRIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1593,
-  serialized_end=1696,
+  serialized_start=1927,
+  serialized_end=2030,
 )
 
 
@@ -867,8 +1055,8 @@ _TRIALSPEC_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1837,
-  serialized_end=1915,
+  serialized_start=2171,
+  serialized_end=2249,
 )
 
 _TRIALSPEC = _descriptor.Descriptor(
@@ -904,8 +1092,8 @@ _TRIALSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1699,
-  serialized_end=1915,
+  serialized_start=2033,
+  serialized_end=2249,
 )
 
 
@@ -942,8 +1130,8 @@ _PARAMETERASSIGNMENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1917,
-  serialized_end=1967,
+  serialized_start=2251,
+  serialized_end=2301,
 )
 
 
@@ -995,8 +1183,8 @@ _TRIALSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1970,
-  serialized_end=2259,
+  serialized_start=2304,
+  serialized_end=2593,
 )
 
 
@@ -1026,8 +1214,8 @@ _OBSERVATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2261,
-  serialized_end=2313,
+  serialized_start=2595,
+  serialized_end=2647,
 )
 
 
@@ -1064,8 +1252,8 @@ _METRIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2315,
-  serialized_end=2352,
+  serialized_start=2649,
+  serialized_end=2686,
 )
 
 
@@ -1102,8 +1290,8 @@ _REPORTOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2354,
-  serialized_end=2458,
+  serialized_start=2688,
+  serialized_end=2792,
 )
 
 
@@ -1126,8 +1314,77 @@ _REPORTOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2460,
-  serialized_end=2487,
+  serialized_start=2794,
+  serialized_end=2821,
+)
+
+
+_STREAMOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
+  name='StreamObservationLogRequest',
+  full_name='api.v1.beta1.StreamObservationLogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.StreamObservationLogRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='observation_log', full_name='api.v1.beta1.StreamObservationLogRequest.observation_log', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2823,
+  serialized_end=2927,
+)
+
+
+_STREAMOBSERVATIONLOGREPLY = _descriptor.Descriptor(
+  name='StreamObservationLogReply',
+  full_name='api.v1.beta1.StreamObservationLogReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='metric_logs_count', full_name='api.v1.beta1.StreamObservationLogReply.metric_logs_count', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2929,
+  serialized_end=2983,
 )
 
 
@@ -1157,8 +1414,8 @@ _OBSERVATIONLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2489,
-  serialized_end=2551,
+  serialized_start=2985,
+  serialized_end=3047,
 )
 
 
@@ -1195,8 +1452,8 @@ _METRICLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2553,
-  serialized_end=2622,
+  serialized_start=3049,
+  serialized_end=3118,
 )
 
 
@@ -1235,6 +1492,27 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='page_size', full_name='api.v1.beta1.GetObservationLogRequest.page_size', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='page_token', full_name='api.v1.beta1.GetObservationLogRequest.page_token', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='downsample', full_name='api.v1.beta1.GetObservationLogRequest.downsample', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1247,8 +1525,53 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2624,
-  serialized_end=2729,
+  serialized_start=3121,
+  serialized_end=3315,
+)
+
+
+_DOWNSAMPLESPEC = _descriptor.Descriptor(
+  name='DownsampleSpec',
+  full_name='api.v1.beta1.DownsampleSpec',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='api.v1.beta1.DownsampleSpec.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='every_nth', full_name='api.v1.beta1.DownsampleSpec.every_nth', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='bucket_duration', full_name='api.v1.beta1.DownsampleSpec.bucket_duration', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3317,
+  serialized_end=3421,
 )
 
 
@@ -1266,6 +1589,13 @@ _GETOBSERVATIONLOGREPLY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='next_page_token', full_name='api.v1.beta1.GetObservationLogReply.next_page_token', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1278,8 +1608,8 @@ _GETOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2731,
-  serialized_end=2810,
+  serialized_start=3423,
+  serialized_end=3527,
 )
 
 
@@ -1309,8 +1639,8 @@ _DELETEOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2812,
-  serialized_end=2861,
+  serialized_start=3529,
+  serialized_end=3578,
 )
 
 
@@ -1333,8 +1663,8 @@ _DELETEOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2863,
-  serialized_end=2890,
+  serialized_start=3580,
+  serialized_end=3607,
 )
 
 
@@ -1366,6 +1696,20 @@ _GETSUGGESTIONSREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='infeasible_trials', full_name='api.v1.beta1.GetSuggestionsRequest.infeasible_trials', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='experiment_id', full_name='api.v1.beta1.GetSuggestionsRequest.experiment_id', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1378,8 +1722,8 @@ _GETSUGGESTIONSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2893,
-  serialized_end=3023,
+  serialized_start=3610,
+  serialized_end=3811,
 )
 
 
@@ -1397,6 +1741,13 @@ _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.GetSuggestionsReply.ParameterAssignments.trial_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1409,8 +1760,8 @@ _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1837,
-  serialized_end=1915,
+  serialized_start=4067,
+  serialized_end=4165,
 )
 
 _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
@@ -1441,6 +1792,13 @@ _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='search_space_exhausted', full_name='api.v1.beta1.GetSuggestionsReply.search_space_exhausted', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1453,8 +1811,8 @@ _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3026,
-  serialized_end=3325,
+  serialized_start=3814,
+  serialized_end=4165,
 )
 
 
@@ -1484,8 +1842,8 @@ _VALIDATEALGORITHMSETTINGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3327,
-  serialized_end=3407,
+  serialized_start=4167,
+  serialized_end=4247,
 )
 
 
@@ -1508,8 +1866,8 @@ _VALIDATEALGORITHMSETTINGSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3409,
-  serialized_end=3441,
+  serialized_start=4249,
+  serialized_end=4281,
 )
 
 
@@ -1541,6 +1899,13 @@ _GETEARLYSTOPPINGRULESREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_names', full_name='api.v1.beta1.GetEarlyStoppingRulesRequest.trial_names', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1553,11 +1918,48 @@ _GETEARLYSTOPPINGRULESREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3444,
-  serialized_end=3585,
+  serialized_start=4284,
+  serialized_end=4446,
 )
 
 
+_GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES = _descriptor.Descriptor(
+  name='TrialEarlyStoppingRules',
+  full_name='api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='early_stopping_rules', full_name='api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules.early_stopping_rules', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4644,
+  serialized_end=4752,
+)
+
 _GETEARLYSTOPPINGRULESREPLY = _descriptor.Descriptor(
   name='GetEarlyStoppingRulesReply',
   full_name='api.v1.beta1.GetEarlyStoppingRulesReply',
@@ -1572,10 +1974,17 @@ _GETEARLYSTOPPINGRULESREPLY = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_early_stopping_rules', full_name='api.v1.beta1.GetEarlyStoppingRulesReply.trial_early_stopping_rules', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES, ],
   enum_types=[
   ],
   options=None,
@@ -1584,8 +1993,8 @@ _GETEARLYSTOPPINGRULESREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3587,
-  serialized_end=3678,
+  serialized_start=4449,
+  serialized_end=4752,
 )
 
 
@@ -1636,8 +2045,8 @@ _EARLYSTOPPINGRULE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3680,
-  serialized_end=3798,
+  serialized_start=4754,
+  serialized_end=4872,
 )
 
 
@@ -1667,8 +2076,8 @@ _SETTRIALSTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3800,
-  serialized_end=3843,
+  serialized_start=4874,
+  serialized_end=4917,
 )
 
 
@@ -1691,8 +2100,63 @@ _SETTRIALSTATUSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3845,
-  serialized_end=3866,
+  serialized_start=4919,
+  serialized_end=4940,
+)
+
+
+_VALIDATEEARLYSTOPPINGSETTINGSREQUEST = _descriptor.Descriptor(
+  name='ValidateEarlyStoppingSettingsRequest',
+  full_name='api.v1.beta1.ValidateEarlyStoppingSettingsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='experiment', full_name='api.v1.beta1.ValidateEarlyStoppingSettingsRequest.experiment', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4942,
+  serialized_end=5026,
+)
+
+
+_VALIDATEEARLYSTOPPINGSETTINGSREPLY = _descriptor.Descriptor(
+  name='ValidateEarlyStoppingSettingsReply',
+  full_name='api.v1.beta1.ValidateEarlyStoppingSettingsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5028,
+  serialized_end=5064,
 )
 
 _EXPERIMENT.fields_by_name['spec'].message_type = _EXPERIMENTSPEC
@@ -1705,7 +2169,11 @@ _EXPERIMENTSPEC.fields_by_name['early_stopping'].message_type = _EARLYSTOPPINGSP
 _EXPERIMENTSPEC.fields_by_name['nas_config'].message_type = _NASCONFIG
 _PARAMETERSPEC.fields_by_name['parameter_type'].enum_type = _PARAMETERTYPE
 _PARAMETERSPEC.fields_by_name['feasible_space'].message_type = _FEASIBLESPACE
+_PARAMETERSPEC.fields_by_name['condition'].message_type = _PARAMETERCONDITION
+_FEASIBLESPACE.fields_by_name['distribution'].enum_type = _DISTRIBUTION
 _OBJECTIVESPEC.fields_by_name['type'].enum_type = _OBJECTIVETYPE
+_OBJECTIVESPEC.fields_by_name['objectives'].message_type = _OBJECTIVE
+_OBJECTIVE.fields_by_name['type'].enum_type = _OBJECTIVETYPE
 _ALGORITHMSPEC.fields_by_name['algorithm_settings'].message_type = _ALGORITHMSETTING
 _EARLYSTOPPINGSPEC.fields_by_name['algorithm_settings'].message_type = _EARLYSTOPPINGSETTING
 _NASCONFIG_OPERATIONS.fields_by_name['operation'].message_type = _OPERATION
@@ -1726,11 +2194,15 @@ _TRIALSTATUS.fields_by_name['observation'].message_type = _OBSERVATION
 _TRIALSTATUS_TRIALCONDITIONTYPE.containing_type = _TRIALSTATUS
 _OBSERVATION.fields_by_name['metrics'].message_type = _METRIC
 _REPORTOBSERVATIONLOGREQUEST.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
+_STREAMOBSERVATIONLOGREQUEST.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
 _OBSERVATIONLOG.fields_by_name['metric_logs'].message_type = _METRICLOG
 _METRICLOG.fields_by_name['metric'].message_type = _METRIC
+_GETOBSERVATIONLOGREQUEST.fields_by_name['downsample'].message_type = _DOWNSAMPLESPEC
+_DOWNSAMPLESPEC.fields_by_name['type'].enum_type = _DOWNSAMPLETYPE
 _GETOBSERVATIONLOGREPLY.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
 _GETSUGGESTIONSREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 _GETSUGGESTIONSREQUEST.fields_by_name['trials'].message_type = _TRIAL
+_GETSUGGESTIONSREQUEST.fields_by_name['infeasible_trials'].message_type = _TRIAL
 _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS.fields_by_name['assignments'].message_type = _PARAMETERASSIGNMENT
 _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS.containing_type = _GETSUGGESTIONSREPLY
 _GETSUGGESTIONSREPLY.fields_by_name['parameter_assignments'].message_type = _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS
@@ -1739,13 +2211,19 @@ _GETSUGGESTIONSREPLY.fields_by_name['early_stopping_rules'].message_type = _EARL
 _VALIDATEALGORITHMSETTINGSREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 _GETEARLYSTOPPINGRULESREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 _GETEARLYSTOPPINGRULESREQUEST.fields_by_name['trials'].message_type = _TRIAL
+_GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES.fields_by_name['early_stopping_rules'].message_type = _EARLYSTOPPINGRULE
+_GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES.containing_type = _GETEARLYSTOPPINGRULESREPLY
 _GETEARLYSTOPPINGRULESREPLY.fields_by_name['early_stopping_rules'].message_type = _EARLYSTOPPINGRULE
+_GETEARLYSTOPPINGRULESREPLY.fields_by_name['trial_early_stopping_rules'].message_type = _GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES
 _EARLYSTOPPINGRULE.fields_by_name['comparison'].enum_type = _COMPARISONTYPE
+_VALIDATEEARLYSTOPPINGSETTINGSREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 DESCRIPTOR.message_types_by_name['Experiment'] = _EXPERIMENT
 DESCRIPTOR.message_types_by_name['ExperimentSpec'] = _EXPERIMENTSPEC
 DESCRIPTOR.message_types_by_name['ParameterSpec'] = _PARAMETERSPEC
+DESCRIPTOR.message_types_by_name['ParameterCondition'] = _PARAMETERCONDITION
 DESCRIPTOR.message_types_by_name['FeasibleSpace'] = _FEASIBLESPACE
 DESCRIPTOR.message_types_by_name['ObjectiveSpec'] = _OBJECTIVESPEC
+DESCRIPTOR.message_types_by_name['Objective'] = _OBJECTIVE
 DESCRIPTOR.message_types_by_name['AlgorithmSpec'] = _ALGORITHMSPEC
 DESCRIPTOR.message_types_by_name['AlgorithmSetting'] = _ALGORITHMSETTING
 DESCRIPTOR.message_types_by_name['EarlyStoppingSpec'] = _EARLYSTOPPINGSPEC
@@ -1761,9 +2239,12 @@ DESCRIPTOR.message_types_by_name['Observation'] = _OBSERVATION
 DESCRIPTOR.message_types_by_name['Metric'] = _METRIC
 DESCRIPTOR.message_types_by_name['ReportObservationLogRequest'] = _REPORTOBSERVATIONLOGREQUEST
 DESCRIPTOR.message_types_by_name['ReportObservationLogReply'] = _REPORTOBSERVATIONLOGREPLY
+DESCRIPTOR.message_types_by_name['StreamObservationLogRequest'] = _STREAMOBSERVATIONLOGREQUEST
+DESCRIPTOR.message_types_by_name['StreamObservationLogReply'] = _STREAMOBSERVATIONLOGREPLY
 DESCRIPTOR.message_types_by_name['ObservationLog'] = _OBSERVATIONLOG
 DESCRIPTOR.message_types_by_name['MetricLog'] = _METRICLOG
 DESCRIPTOR.message_types_by_name['GetObservationLogRequest'] = _GETOBSERVATIONLOGREQUEST
+DESCRIPTOR.message_types_by_name['DownsampleSpec'] = _DOWNSAMPLESPEC
 DESCRIPTOR.message_types_by_name['GetObservationLogReply'] = _GETOBSERVATIONLOGREPLY
 DESCRIPTOR.message_types_by_name['DeleteObservationLogRequest'] = _DELETEOBSERVATIONLOGREQUEST
 DESCRIPTOR.message_types_by_name['DeleteObservationLogReply'] = _DELETEOBSERVATIONLOGREPLY
@@ -1776,8 +2257,12 @@ DESCRIPTOR.message_types_by_name['GetEarlyStoppingRulesReply'] = _GETEARLYSTOPPI
 DESCRIPTOR.message_types_by_name['EarlyStoppingRule'] = _EARLYSTOPPINGRULE
 DESCRIPTOR.message_types_by_name['SetTrialStatusRequest'] = _SETTRIALSTATUSREQUEST
 DESCRIPTOR.message_types_by_name['SetTrialStatusReply'] = _SETTRIALSTATUSREPLY
+DESCRIPTOR.message_types_by_name['ValidateEarlyStoppingSettingsRequest'] = _VALIDATEEARLYSTOPPINGSETTINGSREQUEST
+DESCRIPTOR.message_types_by_name['ValidateEarlyStoppingSettingsReply'] = _VALIDATEEARLYSTOPPINGSETTINGSREPLY
 DESCRIPTOR.enum_types_by_name['ParameterType'] = _PARAMETERTYPE
+DESCRIPTOR.enum_types_by_name['Distribution'] = _DISTRIBUTION
 DESCRIPTOR.enum_types_by_name['ObjectiveType'] = _OBJECTIVETYPE
+DESCRIPTOR.enum_types_by_name['DownsampleType'] = _DOWNSAMPLETYPE
 DESCRIPTOR.enum_types_by_name['ComparisonType'] = _COMPARISONTYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -1810,6 +2295,13 @@ ParameterSpec = _reflection.GeneratedProtocolMessageType('ParameterSpec', (_mess
   ))
 _sym_db.RegisterMessage(ParameterSpec)
 
+ParameterCondition = _reflection.GeneratedProtocolMessageType('ParameterCondition', (_message.Message,), dict(
+  DESCRIPTOR = _PARAMETERCONDITION,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ParameterCondition)
+  ))
+_sym_db.RegisterMessage(ParameterCondition)
+
 FeasibleSpace = _reflection.GeneratedProtocolMessageType('FeasibleSpace', (_message.Message,), dict(
   DESCRIPTOR = _FEASIBLESPACE,
   __module__ = 'api_pb2'
@@ -1824,6 +2316,13 @@ ObjectiveSpec = _reflection.GeneratedProtocolMessageType('ObjectiveSpec', (_mess
   ))
 _sym_db.RegisterMessage(ObjectiveSpec)
 
+Objective = _reflection.GeneratedProtocolMessageType('Objective', (_message.Message,), dict(
+  DESCRIPTOR = _OBJECTIVE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.Objective)
+  ))
+_sym_db.RegisterMessage(Objective)
+
 AlgorithmSpec = _reflection.GeneratedProtocolMessageType('AlgorithmSpec', (_message.Message,), dict(
   DESCRIPTOR = _ALGORITHMSPEC,
   __module__ = 'api_pb2'
@@ -1953,6 +2452,20 @@ ReportObservationLogReply = _reflection.GeneratedProtocolMessageType('ReportObse
   ))
 _sym_db.RegisterMessage(ReportObservationLogReply)
 
+StreamObservationLogRequest = _reflection.GeneratedProtocolMessageType('StreamObservationLogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMOBSERVATIONLOGREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.StreamObservationLogRequest)
+  ))
+_sym_db.RegisterMessage(StreamObservationLogRequest)
+
+StreamObservationLogReply = _reflection.GeneratedProtocolMessageType('StreamObservationLogReply', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMOBSERVATIONLOGREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.StreamObservationLogReply)
+  ))
+_sym_db.RegisterMessage(StreamObservationLogReply)
+
 ObservationLog = _reflection.GeneratedProtocolMessageType('ObservationLog', (_message.Message,), dict(
   DESCRIPTOR = _OBSERVATIONLOG,
   __module__ = 'api_pb2'
@@ -1974,6 +2487,13 @@ GetObservationLogRequest = _reflection.GeneratedProtocolMessageType('GetObservat
   ))
 _sym_db.RegisterMessage(GetObservationLogRequest)
 
+DownsampleSpec = _reflection.GeneratedProtocolMessageType('DownsampleSpec', (_message.Message,), dict(
+  DESCRIPTOR = _DOWNSAMPLESPEC,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.DownsampleSpec)
+  ))
+_sym_db.RegisterMessage(DownsampleSpec)
+
 GetObservationLogReply = _reflection.GeneratedProtocolMessageType('GetObservationLogReply', (_message.Message,), dict(
   DESCRIPTOR = _GETOBSERVATIONLOGREPLY,
   __module__ = 'api_pb2'
@@ -2039,11 +2559,19 @@ GetEarlyStoppingRulesRequest = _reflection.GeneratedProtocolMessageType('GetEarl
 _sym_db.RegisterMessage(GetEarlyStoppingRulesRequest)
 
 GetEarlyStoppingRulesReply = _reflection.GeneratedProtocolMessageType('GetEarlyStoppingRulesReply', (_message.Message,), dict(
+
+  TrialEarlyStoppingRules = _reflection.GeneratedProtocolMessageType('TrialEarlyStoppingRules', (_message.Message,), dict(
+    DESCRIPTOR = _GETEARLYSTOPPINGRULESREPLY_TRIALEARLYSTOPPINGRULES,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:api.v1.beta1.GetEarlyStoppingRulesReply.TrialEarlyStoppingRules)
+    ))
+  ,
   DESCRIPTOR = _GETEARLYSTOPPINGRULESREPLY,
   __module__ = 'api_pb2'
   # @@protoc_insertion_point(class_scope:api.v1.beta1.GetEarlyStoppingRulesReply)
   ))
 _sym_db.RegisterMessage(GetEarlyStoppingRulesReply)
+_sym_db.RegisterMessage(GetEarlyStoppingRulesReply.TrialEarlyStoppingRules)
 
 EarlyStoppingRule = _reflection.GeneratedProtocolMessageType('EarlyStoppingRule', (_message.Message,), dict(
   DESCRIPTOR = _EARLYSTOPPINGRULE,
@@ -2066,6 +2594,20 @@ SetTrialStatusReply = _reflection.GeneratedProtocolMessageType('SetTrialStatusRe
   ))
 _sym_db.RegisterMessage(SetTrialStatusReply)
 
+ValidateEarlyStoppingSettingsRequest = _reflection.GeneratedProtocolMessageType('ValidateEarlyStoppingSettingsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEEARLYSTOPPINGSETTINGSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ValidateEarlyStoppingSettingsRequest)
+  ))
+_sym_db.RegisterMessage(ValidateEarlyStoppingSettingsRequest)
+
+ValidateEarlyStoppingSettingsReply = _reflection.GeneratedProtocolMessageType('ValidateEarlyStoppingSettingsReply', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEEARLYSTOPPINGSETTINGSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ValidateEarlyStoppingSettingsReply)
+  ))
+_sym_db.RegisterMessage(ValidateEarlyStoppingSettingsReply)
+
 
 
 _DBMANAGER = _descriptor.ServiceDescriptor(
@@ -2074,8 +2616,8 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=4090,
-  serialized_end=4416,
+  serialized_start=5447,
+  serialized_end=5883,
   methods=[
   _descriptor.MethodDescriptor(
     name='ReportObservationLog',
@@ -2086,10 +2628,19 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
     output_type=_REPORTOBSERVATIONLOGREPLY,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='StreamObservationLog',
+    full_name='api.v1.beta1.DBManager.StreamObservationLog',
+    index=1,
+    containing_service=None,
+    input_type=_STREAMOBSERVATIONLOGREQUEST,
+    output_type=_STREAMOBSERVATIONLOGREPLY,
+    options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='GetObservationLog',
     full_name='api.v1.beta1.DBManager.GetObservationLog',
-    index=1,
+    index=2,
     containing_service=None,
     input_type=_GETOBSERVATIONLOGREQUEST,
     output_type=_GETOBSERVATIONLOGREPLY,
@@ -2098,7 +2649,7 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='DeleteObservationLog',
     full_name='api.v1.beta1.DBManager.DeleteObservationLog',
-    index=2,
+    index=3,
     containing_service=None,
     input_type=_DELETEOBSERVATIONLOGREQUEST,
     output_type=_DELETEOBSERVATIONLOGREPLY,
@@ -2116,8 +2667,8 @@ _SUGGESTION = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   options=None,
-  serialized_start=4419,
-  serialized_end=4644,
+  serialized_start=5886,
+  serialized_end=6111,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetSuggestions',
@@ -2149,8 +2700,8 @@ _EARLYSTOPPING = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=2,
   options=None,
-  serialized_start=4647,
-  serialized_end=4863,
+  serialized_start=6114,
+  serialized_end=6466,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetEarlyStoppingRules',
@@ -2170,6 +2721,15 @@ _EARLYSTOPPING = _descriptor.ServiceDescriptor(
     output_type=_SETTRIALSTATUSREPLY,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='ValidateEarlyStoppingSettings',
+    full_name='api.v1.beta1.EarlyStopping.ValidateEarlyStoppingSettings',
+    index=2,
+    containing_service=None,
+    input_type=_VALIDATEEARLYSTOPPINGSETTINGSREQUEST,
+    output_type=_VALIDATEEARLYSTOPPINGSETTINGSREPLY,
+    options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_EARLYSTOPPING)
 
@@ -2201,6 +2761,11 @@ try:
           request_serializer=ReportObservationLogRequest.SerializeToString,
           response_deserializer=ReportObservationLogReply.FromString,
           )
+      self.StreamObservationLog = channel.stream_unary(
+          '/api.v1.beta1.DBManager/StreamObservationLog',
+          request_serializer=StreamObservationLogRequest.SerializeToString,
+          response_deserializer=StreamObservationLogReply.FromString,
+          )
       self.GetObservationLog = channel.unary_unary(
           '/api.v1.beta1.DBManager/GetObservationLog',
           request_serializer=GetObservationLogRequest.SerializeToString,
@@ -2229,6 +2794,16 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def StreamObservationLog(self, request_iterator, context):
+      """*
+      Stream logs of Observations for a Trial while it is running.
+      Each request carries a batch of metric logs, which is stored as soon as it is received.
+      Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
     def GetObservationLog(self, request, context):
       """*
       Get all log of Observations for a Trial.
@@ -2253,6 +2828,11 @@ try:
             request_deserializer=ReportObservationLogRequest.FromString,
             response_serializer=ReportObservationLogReply.SerializeToString,
         ),
+        'StreamObservationLog': grpc.stream_unary_rpc_method_handler(
+            servicer.StreamObservationLog,
+            request_deserializer=StreamObservationLogRequest.FromString,
+            response_serializer=StreamObservationLogReply.SerializeToString,
+        ),
         'GetObservationLog': grpc.unary_unary_rpc_method_handler(
             servicer.GetObservationLog,
             request_deserializer=GetObservationLogRequest.FromString,
@@ -2351,6 +2931,11 @@ try:
           request_serializer=SetTrialStatusRequest.SerializeToString,
           response_deserializer=SetTrialStatusReply.FromString,
           )
+      self.ValidateEarlyStoppingSettings = channel.unary_unary(
+          '/api.v1.beta1.EarlyStopping/ValidateEarlyStoppingSettings',
+          request_serializer=ValidateEarlyStoppingSettingsRequest.SerializeToString,
+          response_deserializer=ValidateEarlyStoppingSettingsReply.FromString,
+          )
 
 
   class EarlyStoppingServicer(object):
@@ -2372,6 +2957,13 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def ValidateEarlyStoppingSettings(self, request, context):
+      # missing associated documentation comment in .proto file
+      pass
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
 
   def add_EarlyStoppingServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -2385,6 +2977,11 @@ try:
             request_deserializer=SetTrialStatusRequest.FromString,
             response_serializer=SetTrialStatusReply.SerializeToString,
         ),
+        'ValidateEarlyStoppingSettings': grpc.unary_unary_rpc_method_handler(
+            servicer.ValidateEarlyStoppingSettings,
+            request_deserializer=ValidateEarlyStoppingSettingsRequest.FromString,
+            response_serializer=ValidateEarlyStoppingSettingsReply.SerializeToString,
+        ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
         'api.v1.beta1.EarlyStopping', rpc_method_handlers)
@@ -2408,6 +3005,13 @@ try:
       You can see accuracy curve or other metric logs on UI.
       """
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def StreamObservationLog(self, request_iterator, context):
+      """*
+      Stream logs of Observations for a Trial while it is running.
+      Each request carries a batch of metric logs, which is stored as soon as it is received.
+      Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
     def GetObservationLog(self, request, context):
       """*
       Get all log of Observations for a Trial.
@@ -2438,6 +3042,14 @@ try:
       """
       raise NotImplementedError()
     ReportObservationLog.future = None
+    def StreamObservationLog(self, request_iterator, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Stream logs of Observations for a Trial while it is running.
+      Each request carries a batch of metric logs, which is stored as soon as it is received.
+      Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+      """
+      raise NotImplementedError()
+    StreamObservationLog.future = None
     def GetObservationLog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
       """*
       Get all log of Observations for a Trial.
@@ -2462,16 +3074,19 @@ try:
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): StreamObservationLogRequest.FromString,
     }
     response_serializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): StreamObservationLogReply.SerializeToString,
     }
     method_implementations = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): face_utilities.unary_unary_inline(servicer.DeleteObservationLog),
       ('api.v1.beta1.DBManager', 'GetObservationLog'): face_utilities.unary_unary_inline(servicer.GetObservationLog),
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): face_utilities.unary_unary_inline(servicer.ReportObservationLog),
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): face_utilities.stream_unary_inline(servicer.StreamObservationLog),
     }
     server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
     return beta_implementations.server(method_implementations, options=server_options)
@@ -2487,16 +3102,19 @@ try:
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): StreamObservationLogRequest.SerializeToString,
     }
     response_deserializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'StreamObservationLog'): StreamObservationLogReply.FromString,
     }
     cardinalities = {
       'DeleteObservationLog': cardinality.Cardinality.UNARY_UNARY,
       'GetObservationLog': cardinality.Cardinality.UNARY_UNARY,
       'ReportObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'StreamObservationLog': cardinality.Cardinality.STREAM_UNARY,
     }
     stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
     return beta_implementations.dynamic_stub(channel, 'api.v1.beta1.DBManager', cardinalities, options=stub_options)
@@ -2603,6 +3221,10 @@ try:
       # missing associated documentation comment in .proto file
       pass
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def ValidateEarlyStoppingSettings(self, request, context):
+      # missing associated documentation comment in .proto file
+      pass
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
 
 
   class BetaEarlyStoppingStub(object):
@@ -2624,6 +3246,11 @@ try:
       pass
       raise NotImplementedError()
     SetTrialStatus.future = None
+    def ValidateEarlyStoppingSettings(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      # missing associated documentation comment in .proto file
+      pass
+      raise NotImplementedError()
+    ValidateEarlyStoppingSettings.future = None
 
 
   def beta_create_EarlyStopping_server(servicer, pool=None, pool_size=None, default_timeout=None, maximum_timeout=None):
@@ -2635,14 +3262,17 @@ try:
     request_deserializers = {
       ('api.v1.beta1.EarlyStopping', 'GetEarlyStoppingRules'): GetEarlyStoppingRulesRequest.FromString,
       ('api.v1.beta1.EarlyStopping', 'SetTrialStatus'): SetTrialStatusRequest.FromString,
+      ('api.v1.beta1.EarlyStopping', 'ValidateEarlyStoppingSettings'): ValidateEarlyStoppingSettingsRequest.FromString,
     }
     response_serializers = {
       ('api.v1.beta1.EarlyStopping', 'GetEarlyStoppingRules'): GetEarlyStoppingRulesReply.SerializeToString,
       ('api.v1.beta1.EarlyStopping', 'SetTrialStatus'): SetTrialStatusReply.SerializeToString,
+      ('api.v1.beta1.EarlyStopping', 'ValidateEarlyStoppingSettings'): ValidateEarlyStoppingSettingsReply.SerializeToString,
     }
     method_implementations = {
       ('api.v1.beta1.EarlyStopping', 'GetEarlyStoppingRules'): face_utilities.unary_unary_inline(servicer.GetEarlyStoppingRules),
       ('api.v1.beta1.EarlyStopping', 'SetTrialStatus'): face_utilities.unary_unary_inline(servicer.SetTrialStatus),
+      ('api.v1.beta1.EarlyStopping', 'ValidateEarlyStoppingSettings'): face_utilities.unary_unary_inline(servicer.ValidateEarlyStoppingSettings),
     }
     server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
     return beta_implementations.server(method_implementations, options=server_options)
@@ -2657,14 +3287,17 @@ try:
     request_serializers = {
       ('api.v1.beta1.EarlyStopping', 'GetEarlyStoppingRules'): GetEarlyStoppingRulesRequest.SerializeToString,
       ('api.v1.beta1.EarlyStopping', 'SetTrialStatus'): SetTrialStatusRequest.SerializeToString,
+      ('api.v1.beta1.EarlyStopping', 'ValidateEarlyStoppingSettings'): ValidateEarlyStoppingSettingsRequest.SerializeToString,
     }
     response_deserializers = {
       ('api.v1.beta1.EarlyStopping', 'GetEarlyStoppingRules'): GetEarlyStoppingRulesReply.FromString,
       ('api.v1.beta1.EarlyStopping', 'SetTrialStatus'): SetTrialStatusReply.FromString,
+      ('api.v1.beta1.EarlyStopping', 'ValidateEarlyStoppingSettings'): ValidateEarlyStoppingSettingsReply.FromString,
     }
     cardinalities = {
       'GetEarlyStoppingRules': cardinality.Cardinality.UNARY_UNARY,
       'SetTrialStatus': cardinality.Cardinality.UNARY_UNARY,
+      'ValidateEarlyStoppingSettings': cardinality.Cardinality.UNARY_UNARY,
     }
     stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
     return beta_implementations.dynamic_stub(channel, 'api.v1.beta1.EarlyStopping', cardinalities, options=stub_options)
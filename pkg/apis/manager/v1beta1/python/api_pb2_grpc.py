@@ -20,6 +20,11 @@ class DBManagerStub(object):
         request_serializer=api__pb2.ReportObservationLogRequest.SerializeToString,
         response_deserializer=api__pb2.ReportObservationLogReply.FromString,
         )
+    self.StreamObservationLog = channel.stream_unary(
+        '/api.v1.beta1.DBManager/StreamObservationLog',
+        request_serializer=api__pb2.StreamObservationLogRequest.SerializeToString,
+        response_deserializer=api__pb2.StreamObservationLogReply.FromString,
+        )
     self.GetObservationLog = channel.unary_unary(
         '/api.v1.beta1.DBManager/GetObservationLog',
         request_serializer=api__pb2.GetObservationLogRequest.SerializeToString,
@@ -48,6 +53,16 @@ class DBManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def StreamObservationLog(self, request_iterator, context):
+    """*
+    Stream logs of Observations for a Trial while it is running.
+    Each request carries a batch of metric logs, which is stored as soon as it is received.
+    Metrics collectors use it to report metrics incrementally, so that they are not lost if the Trial is interrupted.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetObservationLog(self, request, context):
     """*
     Get all log of Observations for a Trial.
@@ -72,6 +87,11 @@ def add_DBManagerServicer_to_server(servicer, server):
           request_deserializer=api__pb2.ReportObservationLogRequest.FromString,
           response_serializer=api__pb2.ReportObservationLogReply.SerializeToString,
       ),
+      'StreamObservationLog': grpc.stream_unary_rpc_method_handler(
+          servicer.StreamObservationLog,
+          request_deserializer=api__pb2.StreamObservationLogRequest.FromString,
+          response_serializer=api__pb2.StreamObservationLogReply.SerializeToString,
+      ),
       'GetObservationLog': grpc.unary_unary_rpc_method_handler(
           servicer.GetObservationLog,
           request_deserializer=api__pb2.GetObservationLogRequest.FromString,
@@ -170,6 +190,11 @@ class EarlyStoppingStub(object):
         request_serializer=api__pb2.SetTrialStatusRequest.SerializeToString,
         response_deserializer=api__pb2.SetTrialStatusReply.FromString,
         )
+    self.ValidateEarlyStoppingSettings = channel.unary_unary(
+        '/api.v1.beta1.EarlyStopping/ValidateEarlyStoppingSettings',
+        request_serializer=api__pb2.ValidateEarlyStoppingSettingsRequest.SerializeToString,
+        response_deserializer=api__pb2.ValidateEarlyStoppingSettingsReply.FromString,
+        )
 
 
 class EarlyStoppingServicer(object):
@@ -191,6 +216,13 @@ class EarlyStoppingServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ValidateEarlyStoppingSettings(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_EarlyStoppingServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -204,6 +236,11 @@ def add_EarlyStoppingServicer_to_server(servicer, server):
           request_deserializer=api__pb2.SetTrialStatusRequest.FromString,
           response_serializer=api__pb2.SetTrialStatusReply.SerializeToString,
       ),
+      'ValidateEarlyStoppingSettings': grpc.unary_unary_rpc_method_handler(
+          servicer.ValidateEarlyStoppingSettings,
+          request_deserializer=api__pb2.ValidateEarlyStoppingSettingsRequest.FromString,
+          response_serializer=api__pb2.ValidateEarlyStoppingSettingsReply.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'api.v1.beta1.EarlyStopping', rpc_method_handlers)
@@ -29,47 +29,56 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":         schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":            schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":            schema_apis_controller_common_v1beta1_CollectorSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule":        schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSetting":     schema_apis_controller_common_v1beta1_EarlyStoppingSetting(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec":        schema_apis_controller_common_v1beta1_EarlyStoppingSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FileSystemPath":           schema_apis_controller_common_v1beta1_FileSystemPath(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FilterSpec":               schema_apis_controller_common_v1beta1_FilterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                   schema_apis_controller_common_v1beta1_Metric(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":           schema_apis_controller_common_v1beta1_MetricStrategy(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":     schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":            schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":              schema_apis_controller_common_v1beta1_Observation(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":      schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":               schema_apis_controller_common_v1beta1_SourceSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":     schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":          schema_apis_controller_experiments_v1beta1_Experiment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition": schema_apis_controller_experiments_v1beta1_ExperimentCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentList":      schema_apis_controller_experiments_v1beta1_ExperimentList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentSpec":      schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentStatus":    schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace":       schema_apis_controller_experiments_v1beta1_FeasibleSpace(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.GraphConfig":         schema_apis_controller_experiments_v1beta1_GraphConfig(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig":           schema_apis_controller_experiments_v1beta1_NasConfig(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation":           schema_apis_controller_experiments_v1beta1_Operation(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial":        schema_apis_controller_experiments_v1beta1_OptimalTrial(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec":       schema_apis_controller_experiments_v1beta1_ParameterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec":  schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialSource":         schema_apis_controller_experiments_v1beta1_TrialSource(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate":       schema_apis_controller_experiments_v1beta1_TrialTemplate(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.Suggestion":          schema_apis_controller_suggestions_v1beta1_Suggestion(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionCondition": schema_apis_controller_suggestions_v1beta1_SuggestionCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionList":      schema_apis_controller_suggestions_v1beta1_SuggestionList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionSpec":      schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionStatus":    schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment":     schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.Trial":                    schema_apis_controller_trials_v1beta1_Trial(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition":           schema_apis_controller_trials_v1beta1_TrialCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialList":                schema_apis_controller_trials_v1beta1_TrialList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialSpec":                schema_apis_controller_trials_v1beta1_TrialSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialStatus":              schema_apis_controller_trials_v1beta1_TrialStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":          schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":             schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":             schema_apis_controller_common_v1beta1_CollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule":         schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSetting":      schema_apis_controller_common_v1beta1_EarlyStoppingSetting(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec":         schema_apis_controller_common_v1beta1_EarlyStoppingSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FileSystemPath":            schema_apis_controller_common_v1beta1_FileSystemPath(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FilterSpec":                schema_apis_controller_common_v1beta1_FilterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                    schema_apis_controller_common_v1beta1_Metric(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":            schema_apis_controller_common_v1beta1_MetricStrategy(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":      schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Objective":                 schema_apis_controller_common_v1beta1_Objective(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":             schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":               schema_apis_controller_common_v1beta1_Observation(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":       schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy":               schema_apis_controller_common_v1beta1_RetryPolicy(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":                schema_apis_controller_common_v1beta1_SourceSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":      schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.EnqueuedTrial":        schema_apis_controller_experiments_v1beta1_EnqueuedTrial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":           schema_apis_controller_experiments_v1beta1_Experiment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition":  schema_apis_controller_experiments_v1beta1_ExperimentCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentList":       schema_apis_controller_experiments_v1beta1_ExperimentList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentReference":  schema_apis_controller_experiments_v1beta1_ExperimentReference(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentSpec":       schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentStatus":     schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace":        schema_apis_controller_experiments_v1beta1_FeasibleSpace(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.GraphConfig":          schema_apis_controller_experiments_v1beta1_GraphConfig(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig":            schema_apis_controller_experiments_v1beta1_NasConfig(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ObjectiveImprovement": schema_apis_controller_experiments_v1beta1_ObjectiveImprovement(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation":            schema_apis_controller_experiments_v1beta1_Operation(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial":         schema_apis_controller_experiments_v1beta1_OptimalTrial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition":   schema_apis_controller_experiments_v1beta1_ParameterCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterConstraint":  schema_apis_controller_experiments_v1beta1_ParameterConstraint(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec":        schema_apis_controller_experiments_v1beta1_ParameterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.StoppingPolicy":       schema_apis_controller_experiments_v1beta1_StoppingPolicy(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec":   schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialSource":          schema_apis_controller_experiments_v1beta1_TrialSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate":        schema_apis_controller_experiments_v1beta1_TrialTemplate(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec":        schema_apis_controller_experiments_v1beta1_WarmStartSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.Suggestion":           schema_apis_controller_suggestions_v1beta1_Suggestion(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionCondition":  schema_apis_controller_suggestions_v1beta1_SuggestionCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionList":       schema_apis_controller_suggestions_v1beta1_SuggestionList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionSpec":       schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionStatus":     schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment":      schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.Trial":                     schema_apis_controller_trials_v1beta1_Trial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition":            schema_apis_controller_trials_v1beta1_TrialCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialList":                 schema_apis_controller_trials_v1beta1_TrialList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialSpec":                 schema_apis_controller_trials_v1beta1_TrialSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialStatus":               schema_apis_controller_trials_v1beta1_TrialStatus(ref),
 	}
 }
 
@@ -286,6 +295,13 @@ func schema_apis_controller_common_v1beta1_FileSystemPath(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format of the metrics file lines, it is TEXT by default. Supported only for File metrics collector.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -403,6 +419,40 @@ func schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref common.Refer
 	}
 }
 
+func schema_apis_controller_common_v1beta1_Objective(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Objective is one of the Experiment's objectives for multi-objective optimization.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type for the objective optimization.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"goal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Goal is the objective goal that should be reached. The goal of the Experiment is reached, when one Trial reaches the goals of all objectives.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"objectiveMetricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectiveMetricName represents metric to optimize.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -460,11 +510,25 @@ func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"objectives": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objectives represents Experiment's objectives for multi-objective optimization. Each objective has its own metric and direction. The first objective is the primary one, experiment defaulter (webhook) sets Type and ObjectiveMetricName from it and adds metrics of the other objectives to AdditionalMetricNames.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Objective"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Objective"},
 	}
 }
 
@@ -521,6 +585,56 @@ func schema_apis_controller_common_v1beta1_ParameterAssignment(ref common.Refere
 	}
 }
 
+func schema_apis_controller_common_v1beta1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicy describes how the failed trial run is retried.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max number of times the failed trial run is created again.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of retryable failure reasons. Failure is retryable if the reason or the message of the trial run failure condition contains one of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"exitCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of retryable exit codes. Failure is retryable if the terminated primary container of the trial run Pods has one of them. Only Pods owned by the trial run are checked, otherwise the exit code is taken from the message of the trial run failure condition, if it contains \"exit code\". If reasons and exit codes are omitted, all failures are retryable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_SourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -587,6 +701,35 @@ func schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref common.Refer
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_EnqueuedTrial(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EnqueuedTrial is the trial with the explicit parameter assignments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameterAssignments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Assignments of the parameters from the search space. Inactive conditional parameters must be omitted. Values of the parameters with step must be on the step.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_Experiment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -743,6 +886,33 @@ func schema_apis_controller_experiments_v1beta1_ExperimentList(ref common.Refere
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_ExperimentReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExperimentReference is the reference to the experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the experiment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the experiment. It must be the namespace of this experiment, if it is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -764,6 +934,20 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							},
 						},
 					},
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of constraints on the hyperparameter assignments. Assignments, which don't satisfy the constraints, are rejected and new assignments are suggested.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterConstraint"),
+									},
+								},
+							},
+						},
+					},
 					"objective": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Describes the objective of the experiment.",
@@ -827,11 +1011,57 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"warmStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes the previous experiments, which trials are used to warm start the search.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec"),
+						},
+					},
+					"enqueuedTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of trials with the explicit parameter assignments, which are created before the suggested trials. New trials can be appended to the list when experiment is running.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.EnqueuedTrial"),
+									},
+								},
+							},
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspends the experiment. Suspended experiment doesn't create new trials and its suggestion deployment and service are deleted until the experiment is resumed. Only experiment with resumePolicy = FromVolume can be suspended, because the suggestion state is restored from the volume when experiment is resumed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes what happens with the active trials when experiment is suspended. Defaults to Drain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max duration of the experiment from its start time. New trials are not created and experiment is succeeded when duration is exceeded. Active trials are timed out with the observations collected so far.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"stoppingPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stops the experiment when the best objective value is not improved in the last completed trials.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.StoppingPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.EnqueuedTrial", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterConstraint", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.StoppingPolicy", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -876,11 +1106,25 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 					},
 					"currentOptimalTrial": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Current optimal trial parameters and observations.",
+							Description: "Current optimal trial parameters and observations. For multi-objective Experiment it is the Pareto optimal trial with the best value of the first objective.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
 						},
 					},
+					"paretoOptimalTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current Pareto optimal trials of multi-objective Experiment. Trial is Pareto optimal, if no other trial is better in one objective and not worse in all other objectives.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
+									},
+								},
+							},
+						},
+					},
 					"runningTrialList": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of trial names which are running.",
@@ -971,6 +1215,21 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							},
 						},
 					},
+					"timedOutTrialList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of trial names which have been timed out.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"trials": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Trials is the total number of trials owned by the experiment.",
@@ -1020,11 +1279,39 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"trialsTimedOut": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many trials have been timed out.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"objectiveImprovementHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History of the best objective value improvements in the trials completion order. It is set only if the stopping policy is specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ObjectiveImprovement"),
+									},
+								},
+							},
+						},
+					},
+					"trialsWithoutImprovement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many trials have succeeded after the last improvement of the best objective value. It is set only if the stopping policy is specified.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ObjectiveImprovement", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1066,6 +1353,27 @@ func schema_apis_controller_experiments_v1beta1_FeasibleSpace(ref common.Referen
 							Format: "",
 						},
 					},
+					"distribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distribution of the int or double parameter values, uniform by default. Values of the log-uniform parameter with step are rounded to the multiple of the step.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mean": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mean of the normal distribution. Values of the normal parameter are sampled between min and max.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"std": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard deviation of the normal distribution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1153,6 +1461,44 @@ func schema_apis_controller_experiments_v1beta1_NasConfig(ref common.ReferenceCa
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_ObjectiveImprovement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectiveImprovement is the improvement of the best objective value by the trial.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trialName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the trial, which improved the best objective value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "New best objective value.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"completedTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of the succeeded trials with the objective metric value, including this trial.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"trialName", "value", "completedTrials"},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_Operation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1232,6 +1578,75 @@ func schema_apis_controller_experiments_v1beta1_OptimalTrial(ref common.Referenc
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_ParameterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParameterCondition describes when the conditional parameter is active.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the categorical or discrete parameter, which this parameter depends on.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values of the parent parameter, for which this parameter is active.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"inactiveValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InactiveValue replaces the parameter in the Trial template when the parameter is inactive. Empty string is used by default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ParameterConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParameterConstraint is the constraint on the parameter assignments, e.g. \"batch_size * grad_accum <= 512\". Constraints, which reference inactive conditional parameters, are not checked.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the constraint, which is reported when assignments are rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression must be true for the feasible assignments.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_ParameterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1256,11 +1671,46 @@ func schema_apis_controller_experiments_v1beta1_ParameterSpec(ref common.Referen
 							Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace"),
 						},
 					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition makes the parameter active only for the given values of another parameter. Inactive parameters are not assigned in the Trial.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_StoppingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StoppingPolicy describes when the experiment is succeeded because the best objective value has reached a plateau.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of the last succeeded trials without improvement of the best objective value to stop the experiment. Only trials with the objective metric value are counted.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min change of the best objective value, which is counted as improvement. Defaults to 0, so any improvement is counted.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"patience"},
+			},
+		},
 	}
 }
 
@@ -1292,6 +1742,13 @@ func schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref common.Re
 							Format:      "",
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression to derive the parameter value from the search space parameters, e.g. \"round(0.1 * total_steps)\". It is used instead of the Reference.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1402,11 +1859,52 @@ func schema_apis_controller_experiments_v1beta1_TrialTemplate(ref common.Referen
 							Format:      "",
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed trial run is retried. Trial is failed only after retries are exhausted.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
+					"maxTrialDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max duration of each trial from its start time. Trial run is killed and trial is timed out when duration is exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_WarmStartSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WarmStartSpec is the specification of the warm start. Succeeded trials of the experiments, which assignments are in the search space, are sent to the suggestion service as completed trials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"experiments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of the previous experiments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentReference"},
 	}
 }
 
@@ -1650,6 +2148,20 @@ func schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref common.Refe
 							},
 						},
 					},
+					"infeasibleSuggestions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest suggestion results, which are rejected by the Experiment constraints. Trials are not created for these assignments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment"),
+									},
+								},
+							},
+						},
+					},
 					"startTime": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Represents time when the Suggestion was acknowledged by the Suggestion controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
@@ -1994,12 +2506,24 @@ func schema_apis_controller_trials_v1beta1_TrialSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed trial run is retried.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max duration of the trial from its start time. Trial run is killed and trial is timed out when duration is exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"parameterAssignments"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
 	}
 }
 
@@ -2048,6 +2572,13 @@ func schema_apis_controller_trials_v1beta1_TrialStatus(ref common.ReferenceCallb
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
 						},
 					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many times the failed trial run has been created again.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
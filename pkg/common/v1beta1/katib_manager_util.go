@@ -23,6 +23,7 @@ import (
 
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 type katibDBManagerClientAndConn struct {
@@ -44,8 +45,12 @@ func GetDBManagerAddr() string {
 }
 
 func getKatibDBManagerClientAndConn() (*katibDBManagerClientAndConn, error) {
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		return nil, err
+	}
 	addr := GetDBManagerAddr()
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	conn, err := grpc.Dial(addr, tlsOpt)
 	if err != nil {
 		return nil, err
 	}
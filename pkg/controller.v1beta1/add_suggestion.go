@@ -17,10 +17,18 @@ limitations under the License.
 package controller
 
 import (
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/suggestionclient"
+	goptuna "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
 )
 
 func init() {
 	// AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
 	AddToManagerFuncs = append(AddToManagerFuncs, suggestion.Add)
+
+	// Goptuna algorithms run in the controller if they are embedded in Katib config.
+	suggestionclient.RegisterEmbeddedAlgorithm(func() suggestionapi.SuggestionServer {
+		return goptuna.NewSuggestionService()
+	}, goptuna.AlgorithmCMAES, goptuna.AlgorithmTPE, goptuna.AlgorithmRandom, goptuna.AlgorithmNSGA2, goptuna.AlgorithmGrid)
 }
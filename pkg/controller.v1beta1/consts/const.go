@@ -29,6 +29,9 @@ const (
 	// PluralTrial is the plural for Trial object
 	PluralTrial = "trials"
 
+	// PluralExperiment is the plural for Experiment object
+	PluralExperiment = "experiments"
+
 	// ConfigExperimentSuggestionName is the config name of the
 	// suggestion client implementation in experiment controller.
 	ConfigExperimentSuggestionName = "experiment-suggestion-name"
@@ -101,6 +104,8 @@ const (
 	LabelMetricsCollectorSidecar = "metrics-collector-sidecar"
 	// LabelEarlyStoppingTag is the name of early stopping config in Katib configmap.
 	LabelEarlyStoppingTag = "early-stopping"
+	// LabelGRPCTLSTag is the name of gRPC TLS config in Katib configmap.
+	LabelGRPCTLSTag = "grpc-tls"
 	// DefaultImagePullPolicy is the default value for image pull policy.
 	DefaultImagePullPolicy = corev1.PullIfNotPresent
 	// DefaultCPULimit is the default value for CPU limit.
@@ -116,6 +121,8 @@ const (
 	// DefaultDiskRequest is the default value for disk request.
 	DefaultDiskRequest = "500Mi"
 
+	// SuggestionVolumeMountPathEnvName is the env name of the suggestion volume mount path in suggestion container
+	SuggestionVolumeMountPathEnvName = "KATIB_SUGGESTION_VOLUME_MOUNT_PATH"
 	// DefaultContainerSuggestionVolumeMountPath is the default mount path in suggestion container
 	DefaultContainerSuggestionVolumeMountPath = "/opt/katib/data"
 
@@ -132,6 +139,26 @@ const (
 	// Full default local path = /tmp/katib/suggestions/<suggestion-name>-<suggestion-algorithm>-<suggestion-namespace>
 	DefaultSuggestionVolumeLocalPathPrefix = "/tmp/katib/suggestions/"
 
+	// GRPCTLSCertFileEnvName is the env name of the TLS certificate file for Katib gRPC servers and clients
+	GRPCTLSCertFileEnvName = "KATIB_GRPC_TLS_CERT_FILE"
+	// GRPCTLSKeyFileEnvName is the env name of the TLS private key file for Katib gRPC servers and clients
+	GRPCTLSKeyFileEnvName = "KATIB_GRPC_TLS_KEY_FILE"
+	// GRPCTLSCAFileEnvName is the env name of the CA certificate file to verify Katib gRPC peers
+	GRPCTLSCAFileEnvName = "KATIB_GRPC_TLS_CA_FILE"
+	// GRPCTLSServerNameEnvName is the env name of the server name which gRPC clients use to verify server certificates
+	GRPCTLSServerNameEnvName = "KATIB_GRPC_TLS_SERVER_NAME"
+
+	// ContainerGRPCTLSVolumeName is the volume name of the gRPC TLS Secret
+	ContainerGRPCTLSVolumeName = "katib-grpc-tls"
+	// DefaultGRPCTLSMountPath is the default mount path of the gRPC TLS Secret
+	DefaultGRPCTLSMountPath = "/etc/katib/grpc-tls"
+	// GRPCTLSCertFileName is the certificate key in the gRPC TLS Secret
+	GRPCTLSCertFileName = "tls.crt"
+	// GRPCTLSKeyFileName is the private key key in the gRPC TLS Secret
+	GRPCTLSKeyFileName = "tls.key"
+	// GRPCTLSCAFileName is the CA certificate key in the gRPC TLS Secret
+	GRPCTLSCAFileName = "ca.crt"
+
 	// ReconcileErrorReason is the reason when there is a reconcile error.
 	ReconcileErrorReason = "ReconcileError"
 
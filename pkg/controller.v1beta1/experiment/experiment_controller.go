@@ -200,10 +200,12 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 		// Check if experiment is restartable and max trials is reconfigured
 		// That means experiment is restarting
-		if (util.IsCompletedExperimentRestartable(instance) &&
+		// Experiment with exhausted search space can't be restarted
+		if ((util.IsCompletedExperimentRestartable(instance) &&
 			instance.Spec.MaxTrialCount != nil &&
 			*instance.Spec.MaxTrialCount > instance.Status.Trials) ||
-			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0) {
+			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0)) &&
+			!instance.IsCompletedReason(util.ExperimentSuggestionEndReachedReason) {
 			logger.Info("Experiment is restarting",
 				"MaxTrialCount", instance.Spec.MaxTrialCount,
 				"ParallelTrialCount", instance.Spec.ParallelTrialCount,
@@ -257,6 +259,13 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 	}
 
+	// Experiment must be reconciled when max duration is reached, even if Trials are not changed.
+	if remaining, ok := util.GetRemainingDuration(instance, time.Now()); ok && remaining > 0 && !instance.IsCompleted() {
+		return reconcile.Result{
+			RequeueAfter: remaining,
+		}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -271,12 +280,36 @@ func (r *ReconcileExperiment) ReconcileExperiment(instance *experimentsv1beta1.E
 		return err
 	}
 	if len(trials.Items) > 0 {
-		if err := util.UpdateExperimentStatus(r.collector, instance, trials); err != nil {
+		getSuggestionDone, err := r.isSuggestionExhausted(instance)
+		if err != nil {
+			logger.Error(err, "Suggestion Get error")
+			return err
+		}
+		if err := util.UpdateExperimentStatus(r.collector, instance, trials, getSuggestionDone); err != nil {
 			logger.Error(err, "Update experiment status error")
 			return err
 		}
 	}
+	if instance.IsCompletedReason(util.ExperimentMaxDurationReachedReason) {
+		if err := r.timeoutTrials(instance, trials.Items); err != nil {
+			logger.Error(err, "Timeout trials error")
+			return err
+		}
+	}
 	reconcileRequired := !instance.IsCompleted()
+	if reconcileRequired && instance.Spec.Suspended {
+		return r.suspendExperiment(instance, trials.Items)
+	}
+	if reconcileRequired && instance.IsSuspended() {
+		logger.Info("Experiment is resuming")
+		msg := "Experiment is resumed"
+		instance.MarkExperimentStatusResumed(util.ExperimentResumedReason, msg)
+		// Suggestion deployment and service are created again from the current trials history.
+		if err := r.restartSuggestion(instance); err != nil {
+			logger.Error(err, "restartSuggestion error")
+			return err
+		}
+	}
 	if reconcileRequired {
 		r.ReconcileTrials(instance, trials.Items)
 	}
@@ -284,6 +317,65 @@ func (r *ReconcileExperiment) ReconcileExperiment(instance *experimentsv1beta1.E
 	return nil
 }
 
+// timeoutTrials limits max duration of the active trials to the experiment deadline.
+// Trial controller kills trial run and marks trial timed out with its current observation.
+func (r *ReconcileExperiment) timeoutTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	deadline := instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration)
+	for i := range trials {
+		trial := &trials[i]
+		if trial.IsCompleted() {
+			continue
+		}
+		// Trial which is not started yet is timed out as soon as it is started.
+		maxDuration := time.Duration(0)
+		if trial.Status.StartTime != nil && deadline.After(trial.Status.StartTime.Time) {
+			maxDuration = deadline.Sub(trial.Status.StartTime.Time)
+		}
+		if trial.Spec.MaxDuration != nil && trial.Spec.MaxDuration.Duration <= maxDuration {
+			continue
+		}
+		trial.Spec.MaxDuration = &metav1.Duration{Duration: maxDuration}
+		if err := r.Update(context.TODO(), trial); err != nil {
+			logger.Error(err, "Trial update error", "Trial", trial.Name)
+			return err
+		}
+		logger.Info("Trial is timed out because experiment max duration has reached", "Trial", trial.Name)
+	}
+	return nil
+}
+
+// suspendExperiment stops creating new trials and suspends the suggestion.
+// If spec.suspendPolicy = Delete, active trials are deleted, otherwise they are running until completion.
+func (r *ReconcileExperiment) suspendExperiment(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	msg := "Experiment is suspended"
+	instance.MarkExperimentStatusSuspended(util.ExperimentSuspendedReason, msg)
+	if err := r.suspendSuggestion(instance); err != nil {
+		logger.Error(err, "suspendSuggestion error")
+		return err
+	}
+
+	if instance.Spec.SuspendPolicy == experimentsv1beta1.DeleteTrials {
+		var activeTrials []trialsv1beta1.Trial
+		for _, trial := range trials {
+			if !trial.IsCompleted() {
+				activeTrials = append(activeTrials, trial)
+			}
+		}
+		if len(activeTrials) > 0 {
+			logger.Info("DeleteTrials", "deleteCount", len(activeTrials))
+			if err := r.deleteTrials(instance, activeTrials, int32(len(activeTrials))); err != nil {
+				logger.Error(err, "Delete trials error")
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // ReconcileTrials syncs trials.
 func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
 
@@ -291,7 +383,8 @@ func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Exper
 
 	parallelCount := *instance.Spec.ParallelTrialCount
 	activeCount := instance.Status.TrialsPending + instance.Status.TrialsRunning
-	completedCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed + instance.Status.TrialsKilled + instance.Status.TrialsEarlyStopped
+	completedCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed + instance.Status.TrialsKilled +
+		instance.Status.TrialsEarlyStopped + instance.Status.TrialsTimedOut
 
 	if activeCount > parallelCount {
 		deleteCount := activeCount - parallelCount
@@ -347,13 +440,41 @@ func (r *ReconcileExperiment) createTrials(instance *experimentsv1beta1.Experime
 
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	currentCount := int32(len(trialList))
+	var trialNames []string
+
+	// Enqueued Trials are scheduled before Trials from the Suggestion.
+	// They are not counted in the Suggestion requests.
+	existingTrials := make(map[string]bool, len(trialList))
+	for _, trial := range trialList {
+		existingTrials[trial.Name] = true
+	}
+	for i, enqueuedTrial := range instance.Spec.EnqueuedTrials {
+		name := enqueuedTrialName(instance, i)
+		if existingTrials[name] {
+			currentCount--
+			continue
+		}
+		if addCount <= 0 {
+			break
+		}
+		trial := &suggestionsv1beta1.TrialAssignment{
+			Name:                 name,
+			ParameterAssignments: enqueuedTrial.ParameterAssignments,
+		}
+		if err := r.createTrialInstance(instance, trial); err != nil {
+			logger.Error(err, "Create enqueued trial instance error", "trial", trial)
+			return err
+		}
+		trialNames = append(trialNames, name)
+		addCount--
+	}
+
 	logger.Info("Reconcile Suggestion", "addCount", addCount)
 	trials, err := r.ReconcileSuggestions(instance, currentCount, addCount)
 	if err != nil {
 		logger.Error(err, "Get suggestions error")
 		return err
 	}
-	var trialNames []string
 	for _, trial := range trials {
 		if err = r.createTrialInstance(instance, &trial); err != nil {
 			logger.Error(err, "Create trial instance error", "trial", trial)
@@ -34,7 +34,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -374,6 +376,184 @@ func TestReconcile(t *testing.T) {
 	g.Expect(r.cleanupSuggestionResources(instance)).NotTo(gomega.HaveOccurred())
 }
 
+func TestReconcileSuspendedExperiment(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockSuggestion := suggestionmock.NewMockSuggestion(mockCtrl)
+
+	instance := newFakeInstance()
+	instance.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+	instance.Spec.Suspended = true
+	instance.Spec.SuspendPolicy = experimentsv1beta1.DeleteTrials
+	instance.MarkExperimentStatusCreated(experimentUtil.ExperimentCreatedReason, "Experiment is created")
+	instance.MarkExperimentStatusRunning(experimentUtil.ExperimentRunningReason, "Experiment is running")
+
+	suggestion := newFakeSuggestion()
+	suggestion.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+	suggestion.Status.Suggestions = suggestion.Status.Suggestions[:2]
+
+	succeededTrial := newFakeTrial(trialName+"-1", experimentName)
+	succeededTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial is succeeded")
+	runningTrial := newFakeTrial(trialName+"-2", experimentName)
+
+	r := &ReconcileExperiment{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(instance, suggestion, succeededTrial, runningTrial).Build(),
+		Suggestion: mockSuggestion,
+		collector:  experimentUtil.NewExpsCollector(nil, prometheus.NewRegistry()),
+	}
+
+	// Suggestion is marked succeeded to delete its Deployment and Service.
+	suggestionSuspended := newFakeSuggestion()
+	suggestionSuspended.MarkSuggestionStatusSucceeded("Experiment is suspended",
+		"Suggestion is succeeded, can be restarted when experiment is resumed")
+	suspendCall := mockSuggestion.EXPECT().UpdateSuggestionStatus(statusMatcher{suggestionSuspended}).Return(nil)
+	// Deleted running Trial is removed from the Suggestion requests and status.
+	mockSuggestion.EXPECT().UpdateSuggestion(gomock.Any()).Return(nil).Do(
+		func(arg0 interface{}) {
+			g.Expect(arg0.(*suggestionsv1beta1.Suggestion).Spec.Requests).To(gomega.Equal(int32(1)))
+		})
+	deleteTrialsCall := mockSuggestion.EXPECT().UpdateSuggestionStatus(gomock.Any()).Return(nil).Do(
+		func(arg0 interface{}) {
+			s := arg0.(*suggestionsv1beta1.Suggestion)
+			g.Expect(s.Status.Suggestions).To(gomega.HaveLen(1))
+			g.Expect(s.Status.Suggestions[0].Name).To(gomega.Equal(succeededTrial.Name))
+		})
+	gomock.InOrder(suspendCall, deleteTrialsCall)
+
+	// Test 1 - Suspend experiment with spec.suspendPolicy = Delete
+	g.Expect(r.ReconcileExperiment(instance)).NotTo(gomega.HaveOccurred())
+	g.Expect(instance.IsSuspended()).To(gomega.BeTrue())
+	g.Expect(instance.IsRunning()).To(gomega.BeFalse())
+	g.Expect(errors.IsNotFound(r.Get(context.TODO(),
+		types.NamespacedName{Namespace: namespace, Name: runningTrial.Name}, &trialsv1beta1.Trial{}))).To(gomega.BeTrue())
+	g.Expect(r.Get(context.TODO(),
+		types.NamespacedName{Namespace: namespace, Name: succeededTrial.Name}, &trialsv1beta1.Trial{})).NotTo(gomega.HaveOccurred())
+
+	// Test 2 - Suspended Suggestion without volume is not changed
+	instanceNoVolume := instance.DeepCopy()
+	instanceNoVolume.Spec.ResumePolicy = experimentsv1beta1.LongRunning
+	g.Expect(r.suspendSuggestion(instanceNoVolume)).NotTo(gomega.HaveOccurred())
+}
+
+func TestReconcileResumedExperiment(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockSuggestion := suggestionmock.NewMockSuggestion(mockCtrl)
+
+	var parallelCount int32 = 1
+	instance := newFakeInstance()
+	instance.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+	instance.Spec.ParallelTrialCount = &parallelCount
+	instance.MarkExperimentStatusCreated(experimentUtil.ExperimentCreatedReason, "Experiment is created")
+	instance.MarkExperimentStatusSuspended(experimentUtil.ExperimentSuspendedReason, "Experiment is suspended")
+
+	suggestion := newFakeSuggestion()
+	suggestion.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+	suggestion.MarkSuggestionStatusSucceeded("Experiment is suspended",
+		"Suggestion is succeeded, can be restarted when experiment is resumed")
+
+	runningTrial := newFakeTrial(trialName+"-1", experimentName)
+
+	r := &ReconcileExperiment{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(instance, suggestion, runningTrial).Build(),
+		Suggestion: mockSuggestion,
+		collector:  experimentUtil.NewExpsCollector(nil, prometheus.NewRegistry()),
+	}
+
+	// Suggestion is restarted from the volume.
+	suggestionRestarting := newFakeSuggestion()
+	suggestionRestarting.MarkSuggestionStatusRunning(corev1.ConditionFalse,
+		suggestionsv1beta1.SuggestionRestartReason, "Suggestion is not running")
+	mockSuggestion.EXPECT().UpdateSuggestionStatus(statusMatcher{suggestionRestarting}).Return(nil)
+
+	// Test 1 - Resume experiment, which has the active Trial
+	g.Expect(r.ReconcileExperiment(instance)).NotTo(gomega.HaveOccurred())
+	g.Expect(instance.IsSuspended()).To(gomega.BeFalse())
+
+	// Test 2 - Resumed experiment with restarting Suggestion is not restarted again
+	restarting := suggestion.DeepCopy()
+	restarting.Status = suggestionRestarting.Status
+	g.Expect(r.Status().Update(context.TODO(), restarting)).NotTo(gomega.HaveOccurred())
+	g.Expect(r.restartSuggestion(instance)).NotTo(gomega.HaveOccurred())
+}
+
+func TestReconcileExperimentMaxDurationReached(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockSuggestion := suggestionmock.NewMockSuggestion(mockCtrl)
+
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	instance := newFakeInstance()
+	instance.Spec.MaxDuration = &metav1.Duration{Duration: 30 * time.Minute}
+	instance.Status.StartTime = &startTime
+	instance.MarkExperimentStatusCreated(experimentUtil.ExperimentCreatedReason, "Experiment is created")
+	instance.MarkExperimentStatusRunning(experimentUtil.ExperimentRunningReason, "Experiment is running")
+
+	trialStartTime := metav1.NewTime(startTime.Add(10 * time.Minute))
+	succeededTrial := newFakeTrial(trialName+"-1", experimentName)
+	succeededTrial.Status.StartTime = &trialStartTime
+	succeededTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial is succeeded")
+	runningTrial := newFakeTrial(trialName+"-2", experimentName)
+	runningTrial.Status.StartTime = &trialStartTime
+	runningTrial.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	shortRunningTrial := newFakeTrial(trialName+"-3", experimentName)
+	shortRunningTrial.Status.StartTime = &trialStartTime
+	shortRunningTrial.Spec.MaxDuration = &metav1.Duration{Duration: 5 * time.Minute}
+	pendingTrial := newFakeTrial(trialName+"-4", experimentName)
+	pendingTrial.Status.Conditions = pendingTrial.Status.Conditions[:1]
+
+	r := &ReconcileExperiment{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).
+			WithObjects(instance, succeededTrial, runningTrial, shortRunningTrial, pendingTrial).Build(),
+		Suggestion: mockSuggestion,
+		collector:  experimentUtil.NewExpsCollector(nil, prometheus.NewRegistry()),
+	}
+
+	g.Expect(r.ReconcileExperiment(instance)).NotTo(gomega.HaveOccurred())
+	g.Expect(instance.IsSucceeded()).To(gomega.BeTrue())
+	g.Expect(instance.IsCompletedReason(experimentUtil.ExperimentMaxDurationReachedReason)).To(gomega.BeTrue())
+
+	getMaxDuration := func(name string) *metav1.Duration {
+		trial := &trialsv1beta1.Trial{}
+		g.Expect(r.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, trial)).NotTo(gomega.HaveOccurred())
+		return trial.Spec.MaxDuration
+	}
+	// Running Trial is timed out at the experiment deadline.
+	g.Expect(getMaxDuration(runningTrial.Name)).To(gomega.Equal(&metav1.Duration{Duration: 20 * time.Minute}))
+	// Running Trial with the earlier deadline is not changed.
+	g.Expect(getMaxDuration(shortRunningTrial.Name)).To(gomega.Equal(&metav1.Duration{Duration: 5 * time.Minute}))
+	// Pending Trial is timed out as soon as it is started.
+	g.Expect(getMaxDuration(pendingTrial.Name)).To(gomega.Equal(&metav1.Duration{}))
+	// Completed Trial is not changed.
+	g.Expect(getMaxDuration(succeededTrial.Name)).To(gomega.BeNil())
+}
+
+func newFakeTrial(name, experimentName string) *trialsv1beta1.Trial {
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				consts.LabelExperimentName: experimentName,
+			},
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: newFakeInstance().Spec.Objective,
+		},
+	}
+	trial.MarkTrialStatusCreated("TrialCreated", "Trial is created")
+	trial.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+	return trial
+}
+
 func newFakeInstance() *experimentsv1beta1.Experiment {
 	var parallelCount int32 = 2
 	var goal float64 = 99.9
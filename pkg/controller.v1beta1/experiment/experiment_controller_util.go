@@ -18,6 +18,7 @@ package experiment
 
 import (
 	"context"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 
@@ -36,6 +37,11 @@ const (
 	updatePrometheusMetrics = "update-prometheus-metrics"
 )
 
+// enqueuedTrialName returns the name of the Trial for the i-th enqueued Trial of the Experiment.
+func enqueuedTrialName(instance *experimentsv1beta1.Experiment, i int) string {
+	return fmt.Sprintf("%s-enqueued-%d", instance.Name, i)
+}
+
 func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta1.Experiment, trialAssignment *suggestionsv1beta1.TrialAssignment) error {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: expInstance.GetName(), Namespace: expInstance.GetNamespace()})
 
@@ -86,6 +92,14 @@ func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta
 		trial.Spec.FailureCondition = expInstance.Spec.TrialTemplate.FailureCondition
 	}
 
+	if expInstance.Spec.TrialTemplate.RetryPolicy != nil {
+		trial.Spec.RetryPolicy = expInstance.Spec.TrialTemplate.RetryPolicy
+	}
+
+	if expInstance.Spec.TrialTemplate.MaxTrialDuration != nil {
+		trial.Spec.MaxDuration = expInstance.Spec.TrialTemplate.MaxTrialDuration
+	}
+
 	if err := r.Create(context.TODO(), trial); err != nil {
 		logger.Error(err, "Trial create error", "Trial name", trial.Name)
 		return err
@@ -203,3 +217,52 @@ func (r *ReconcileExperiment) restartSuggestion(instance *experimentsv1beta1.Exp
 	}
 	return nil
 }
+
+// suspendSuggestion marks suggestion succeeded, so suggestion deployment and service are deleted.
+// Suggestion is restarted from the volume when experiment is resumed.
+// Suggestion without volume is not suspended, otherwise its state is lost.
+func (r *ReconcileExperiment) suspendSuggestion(instance *experimentsv1beta1.Experiment) error {
+	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if instance.Spec.ResumePolicy != experimentsv1beta1.FromVolume {
+		return nil
+	}
+	original := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(),
+		types.NamespacedName{Namespace: instance.GetNamespace(), Name: instance.GetName()}, original)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// If Suggestion is completed not needed to suspend Suggestion
+	if original.IsCompleted() {
+		return nil
+	}
+
+	logger.Info("Suggestion is suspending")
+	suggestion := original.DeepCopy()
+	reason := "Experiment is suspended"
+	msg := "Suggestion is succeeded, can be restarted when experiment is resumed"
+	suggestion.MarkSuggestionStatusSucceeded(reason, msg)
+
+	if err := r.UpdateSuggestionStatus(suggestion); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isSuggestionExhausted returns true if suggestion service has suggested all assignments of the search space.
+func (r *ReconcileExperiment) isSuggestionExhausted(instance *experimentsv1beta1.Experiment) (bool, error) {
+	suggestion := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(),
+		types.NamespacedName{Namespace: instance.GetNamespace(), Name: instance.GetName()}, suggestion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return suggestion.IsExhausted(), nil
+}
@@ -30,6 +30,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/expression"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
@@ -117,17 +118,57 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 		assignmentsMap[assignment.Name] = assignment.Value
 	}
 
+	// Conditional parameters are not assigned if they are inactive
+	conditionsMap := make(map[string]*experimentsv1beta1.ParameterCondition)
+	for _, param := range experiment.Spec.Parameters {
+		if param.Condition != nil {
+			conditionsMap[param.Name] = param.Condition
+		}
+	}
+
+	// Derived parameters are evaluated with the inactive values of conditional parameters
+	expressionValues := make(map[string]string)
+	for name, condition := range conditionsMap {
+		expressionValues[name] = condition.InactiveValue
+	}
+	for name, value := range assignmentsMap {
+		expressionValues[name] = value
+	}
+
 	placeHolderToValueMap := make(map[string]string)
 	var metaRefKey, metaRefIndex string
-	nonMetaParamCount := 0
+	// Assigned parameters which are consumed by the trial parameters
+	consumedParams := make(map[string]bool)
 	for _, param := range experiment.Spec.TrialTemplate.TrialParameters {
+		// handle trial parameters which are derived from trial assignments
+		if param.Expression != "" {
+			e, err := expression.Parse(param.Expression)
+			if err != nil {
+				return "", err
+			}
+			value, err := e.EvaluateString(expressionValues)
+			if err != nil {
+				return "", err
+			}
+			placeHolderToValueMap[param.Name] = value
+			for _, name := range e.Parameters() {
+				if _, ok := assignmentsMap[name]; ok {
+					consumedParams[name] = true
+				}
+			}
+			continue
+		}
+
 		metaMatchRegex := regexp.MustCompile(consts.TrialTemplateMetaReplaceFormatRegex)
 		sub := metaMatchRegex.FindStringSubmatch(param.Reference)
 		// handle trial parameters which consume trial assignments
 		if len(sub) == 0 {
 			if value, ok := assignmentsMap[param.Reference]; ok {
 				placeHolderToValueMap[param.Name] = value
-				nonMetaParamCount += 1
+				consumedParams[param.Reference] = true
+				continue
+			} else if condition, ok := conditionsMap[param.Reference]; ok {
+				placeHolderToValueMap[param.Name] = condition.InactiveValue
 				continue
 			} else {
 				return "", fmt.Errorf("Unable to find parameter: %v in parameter assignment %v", param.Reference, assignmentsMap)
@@ -172,8 +213,8 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 	}
 
 	// Number of parameters must be equal
-	if len(assignments) != nonMetaParamCount {
-		return "", fmt.Errorf("Number of TrialAssignment: %v != number of nonMetaTrialParameters in TrialSpec: %v", len(assignments), nonMetaParamCount)
+	if len(assignments) != len(consumedParams) {
+		return "", fmt.Errorf("Number of TrialAssignment: %v != number of nonMetaTrialParameters in TrialSpec: %v", len(assignments), len(consumedParams))
 	}
 
 	// Replacing placeholders with parameter values
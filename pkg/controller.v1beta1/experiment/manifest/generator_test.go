@@ -86,6 +86,12 @@ func TestGetRunSpecWithHP(t *testing.T) {
 		t.Errorf("ConvertObjectToUnstructured failed: %v", err)
 	}
 
+	expectedJob.Spec.Template.Spec.Containers[0].Command[3] = "--num-layers=3"
+	expectedInactiveRunSpec, err := util.ConvertObjectToUnstructured(expectedJob)
+	if err != nil {
+		t.Errorf("ConvertObjectToUnstructured failed: %v", err)
+	}
+
 	tcs := []struct {
 		Instance             *experimentsv1beta1.Experiment
 		ParameterAssignments []commonapiv1beta1.ParameterAssignment
@@ -101,6 +107,53 @@ func TestGetRunSpecWithHP(t *testing.T) {
 			Err:                  false,
 			testDescription:      "Run with valid parameters",
 		},
+		// Inactive conditional parameter
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters = []experimentsv1beta1.ParameterSpec{
+					{
+						Name:          "num-layers",
+						ParameterType: experimentsv1beta1.ParameterTypeInt,
+						Condition: &experimentsv1beta1.ParameterCondition{
+							Parameter:     "optimizer",
+							Values:        []string{"sgd"},
+							InactiveValue: "3",
+						},
+					},
+				}
+				return i
+			}(),
+			ParameterAssignments: newFakeParameterAssignment()[:1],
+			expectedRunSpec:      expectedInactiveRunSpec,
+			Err:                  false,
+			testDescription:      "Run with inactive conditional parameter",
+		},
+		// Derived parameter
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.TrialParameters[1].Reference = ""
+				i.Spec.TrialTemplate.TrialParameters[1].Expression = "max(${num-layers} - 2, 1)"
+				return i
+			}(),
+			ParameterAssignments: newFakeParameterAssignment(),
+			expectedRunSpec:      expectedInactiveRunSpec,
+			Err:                  false,
+			testDescription:      "Run with derived parameter",
+		},
+		// Derived parameter can't be evaluated
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.TrialParameters[1].Reference = ""
+				i.Spec.TrialTemplate.TrialParameters[1].Expression = "${num-layers} / (lr - 0.05)"
+				return i
+			}(),
+			ParameterAssignments: newFakeParameterAssignment(),
+			Err:                  true,
+			testDescription:      "Derived parameter with division by zero",
+		},
 		// Invalid JSON in unstructured
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -17,7 +17,10 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -38,16 +41,21 @@ const (
 	ExperimentGoalReachedReason          = "ExperimentGoalReached"
 	ExperimentMaxTrialsReachedReason     = "ExperimentMaxTrialsReached"
 	ExperimentSuggestionEndReachedReason = "ExperimentSuggestionEndReached"
+	ExperimentMaxDurationReachedReason   = "ExperimentMaxDurationReached"
+	ExperimentPlateauReachedReason       = "ExperimentPlateauReached"
 	ExperimentFailedReason               = "ExperimentFailed"
+	ExperimentSuspendedReason            = "ExperimentSuspended"
+	ExperimentResumedReason              = "ExperimentResumed"
 )
 
-// UpdateExperimentStatus checks if objective goal is reached and updates Experiment status from current Trials
-func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) error {
+// UpdateExperimentStatus checks if objective goal is reached and updates Experiment status from current Trials.
+// getSuggestionDone is true if suggestion service has suggested all assignments of the search space.
+func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList, getSuggestionDone bool) error {
 
 	isObjectiveGoalReached := updateTrialsSummary(instance, trials)
 
 	if !instance.IsCompleted() {
-		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, false)
+		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, getSuggestionDone)
 	}
 	return nil
 
@@ -59,6 +67,7 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 	sts := &instance.Status
 	sts.Trials = 0
 	sts.RunningTrialList, sts.PendingTrialList, sts.FailedTrialList, sts.SucceededTrialList, sts.KilledTrialList, sts.EarlyStoppedTrialList = nil, nil, nil, nil, nil, nil
+	sts.TimedOutTrialList = nil
 	bestTrialIndex := -1
 	isObjectiveGoalReached := false
 	var objectiveValueGoal float64
@@ -71,6 +80,8 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 		sts.Trials++
 		if trial.IsKilled() {
 			sts.KilledTrialList = append(sts.KilledTrialList, trial.Name)
+		} else if trial.IsTimedOut() {
+			sts.TimedOutTrialList = append(sts.TimedOutTrialList, trial.Name)
 		} else if trial.IsFailed() {
 			sts.FailedTrialList = append(sts.FailedTrialList, trial.Name)
 		} else if trial.IsSucceeded() {
@@ -126,40 +137,208 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 	sts.TrialsFailed = int32(len(sts.FailedTrialList))
 	sts.TrialsKilled = int32(len(sts.KilledTrialList))
 	sts.TrialsEarlyStopped = int32(len(sts.EarlyStoppedTrialList))
+	sts.TrialsTimedOut = int32(len(sts.TimedOutTrialList))
+
+	// For multi-objective Experiment the first Pareto optimal trial is the best one.
+	sts.ParetoOptimalTrials = nil
+	if len(instance.Spec.Objective.Objectives) > 0 {
+		var paretoTrialIndexes []int
+		paretoTrialIndexes, isObjectiveGoalReached = getParetoOptimalTrials(instance.Spec.Objective.Objectives, trials)
+		for _, index := range paretoTrialIndexes {
+			sts.ParetoOptimalTrials = append(sts.ParetoOptimalTrials, newOptimalTrial(trials.Items[index]))
+		}
+		if len(paretoTrialIndexes) > 0 {
+			bestTrialIndex = paretoTrialIndexes[0]
+		}
+	}
 
 	// if best trial is set
 	if bestTrialIndex != -1 {
-		bestTrial := trials.Items[bestTrialIndex]
+		sts.CurrentOptimalTrial = newOptimalTrial(trials.Items[bestTrialIndex])
+	}
+
+	updateObjectiveImprovementHistory(instance, trials)
+	return isObjectiveGoalReached
+}
 
-		sts.CurrentOptimalTrial.BestTrialName = bestTrial.Name
-		sts.CurrentOptimalTrial.ParameterAssignments = []commonv1beta1.ParameterAssignment{}
-		for _, parameterAssigment := range bestTrial.Spec.ParameterAssignments {
-			sts.CurrentOptimalTrial.ParameterAssignments = append(sts.CurrentOptimalTrial.ParameterAssignments, parameterAssigment)
+// updateObjectiveImprovementHistory sets the improvements of the best objective value for the stopping policy.
+// Succeeded trials with the objective metric value are ordered by the completion time. Trial improves the best value,
+// if its value is better than the last improvement by more than the tolerance.
+func updateObjectiveImprovementHistory(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) {
+	sts := &instance.Status
+	sts.ObjectiveImprovementHistory = nil
+	sts.TrialsWithoutImprovement = 0
+	policy := instance.Spec.StoppingPolicy
+	if policy == nil {
+		return
+	}
+
+	// Early stopped, failed and killed trials don't have the final objective value, so they are not counted.
+	type succeededTrial struct {
+		name           string
+		value          float64
+		completionTime time.Time
+	}
+	succeededTrials := []succeededTrial{}
+	for _, trial := range trials.Items {
+		if !trial.IsSucceeded() {
+			continue
+		}
+		value, err := strconv.ParseFloat(getObjectiveMetricValue(trial), 64)
+		if err != nil {
+			continue
+		}
+		t := succeededTrial{name: trial.Name, value: value}
+		if trial.Status.CompletionTime != nil {
+			t.completionTime = trial.Status.CompletionTime.Time
+		}
+		succeededTrials = append(succeededTrials, t)
+	}
+	sort.Slice(succeededTrials, func(i, j int) bool {
+		if !succeededTrials[i].completionTime.Equal(succeededTrials[j].completionTime) {
+			return succeededTrials[i].completionTime.Before(succeededTrials[j].completionTime)
 		}
+		return succeededTrials[i].name < succeededTrials[j].name
+	})
+
+	for i, t := range succeededTrials {
+		if len(sts.ObjectiveImprovementHistory) > 0 {
+			improvement := t.value - sts.ObjectiveImprovementHistory[len(sts.ObjectiveImprovementHistory)-1].Value
+			if instance.Spec.Objective.Type == commonv1beta1.ObjectiveTypeMinimize {
+				improvement = -improvement
+			}
+			if improvement <= policy.Tolerance {
+				sts.TrialsWithoutImprovement++
+				continue
+			}
+		}
+		sts.ObjectiveImprovementHistory = append(sts.ObjectiveImprovementHistory, experimentsv1beta1.ObjectiveImprovement{
+			TrialName:       t.name,
+			Value:           t.value,
+			CompletedTrials: int32(i + 1),
+		})
+		sts.TrialsWithoutImprovement = 0
+	}
+}
 
-		sts.CurrentOptimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
-		for _, metric := range bestTrial.Status.Observation.Metrics {
-			sts.CurrentOptimalTrial.Observation.Metrics = append(sts.CurrentOptimalTrial.Observation.Metrics, metric)
+func newOptimalTrial(trial trialsv1beta1.Trial) experimentsv1beta1.OptimalTrial {
+	optimalTrial := experimentsv1beta1.OptimalTrial{
+		BestTrialName:        trial.Name,
+		ParameterAssignments: []commonv1beta1.ParameterAssignment{},
+	}
+	for _, parameterAssigment := range trial.Spec.ParameterAssignments {
+		optimalTrial.ParameterAssignments = append(optimalTrial.ParameterAssignments, parameterAssigment)
+	}
+
+	optimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
+	if trial.Status.Observation != nil {
+		for _, metric := range trial.Status.Observation.Metrics {
+			optimalTrial.Observation.Metrics = append(optimalTrial.Observation.Metrics, metric)
 		}
 	}
-	return isObjectiveGoalReached
+	return optimalTrial
+}
+
+// getParetoOptimalTrials returns indexes of the Pareto optimal trials, sorted by the value of the first objective.
+// Only trials with numeric values of all objectives are compared.
+// It also returns true, if one trial reaches goals of all objectives.
+func getParetoOptimalTrials(objectives []commonv1beta1.Objective, trials *trialsv1beta1.TrialList) ([]int, bool) {
+	isObjectiveGoalReached := false
+	// Values are negated for maximize objectives, so that lower value is always better.
+	values := make(map[int][]float64)
+	indexes := []int{}
+	for index, trial := range trials.Items {
+		trialValues := make([]float64, 0, len(objectives))
+		for _, objective := range objectives {
+			value, err := strconv.ParseFloat(getMetricValue(trial, objective.ObjectiveMetricName), 64)
+			if err != nil {
+				break
+			}
+			if objective.Type == commonv1beta1.ObjectiveTypeMaximize {
+				value = -value
+			}
+			trialValues = append(trialValues, value)
+		}
+		if len(trialValues) != len(objectives) {
+			continue
+		}
+		values[index] = trialValues
+		indexes = append(indexes, index)
+		if isGoalReached(objectives, trialValues) {
+			isObjectiveGoalReached = true
+		}
+	}
+
+	paretoTrialIndexes := []int{}
+	for _, index := range indexes {
+		isDominated := false
+		for _, other := range indexes {
+			if other != index && dominates(values[other], values[index]) {
+				isDominated = true
+				break
+			}
+		}
+		if !isDominated {
+			paretoTrialIndexes = append(paretoTrialIndexes, index)
+		}
+	}
+	sort.SliceStable(paretoTrialIndexes, func(i, j int) bool {
+		return values[paretoTrialIndexes[i]][0] < values[paretoTrialIndexes[j]][0]
+	})
+	return paretoTrialIndexes, isObjectiveGoalReached
+}
+
+// dominates returns true, if values a are not worse than values b in all objectives and better in one of them.
+func dominates(a, b []float64) bool {
+	isBetter := false
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+		if a[i] < b[i] {
+			isBetter = true
+		}
+	}
+	return isBetter
+}
+
+// isGoalReached returns true, if values reach goals of all objectives, which have goal.
+func isGoalReached(objectives []commonv1beta1.Objective, values []float64) bool {
+	hasGoal := false
+	for i, objective := range objectives {
+		if objective.Goal == nil {
+			continue
+		}
+		hasGoal = true
+		goal := *objective.Goal
+		if objective.Type == commonv1beta1.ObjectiveTypeMaximize {
+			goal = -goal
+		}
+		if values[i] > goal {
+			return false
+		}
+	}
+	return hasGoal
 }
 
 func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
+	return getMetricValue(trial, trial.Spec.Objective.ObjectiveMetricName)
+}
+
+func getMetricValue(trial trialsv1beta1.Trial, metricName string) string {
 	if trial.Status.Observation == nil {
 		return consts.UnavailableMetricValue
 	}
-	var objectiveStrategy commonv1beta1.MetricStrategyType
-	objectiveMetricName := trial.Spec.Objective.ObjectiveMetricName
+	var metricStrategy commonv1beta1.MetricStrategyType
 	for _, strategy := range trial.Spec.Objective.MetricStrategies {
-		if strategy.Name == objectiveMetricName {
-			objectiveStrategy = strategy.Value
+		if strategy.Name == metricName {
+			metricStrategy = strategy.Value
 			break
 		}
 	}
 	for _, metric := range trial.Status.Observation.Metrics {
-		if objectiveMetricName == metric.Name {
-			switch objectiveStrategy {
+		if metricName == metric.Name {
+			switch metricStrategy {
 			case commonv1beta1.ExtractByMin:
 				if metric.Min == consts.UnavailableMetricValue {
 					return metric.Latest
@@ -181,7 +360,8 @@ func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
 // UpdateExperimentStatusCondition updates the experiment status.
 func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, isObjectiveGoalReached bool, getSuggestionDone bool) {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	completedTrialsCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed + instance.Status.TrialsKilled + instance.Status.TrialsEarlyStopped
+	completedTrialsCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed + instance.Status.TrialsKilled +
+		instance.Status.TrialsEarlyStopped + instance.Status.TrialsTimedOut
 	failedTrialsCount := instance.Status.TrialsFailed
 	activeTrialsCount := instance.Status.TrialsPending + instance.Status.TrialsRunning
 	now := metav1.Now()
@@ -215,6 +395,28 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	// Then check if MaxDuration is reached.
+	if remaining, ok := GetRemainingDuration(instance, now.Time); ok && remaining <= 0 {
+		msg := "Experiment has succeeded because max duration has reached"
+		instance.MarkExperimentStatusSucceeded(ExperimentMaxDurationReachedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
+	// Then check if the best objective value has reached a plateau.
+	if instance.Spec.StoppingPolicy != nil && len(instance.Status.ObjectiveImprovementHistory) > 0 &&
+		instance.Status.TrialsWithoutImprovement >= instance.Spec.StoppingPolicy.Patience {
+		msg := fmt.Sprintf("Experiment has succeeded because best objective value has not improved in the last %v trials",
+			instance.Status.TrialsWithoutImprovement)
+		instance.MarkExperimentStatusSucceeded(ExperimentPlateauReachedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
 	if getSuggestionDone && activeTrialsCount == 0 {
 		msg := "Experiment has succeeded because suggestion service has reached the end"
 		instance.MarkExperimentStatusSucceeded(ExperimentSuggestionEndReachedReason, msg)
@@ -224,10 +426,26 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	if instance.Spec.Suspended {
+		msg := "Experiment is suspended"
+		instance.MarkExperimentStatusSuspended(ExperimentSuspendedReason, msg)
+		return
+	}
+
 	msg := "Experiment is running"
 	instance.MarkExperimentStatusRunning(ExperimentRunningReason, msg)
 }
 
+// GetRemainingDuration returns the duration until max duration of the experiment is reached.
+// It returns false if max duration is not specified or experiment is not started.
+func GetRemainingDuration(instance *experimentsv1beta1.Experiment, now time.Time) (time.Duration, bool) {
+	if instance.Spec.MaxDuration == nil || instance.Status.StartTime == nil {
+		return 0, false
+	}
+	deadline := instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration)
+	return deadline.Sub(now), true
+}
+
 // IsCompletedExperimentRestartable returns whether experiment is restartable or not
 // Experiment is restartable only if it is in succeeded state by reaching max trials and
 // ResumePolicy = LongRunning or ResumePolicy = FromVolume
@@ -0,0 +1,311 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func newFakeObjectiveSpec(objectives []commonv1beta1.Objective) *commonv1beta1.ObjectiveSpec {
+	return &commonv1beta1.ObjectiveSpec{
+		Type:                  objectives[0].Type,
+		ObjectiveMetricName:   objectives[0].ObjectiveMetricName,
+		AdditionalMetricNames: []string{"latency"},
+		MetricStrategies: []commonv1beta1.MetricStrategy{
+			{Name: "accuracy", Value: commonv1beta1.ExtractByMax},
+			{Name: "latency", Value: commonv1beta1.ExtractByLatest},
+		},
+		Objectives: objectives,
+	}
+}
+
+func newFakeTrial(name string, objective *commonv1beta1.ObjectiveSpec, accuracy, latency string) trialsv1beta1.Trial {
+	return trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: objective,
+		},
+		Status: trialsv1beta1.TrialStatus{
+			Observation: &commonv1beta1.Observation{
+				Metrics: []commonv1beta1.Metric{
+					{Name: "accuracy", Min: "0", Max: accuracy, Latest: "0"},
+					{Name: "latency", Min: "0", Max: "100", Latest: latency},
+				},
+			},
+		},
+	}
+}
+
+func TestUpdateTrialsSummaryMultiObjective(t *testing.T) {
+	accuracyGoal := 0.9
+	latencyGoal := 10.0
+	maxLatencyGoal := 50.0
+
+	testCases := []struct {
+		objectives             []commonv1beta1.Objective
+		expectedParetoTrials   []string
+		expectedOptimalTrial   string
+		isObjectiveGoalReached bool
+		testDesc               string
+	}{
+		{
+			objectives: []commonv1beta1.Objective{
+				{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "accuracy"},
+				{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency"},
+			},
+			expectedParetoTrials: []string{"trial-3", "trial-1", "trial-2"},
+			expectedOptimalTrial: "trial-3",
+			testDesc:             "Pareto front of maximize and minimize objectives",
+		},
+		{
+			objectives: []commonv1beta1.Objective{
+				{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "accuracy", Goal: &accuracyGoal},
+				{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency", Goal: &latencyGoal},
+			},
+			expectedParetoTrials:   []string{"trial-3", "trial-1", "trial-2"},
+			expectedOptimalTrial:   "trial-3",
+			isObjectiveGoalReached: true,
+			testDesc:               "One trial reaches goals of all objectives",
+		},
+		{
+			objectives: []commonv1beta1.Objective{
+				{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "accuracy", Goal: &accuracyGoal},
+				{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "latency", Goal: &maxLatencyGoal},
+			},
+			expectedParetoTrials: []string{"trial-4"},
+			expectedOptimalTrial: "trial-4",
+			testDesc:             "Goal of one objective is not reached",
+		},
+	}
+
+	for _, tc := range testCases {
+		objective := newFakeObjectiveSpec(tc.objectives)
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Objective: objective,
+			},
+		}
+		trials := &trialsv1beta1.TrialList{
+			Items: []trialsv1beta1.Trial{
+				newFakeTrial("trial-0", objective, "0.7", "20"),
+				newFakeTrial("trial-1", objective, "0.92", "8"),
+				newFakeTrial("trial-2", objective, "0.8", "5"),
+				newFakeTrial("trial-3", objective, "0.95", "30"),
+				newFakeTrial("trial-4", objective, "0.95", "40"),
+				newFakeTrial("trial-5", objective, "0.99", "unavailable"),
+			},
+		}
+
+		isObjectiveGoalReached := updateTrialsSummary(instance, trials)
+		if isObjectiveGoalReached != tc.isObjectiveGoalReached {
+			t.Errorf("Case: %v. Expected objective goal reached %v, got %v", tc.testDesc, tc.isObjectiveGoalReached, isObjectiveGoalReached)
+		}
+		paretoTrials := []string{}
+		for _, trial := range instance.Status.ParetoOptimalTrials {
+			paretoTrials = append(paretoTrials, trial.BestTrialName)
+		}
+		if !reflect.DeepEqual(paretoTrials, tc.expectedParetoTrials) {
+			t.Errorf("Case: %v. Expected Pareto optimal trials %v, got %v", tc.testDesc, tc.expectedParetoTrials, paretoTrials)
+		}
+		if instance.Status.CurrentOptimalTrial.BestTrialName != tc.expectedOptimalTrial {
+			t.Errorf("Case: %v. Expected optimal trial %v, got %v", tc.testDesc, tc.expectedOptimalTrial, instance.Status.CurrentOptimalTrial.BestTrialName)
+		}
+	}
+}
+
+func TestUpdateExperimentStatusConditionSuspended(t *testing.T) {
+	testCases := []struct {
+		suspended         bool
+		expectedSuspended bool
+		expectedRunning   bool
+		testDesc          string
+	}{
+		{
+			suspended:         true,
+			expectedSuspended: true,
+			expectedRunning:   false,
+			testDesc:          "Suspend running experiment",
+		},
+		{
+			suspended:         false,
+			expectedSuspended: false,
+			expectedRunning:   true,
+			testDesc:          "Experiment is not suspended",
+		},
+	}
+
+	for _, tc := range testCases {
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Suspended: tc.suspended,
+			},
+		}
+		instance.MarkExperimentStatusRunning(ExperimentRunningReason, "Experiment is running")
+
+		UpdateExperimentStatusCondition(nil, instance, false, false)
+		if instance.IsSuspended() != tc.expectedSuspended {
+			t.Errorf("Case: %v. Expected suspended %v, got %v", tc.testDesc, tc.expectedSuspended, instance.IsSuspended())
+		}
+		if instance.IsRunning() != tc.expectedRunning {
+			t.Errorf("Case: %v. Expected running %v, got %v", tc.testDesc, tc.expectedRunning, instance.IsRunning())
+		}
+	}
+}
+
+func TestUpdateExperimentStatusConditionMaxDuration(t *testing.T) {
+	testCases := []struct {
+		maxDuration       *metav1.Duration
+		startTime         metav1.Time
+		expectedSucceeded bool
+		testDesc          string
+	}{
+		{
+			maxDuration:       &metav1.Duration{Duration: time.Hour},
+			startTime:         metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+			expectedSucceeded: true,
+			testDesc:          "Max duration is reached",
+		},
+		{
+			maxDuration:       &metav1.Duration{Duration: time.Hour},
+			startTime:         metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+			expectedSucceeded: false,
+			testDesc:          "Max duration is not reached",
+		},
+		{
+			maxDuration:       nil,
+			startTime:         metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+			expectedSucceeded: false,
+			testDesc:          "Max duration is not specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				MaxDuration: tc.maxDuration,
+			},
+			Status: experimentsv1beta1.ExperimentStatus{
+				StartTime: &tc.startTime,
+			},
+		}
+
+		UpdateExperimentStatusCondition(NewExpsCollector(nil, prometheus.NewRegistry()), instance, false, false)
+		if instance.IsCompletedReason(ExperimentMaxDurationReachedReason) != tc.expectedSucceeded {
+			t.Errorf("Case: %v. Expected succeeded %v, got conditions %v", tc.testDesc, tc.expectedSucceeded, instance.Status.Conditions)
+		}
+	}
+}
+
+func TestUpdateExperimentStatusPlateau(t *testing.T) {
+	objective := newFakeObjectiveSpec([]commonv1beta1.Objective{
+		{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "accuracy"},
+	})
+	// Trials are completed in the reverse order of the names.
+	accuracies := []string{"0.5", "0.9", "0.905", "0.8", "0.95", "0.7", "0.6"}
+	now := time.Now()
+	trials := &trialsv1beta1.TrialList{}
+	for i, accuracy := range accuracies {
+		trial := newFakeTrial(fmt.Sprintf("trial-%v", len(accuracies)-i), objective, accuracy, "0")
+		trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial is succeeded")
+		completionTime := metav1.NewTime(now.Add(time.Duration(i) * time.Minute))
+		trial.Status.CompletionTime = &completionTime
+		trials.Items = append(trials.Items, trial)
+	}
+	running := newFakeTrial("trial-running", objective, "0.99", "0")
+	running.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+	trials.Items = append(trials.Items, running)
+	// Early stopped trials are not counted, since they don't have the final objective value.
+	for i, accuracy := range []string{"0.1", "0.99"} {
+		earlyStopped := newFakeTrial(fmt.Sprintf("trial-early-stopped-%v", i), objective, accuracy, "0")
+		earlyStopped.MarkTrialStatusEarlyStopped("TrialEarlyStopped", "Trial is early stopped")
+		completionTime := metav1.NewTime(now.Add(time.Duration(len(accuracies)+i) * time.Minute))
+		earlyStopped.Status.CompletionTime = &completionTime
+		trials.Items = append(trials.Items, earlyStopped)
+	}
+
+	testCases := []struct {
+		stoppingPolicy                   *experimentsv1beta1.StoppingPolicy
+		expectedHistory                  []experimentsv1beta1.ObjectiveImprovement
+		expectedTrialsWithoutImprovement int32
+		expectedSucceeded                bool
+		testDesc                         string
+	}{
+		{
+			stoppingPolicy: &experimentsv1beta1.StoppingPolicy{Patience: 2},
+			expectedHistory: []experimentsv1beta1.ObjectiveImprovement{
+				{TrialName: "trial-7", Value: 0.5, CompletedTrials: 1},
+				{TrialName: "trial-6", Value: 0.9, CompletedTrials: 2},
+				{TrialName: "trial-5", Value: 0.905, CompletedTrials: 3},
+				{TrialName: "trial-3", Value: 0.95, CompletedTrials: 5},
+			},
+			expectedTrialsWithoutImprovement: 2,
+			expectedSucceeded:                true,
+			testDesc:                         "Best objective value is not improved in the last patience trials",
+		},
+		{
+			stoppingPolicy: &experimentsv1beta1.StoppingPolicy{Patience: 3, Tolerance: 0.01},
+			expectedHistory: []experimentsv1beta1.ObjectiveImprovement{
+				{TrialName: "trial-7", Value: 0.5, CompletedTrials: 1},
+				{TrialName: "trial-6", Value: 0.9, CompletedTrials: 2},
+				{TrialName: "trial-3", Value: 0.95, CompletedTrials: 5},
+			},
+			expectedTrialsWithoutImprovement: 2,
+			expectedSucceeded:                false,
+			testDesc:                         "Improvement less than tolerance is not counted",
+		},
+		{
+			stoppingPolicy:    nil,
+			expectedSucceeded: false,
+			testDesc:          "Stopping policy is not specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		instance := &experimentsv1beta1.Experiment{
+			Spec: experimentsv1beta1.ExperimentSpec{
+				Objective:      objective,
+				StoppingPolicy: tc.stoppingPolicy,
+			},
+		}
+
+		if err := UpdateExperimentStatus(NewExpsCollector(nil, prometheus.NewRegistry()), instance, trials, false); err != nil {
+			t.Fatalf("Case: %v. Failed to update experiment status: %v", tc.testDesc, err)
+		}
+		if !reflect.DeepEqual(instance.Status.ObjectiveImprovementHistory, tc.expectedHistory) {
+			t.Errorf("Case: %v. Expected improvement history %v, got %v", tc.testDesc, tc.expectedHistory, instance.Status.ObjectiveImprovementHistory)
+		}
+		if instance.Status.TrialsWithoutImprovement != tc.expectedTrialsWithoutImprovement {
+			t.Errorf("Case: %v. Expected %v trials without improvement, got %v", tc.testDesc, tc.expectedTrialsWithoutImprovement, instance.Status.TrialsWithoutImprovement)
+		}
+		if instance.IsCompletedReason(ExperimentPlateauReachedReason) != tc.expectedSucceeded {
+			t.Errorf("Case: %v. Expected succeeded %v, got conditions %v", tc.testDesc, tc.expectedSucceeded, instance.Status.Conditions)
+		}
+	}
+}
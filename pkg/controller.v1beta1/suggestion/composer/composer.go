@@ -88,6 +88,11 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		}
 	}
 
+	tlsConfigData, err := katibconfig.GetGRPCTLSConfigData(g.Client)
+	if err != nil {
+		return nil, err
+	}
+
 	d := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        util.GetSuggestionDeploymentName(s),
@@ -105,7 +110,7 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 					Annotations: util.SuggestionAnnotations(s),
 				},
 				Spec: corev1.PodSpec{
-					Containers: g.desiredContainers(s, suggestionConfigData, earlyStoppingConfigData),
+					Containers: g.desiredContainers(s, suggestionConfigData, earlyStoppingConfigData, tlsConfigData),
 				},
 			},
 		},
@@ -130,6 +135,11 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		}
 	}
 
+	// Attach gRPC TLS Secret to the suggestion pod spec if TLS is configured for any container.
+	if tlsConfigData.EnabledFor(suggestionConfigData.GRPCTLS) || tlsConfigData.EnabledFor(earlyStoppingConfigData.GRPCTLS) {
+		d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes, tlsConfigData.Volume())
+	}
+
 	// Attach ServiceAccount if early stopping is used.
 	// For custom service account user should manually add appropriate Role to change Trial status.
 	if s.Spec.EarlyStopping != nil && s.Spec.EarlyStopping.AlgorithmName != "" && suggestionConfigData.ServiceAccountName == "" {
@@ -181,7 +191,8 @@ func (g *General) DesiredService(s *suggestionsv1beta1.Suggestion) (*corev1.Serv
 
 func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 	suggestionConfigData katibconfig.SuggestionConfig,
-	earlyStoppingConfigData katibconfig.EarlyStoppingConfig) []corev1.Container {
+	earlyStoppingConfigData katibconfig.EarlyStoppingConfig,
+	tlsConfigData katibconfig.GRPCTLSConfig) []corev1.Container {
 
 	containers := []corev1.Container{}
 	suggestionContainer := corev1.Container{
@@ -198,14 +209,27 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 	}
 
 	if viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion) {
+		probeCommand := []string{
+			defaultGRPCHealthCheckProbe,
+			fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
+			fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
+		}
+		// Health probe must present client certificate to the suggestion service with mTLS.
+		if tlsConfigData.EnabledFor(suggestionConfigData.GRPCTLS) {
+			probeCommand = append(probeCommand,
+				"-tls",
+				fmt.Sprintf("-tls-ca-cert=%s", tlsConfigData.CAFile()),
+				fmt.Sprintf("-tls-client-cert=%s", tlsConfigData.CertFile()),
+				fmt.Sprintf("-tls-client-key=%s", tlsConfigData.KeyFile()),
+			)
+			if tlsConfigData.ServerName != "" {
+				probeCommand = append(probeCommand, fmt.Sprintf("-tls-server-name=%s", tlsConfigData.ServerName))
+			}
+		}
 		suggestionContainer.ReadinessProbe = &corev1.Probe{
 			Handler: corev1.Handler{
 				Exec: &corev1.ExecAction{
-					Command: []string{
-						defaultGRPCHealthCheckProbe,
-						fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
-						fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
-					},
+					Command: probeCommand,
 				},
 			},
 			InitialDelaySeconds: defaultInitialDelaySeconds,
@@ -214,11 +238,7 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 		suggestionContainer.LivenessProbe = &corev1.Probe{
 			Handler: corev1.Handler{
 				Exec: &corev1.ExecAction{
-					Command: []string{
-						defaultGRPCHealthCheckProbe,
-						fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
-						fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
-					},
+					Command: probeCommand,
 				},
 			},
 			// Ref https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
@@ -229,6 +249,7 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 	}
 
 	// Attach volume mounts to the suggestion container if ResumePolicy = FromVolume
+	// Algorithm service can store its state under the mount path from the env.
 	if s.Spec.ResumePolicy == experimentsv1beta1.FromVolume {
 		suggestionContainer.VolumeMounts = []corev1.VolumeMount{
 			{
@@ -236,6 +257,18 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 				MountPath: suggestionConfigData.VolumeMountPath,
 			},
 		}
+		suggestionContainer.Env = []corev1.EnvVar{
+			{
+				Name:  consts.SuggestionVolumeMountPathEnvName,
+				Value: suggestionConfigData.VolumeMountPath,
+			},
+		}
+	}
+
+	// Mount gRPC TLS Secret and pass the certificate paths to the suggestion service.
+	if tlsConfigData.EnabledFor(suggestionConfigData.GRPCTLS) {
+		suggestionContainer.VolumeMounts = append(suggestionContainer.VolumeMounts, tlsConfigData.VolumeMount())
+		suggestionContainer.Env = append(suggestionContainer.Env, tlsConfigData.Env()...)
 	}
 	containers = append(containers, suggestionContainer)
 
@@ -251,6 +284,10 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 				},
 			},
 		}
+		if tlsConfigData.EnabledFor(earlyStoppingConfigData.GRPCTLS) {
+			earlyStoppingContainer.VolumeMounts = []corev1.VolumeMount{tlsConfigData.VolumeMount()}
+			earlyStoppingContainer.Env = tlsConfigData.Env()
+		}
 
 		containers = append(containers, earlyStoppingContainer)
 	}
@@ -338,6 +375,18 @@ func (g *General) DesiredRBAC(s *suggestionsv1beta1.Suggestion) (*corev1.Service
 					rbacv1.VerbAll,
 				},
 			},
+			// Early stopping service gets the Experiment to refresh the rules of the running Trials.
+			{
+				APIGroups: []string{
+					experimentsv1beta1.Group,
+				},
+				Resources: []string{
+					consts.PluralExperiment,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 
@@ -194,6 +194,7 @@ func TestDesiredDeployment(t *testing.T) {
 			expectedDeployment: func() *appsv1.Deployment {
 				deploy := newFakeDeployment()
 				deploy.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath = "/custom/container/path"
+				deploy.Spec.Template.Spec.Containers[0].Env[0].Value = "/custom/container/path"
 				return deploy
 			}(),
 			err:             false,
@@ -226,6 +227,69 @@ func TestDesiredDeployment(t *testing.T) {
 			err:             true,
 			testDescription: "Get early stopping config error, image is missed",
 		},
+		{
+			suggestion: newFakeSuggestion(),
+			configMap: func() *corev1.ConfigMap {
+				cm := newFakeKatibConfig(newFakeSuggestionConfig(), newFakeEarlyStoppingConfig())
+				cm.Data[consts.LabelGRPCTLSTag] = `{"secretName": "katib-grpc-tls"}`
+				return cm
+			}(),
+			expectedDeployment: newFakeDeployment(),
+			err:                false,
+			testDescription:    "gRPC TLS is not used if suggestion and early stopping do not opt in",
+		},
+		{
+			suggestion: newFakeSuggestion(),
+			configMap: func() *corev1.ConfigMap {
+				esC := newFakeEarlyStoppingConfig()
+				esC.GRPCTLS = true
+				cm := newFakeKatibConfig(newFakeSuggestionConfig(), esC)
+				cm.Data[consts.LabelGRPCTLSTag] = `{"secretName": "katib-grpc-tls"}`
+				return cm
+			}(),
+			expectedDeployment: func() *appsv1.Deployment {
+				tlsConfig := newFakeGRPCTLSConfig()
+				deploy := newFakeDeployment()
+				deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, tlsConfig.Volume())
+				deploy.Spec.Template.Spec.Containers[1].VolumeMounts = []corev1.VolumeMount{tlsConfig.VolumeMount()}
+				deploy.Spec.Template.Spec.Containers[1].Env = tlsConfig.Env()
+				return deploy
+			}(),
+			err:             false,
+			testDescription: "gRPC TLS is used only for early stopping if algorithm does not opt in",
+		},
+		{
+			suggestion: newFakeSuggestion(),
+			configMap: func() *corev1.ConfigMap {
+				sc := newFakeSuggestionConfig()
+				sc.GRPCTLS = true
+				esC := newFakeEarlyStoppingConfig()
+				esC.GRPCTLS = true
+				cm := newFakeKatibConfig(sc, esC)
+				cm.Data[consts.LabelGRPCTLSTag] = `{"secretName": "katib-grpc-tls"}`
+				return cm
+			}(),
+			expectedDeployment: func() *appsv1.Deployment {
+				tlsConfig := newFakeGRPCTLSConfig()
+				deploy := newFakeDeployment()
+				deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, tlsConfig.Volume())
+				probeCommand := append(deploy.Spec.Template.Spec.Containers[0].ReadinessProbe.Exec.Command,
+					"-tls",
+					fmt.Sprintf("-tls-ca-cert=%s", tlsConfig.CAFile()),
+					fmt.Sprintf("-tls-client-cert=%s", tlsConfig.CertFile()),
+					fmt.Sprintf("-tls-client-key=%s", tlsConfig.KeyFile()),
+				)
+				deploy.Spec.Template.Spec.Containers[0].ReadinessProbe.Exec.Command = probeCommand
+				deploy.Spec.Template.Spec.Containers[0].LivenessProbe.Exec.Command = probeCommand
+				deploy.Spec.Template.Spec.Containers[0].VolumeMounts = append(deploy.Spec.Template.Spec.Containers[0].VolumeMounts, tlsConfig.VolumeMount())
+				deploy.Spec.Template.Spec.Containers[0].Env = append(deploy.Spec.Template.Spec.Containers[0].Env, tlsConfig.Env()...)
+				deploy.Spec.Template.Spec.Containers[1].VolumeMounts = []corev1.VolumeMount{tlsConfig.VolumeMount()}
+				deploy.Spec.Template.Spec.Containers[1].Env = tlsConfig.Env()
+				return deploy
+			}(),
+			err:             false,
+			testDescription: "gRPC TLS is used for suggestion and early stopping if algorithm opts in",
+		},
 	}
 
 	viper.Set(consts.ConfigEnableGRPCProbeInSuggestion, true)
@@ -613,6 +677,18 @@ func TestDesiredRBAC(t *testing.T) {
 					rbacv1.VerbAll,
 				},
 			},
+			// Early stopping service gets the Experiment to refresh the rules of the running Trials.
+			{
+				APIGroups: []string{
+					experimentsv1beta1.Group,
+				},
+				Resources: []string{
+					consts.PluralExperiment,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 
@@ -753,6 +829,13 @@ func newFakeKatibConfig(suggestionConfig katibconfig.SuggestionConfig, earlyStop
 	}
 }
 
+func newFakeGRPCTLSConfig() katibconfig.GRPCTLSConfig {
+	return katibconfig.GRPCTLSConfig{
+		SecretName: "katib-grpc-tls",
+		MountPath:  consts.DefaultGRPCTLSMountPath,
+	}
+}
+
 func newFakeSuggestion() *suggestionsv1beta1.Suggestion {
 	return &suggestionsv1beta1.Suggestion{
 		ObjectMeta: metav1.ObjectMeta{
@@ -881,6 +964,12 @@ func newFakeContainers() []corev1.Container {
 					MountPath: consts.DefaultContainerSuggestionVolumeMountPath,
 				},
 			},
+			Env: []corev1.EnvVar{
+				{
+					Name:  consts.SuggestionVolumeMountPathEnvName,
+					Value: consts.DefaultContainerSuggestionVolumeMountPath,
+				},
+			},
 		},
 		{
 			Name:            consts.ContainerEarlyStopping,
@@ -42,6 +42,7 @@ import (
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/composer"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/suggestionclient"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 const (
@@ -60,7 +61,7 @@ func Add(mgr manager.Manager) error {
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	return &ReconcileSuggestion{
 		Client:           mgr.GetClient(),
-		SuggestionClient: suggestionclient.New(),
+		SuggestionClient: suggestionclient.New(mgr.GetClient()),
 		scheme:           mgr.GetScheme(),
 		Composer:         composer.New(mgr),
 		recorder:         mgr.GetEventRecorderFor(ControllerName),
@@ -137,6 +138,7 @@ func (r *ReconcileSuggestion) Reconcile(ctx context.Context, request reconcile.R
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// For additional cleanup logic use finalizers.
+			r.DeleteEmbeddedState(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -145,6 +147,7 @@ func (r *ReconcileSuggestion) Reconcile(ctx context.Context, request reconcile.R
 	instance := oldS.DeepCopy()
 	// Suggestion will be succeeded if ResumePolicy = Never or ResumePolicy = FromVolume
 	if instance.IsSucceeded() {
+		r.DeleteEmbeddedState(request.NamespacedName)
 		err = r.deleteDeployment(instance, request.NamespacedName)
 		if err != nil {
 			return reconcile.Result{}, err
@@ -191,33 +194,109 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	suggestionNsName := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
 	logger := log.WithValues("Suggestion", suggestionNsName)
 
+	suggestionConfigData, err := katibconfig.GetSuggestionConfigData(instance.Spec.Algorithm.AlgorithmName, r.Client)
+	if err != nil {
+		return err
+	}
+
+	// Embedded and shared Suggestion services don't require Deployment and Service for the Suggestion.
+	if suggestionclient.IsEmbedded(suggestionConfigData) {
+		msg := "Suggestion service is embedded"
+		instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionTrue, SuggestionEmbeddedReason, msg)
+	} else if util.IsSharedSuggestion(suggestionConfigData) {
+		msg := "Suggestion service is shared"
+		instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionTrue, SuggestionSharedReason, msg)
+	} else if isReady, err := r.reconcileSuggestionDeployment(instance); err != nil {
+		return err
+	} else if !isReady {
+		return nil
+	}
+
+	experiment := &experimentsv1beta1.Experiment{}
+	trials := &trialsv1beta1.TrialList{}
+
+	if err := r.Get(context.TODO(), types.NamespacedName{
+		Name:      instance.Name,
+		Namespace: instance.Namespace,
+	}, experiment); err != nil {
+		return err
+	}
+
+	if err := r.List(context.TODO(), trials, client.MatchingLabels(util.TrialLabels(experiment))); err != nil {
+		return err
+	}
+	// TODO (andreyvelich): Do we want to run ValidateAlgorithmSettings when Experiment is restarting?
+	// Currently it is running.
+	if !instance.IsRunning() {
+		if err = r.ValidateAlgorithmSettings(instance, experiment); err != nil {
+			logger.Error(err, "Marking suggestion failed as algorithm settings validation failed")
+			msg := fmt.Sprintf("Validation failed: %v", err)
+			instance.MarkSuggestionStatusFailed(SuggestionFailedReason, msg)
+			// return nil since it is a terminal condition
+			return nil
+		}
+		if instance.Spec.EarlyStopping != nil && instance.Spec.EarlyStopping.AlgorithmName != "" {
+			if err = r.ValidateEarlyStoppingSettings(instance, experiment); err != nil {
+				logger.Error(err, "Marking suggestion failed as early stopping settings validation failed")
+				msg := fmt.Sprintf("Validation failed: %v", err)
+				instance.MarkSuggestionStatusFailed(SuggestionFailedReason, msg)
+				// return nil since it is a terminal condition
+				return nil
+			}
+		}
+		msg := "Suggestion is running"
+		instance.MarkSuggestionStatusRunning(corev1.ConditionTrue, SuggestionRunningReason, msg)
+	}
+	// Exhausted suggestion service can't return new assignments.
+	if instance.IsExhausted() {
+		logger.Info("Search space is exhausted, assignments are not synced", "Suggestion Count", instance.Status.SuggestionCount)
+		return nil
+	}
+	logger.Info("Sync assignments", "Suggestion Requests", instance.Spec.Requests,
+		"Suggestion Count", instance.Status.SuggestionCount)
+	warmStartTrials, err := r.getWarmStartTrials(experiment)
+	if err != nil {
+		return err
+	}
+	if err = r.SyncAssignments(instance, experiment, trials.Items, warmStartTrials); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reconcileSuggestionDeployment reconciles volume, Service, RBAC and Deployment for the Suggestion service.
+// It returns true if the Deployment is ready.
+func (r *ReconcileSuggestion) reconcileSuggestionDeployment(instance *suggestionsv1beta1.Suggestion) (bool, error) {
+	suggestionNsName := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+
 	// If ResumePolicy = FromVolume volume is reconciled for suggestion
 	if instance.Spec.ResumePolicy == experimentsv1beta1.FromVolume {
 		pvc, pv, err := r.DesiredVolume(instance)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Reconcile PVC and PV
 		_, _, err = r.reconcileVolume(pvc, pv, suggestionNsName)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 	}
 
 	service, err := r.DesiredService(instance)
 	if err != nil {
-		return err
+		return false, err
 	}
 	_, err = r.reconcileService(service, suggestionNsName)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	deploy, err := r.DesiredDeployment(instance)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// If early stopping is used, create RBAC.
@@ -228,63 +307,31 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 
 		serviceAccount, role, roleBinding, err := r.DesiredRBAC(instance)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Reconcile ServiceAccount, Role and RoleBinding
 		err = r.reconcileRBAC(serviceAccount, role, roleBinding, suggestionNsName)
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
 	if foundDeploy, err := r.reconcileDeployment(deploy, suggestionNsName); err != nil {
-		return err
+		return false, err
 	} else {
 		if isReady := r.checkDeploymentReady(foundDeploy); isReady != true {
 			// deployment is not ready yet
 			msg := "Deployment is not ready"
 			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionFalse, SuggestionDeploymentNotReady, msg)
-			return nil
+			return false, nil
 		} else {
 			msg := "Deployment is ready"
 			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionTrue, SuggestionDeploymentReady, msg)
 		}
 
 	}
-	experiment := &experimentsv1beta1.Experiment{}
-	trials := &trialsv1beta1.TrialList{}
-
-	if err := r.Get(context.TODO(), types.NamespacedName{
-		Name:      instance.Name,
-		Namespace: instance.Namespace,
-	}, experiment); err != nil {
-		return err
-	}
-
-	if err := r.List(context.TODO(), trials, client.MatchingLabels(util.TrialLabels(experiment))); err != nil {
-		return err
-	}
-	// TODO (andreyvelich): Do we want to run ValidateAlgorithmSettings when Experiment is restarting?
-	// Currently it is running.
-	if !instance.IsRunning() {
-		if err = r.ValidateAlgorithmSettings(instance, experiment); err != nil {
-			logger.Error(err, "Marking suggestion failed as algorithm settings validation failed")
-			msg := fmt.Sprintf("Validation failed: %v", err)
-			instance.MarkSuggestionStatusFailed(SuggestionFailedReason, msg)
-			// return nil since it is a terminal condition
-			return nil
-		}
-		msg := "Suggestion is running"
-		instance.MarkSuggestionStatusRunning(corev1.ConditionTrue, SuggestionRunningReason, msg)
-	}
-	logger.Info("Sync assignments", "Suggestion Requests", instance.Spec.Requests,
-		"Suggestion Count", instance.Status.SuggestionCount)
-	if err = r.SyncAssignments(instance, experiment, trials.Items); err != nil {
-		return err
-	}
-
-	return nil
+	return true, nil
 }
 
 func (r *ReconcileSuggestion) checkDeploymentReady(deploy *appsv1.Deployment) bool {
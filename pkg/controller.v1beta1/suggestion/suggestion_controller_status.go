@@ -27,6 +27,8 @@ const (
 	SuggestionCreatedReason      = "SuggestionCreated"
 	SuggestionDeploymentReady    = "DeploymentReady"
 	SuggestionDeploymentNotReady = "DeploymentNotReady"
+	SuggestionEmbeddedReason     = "SuggestionEmbedded"
+	SuggestionSharedReason       = "SuggestionShared"
 	SuggestionRunningReason      = "SuggestionRunning"
 	SuggestionFailedReason       = "SuggestionFailed"
 )
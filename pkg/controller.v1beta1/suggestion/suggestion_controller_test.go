@@ -98,7 +98,8 @@ func TestReconcile(t *testing.T) {
 	}()
 
 	mockSuggestionClient.EXPECT().ValidateAlgorithmSettings(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	mockSuggestionClient.EXPECT().SyncAssignments(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSuggestionClient.EXPECT().SyncAssignments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSuggestionClient.EXPECT().DeleteEmbeddedState(gomock.Any()).AnyTimes()
 
 	instance := &suggestionsv1beta1.Suggestion{
 		ObjectMeta: metav1.ObjectMeta{
@@ -24,8 +24,12 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 )
 
 func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, suggestionNsName types.NamespacedName) (*appsv1.Deployment, error) {
@@ -190,3 +194,24 @@ func (r *ReconcileSuggestion) deleteService(instance *v1beta1.Suggestion, sugges
 
 	return nil
 }
+
+// getWarmStartTrials returns Trials of the Experiments from the warm start specification.
+// Experiments from the other namespaces are ignored.
+func (r *ReconcileSuggestion) getWarmStartTrials(experiment *experimentsv1beta1.Experiment) ([]trialsv1beta1.Trial, error) {
+	if experiment.Spec.WarmStart == nil {
+		return nil, nil
+	}
+	warmStartTrials := []trialsv1beta1.Trial{}
+	for _, ref := range experiment.Spec.WarmStart.Experiments {
+		if ref.Namespace != "" && ref.Namespace != experiment.Namespace {
+			continue
+		}
+		trials := &trialsv1beta1.TrialList{}
+		if err := r.List(context.TODO(), trials, client.InNamespace(experiment.Namespace),
+			client.MatchingLabels{consts.LabelExperimentName: ref.Name}); err != nil {
+			return nil, err
+		}
+		warmStartTrials = append(warmStartTrials, trials.Items...)
+	}
+	return warmStartTrials, nil
+}
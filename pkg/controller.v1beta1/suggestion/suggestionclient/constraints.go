@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestionclient
+
+import (
+	"fmt"
+
+	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/expression"
+)
+
+const (
+	// maxSuggestionAttempts is the maximum number of GetSuggestions calls in one sync
+	// to replace the assignments which violate the Experiment constraints.
+	maxSuggestionAttempts = 10
+	// maxInfeasibleSuggestions is the number of the latest infeasible assignments
+	// which are stored in the Suggestion status.
+	maxInfeasibleSuggestions = 100
+)
+
+type constraint struct {
+	name       string
+	expression *expression.Expression
+}
+
+// parseConstraints parses expressions of the Experiment constraints.
+func parseConstraints(constraints []experimentsv1beta1.ParameterConstraint) ([]constraint, error) {
+	parsed := make([]constraint, 0, len(constraints))
+	for _, c := range constraints {
+		e, err := expression.Parse(c.Expression)
+		if err != nil {
+			return nil, err
+		}
+		name := c.Name
+		if name == "" {
+			name = c.Expression
+		}
+		parsed = append(parsed, constraint{name: name, expression: e})
+	}
+	return parsed, nil
+}
+
+// checkConstraints returns the reason why the assignments are infeasible
+// or empty string if all constraints are satisfied.
+// Constraints which reference unassigned (inactive conditional) parameters are not checked.
+func checkConstraints(constraints []constraint, assignments []commonapiv1beta1.ParameterAssignment) string {
+	values := make(map[string]string, len(assignments))
+	for _, a := range assignments {
+		values[a.Name] = a.Value
+	}
+
+	for _, c := range constraints {
+		assigned := true
+		for _, p := range c.expression.Parameters() {
+			if _, ok := values[p]; !ok {
+				assigned = false
+				break
+			}
+		}
+		if !assigned {
+			continue
+		}
+		satisfied, err := c.expression.EvaluateBool(values)
+		if err != nil {
+			return fmt.Sprintf("Constraint %v can't be evaluated: %v", c.name, err)
+		}
+		if !satisfied {
+			return fmt.Sprintf("Constraint %v is violated", c.name)
+		}
+	}
+	return ""
+}
+
+// convertInfeasibleSuggestions converts the infeasible assignments to the GRPC Trials.
+func convertInfeasibleSuggestions(tas []suggestionsv1beta1.TrialAssignment) []*suggestionapi.Trial {
+	trials := make([]*suggestionapi.Trial, 0, len(tas))
+	for _, ta := range tas {
+		trials = append(trials, &suggestionapi.Trial{
+			Name: ta.Name,
+			Spec: &suggestionapi.TrialSpec{
+				ParameterAssignments: convertTrialParameterAssignments(ta.ParameterAssignments),
+			},
+		})
+	}
+	return trials
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestionclient
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
+
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+// EmbeddedServerFactory creates the in-process Suggestion service.
+type EmbeddedServerFactory func() suggestionapi.SuggestionServer
+
+// embeddedFactories contains the registered in-process Suggestion services, key is the algorithm name.
+var embeddedFactories = map[string]EmbeddedServerFactory{}
+
+// RegisterEmbeddedAlgorithm registers the in-process Suggestion service for the algorithms.
+// It must be called before the controller is started.
+func RegisterEmbeddedAlgorithm(factory EmbeddedServerFactory, algorithmNames ...string) {
+	for _, algorithmName := range algorithmNames {
+		embeddedFactories[algorithmName] = factory
+	}
+	katibconfig.AddEmbeddedAlgorithms(algorithmNames...)
+}
+
+// IsEmbedded returns true if the Suggestion service runs in-process instead of the Suggestion Deployment.
+// Katib config accepts embedded mode only for the registered algorithms.
+func IsEmbedded(suggestionConfig katibconfig.SuggestionConfig) bool {
+	return suggestionConfig.Embedded
+}
+
+// embeddedServers keeps the state of the in-process Suggestion services, key is the Suggestion namespaced name.
+type embeddedServers struct {
+	mu      sync.Mutex
+	servers map[types.NamespacedName]suggestionapi.SuggestionServer
+}
+
+func newEmbeddedServers() *embeddedServers {
+	return &embeddedServers{
+		servers: make(map[types.NamespacedName]suggestionapi.SuggestionServer),
+	}
+}
+
+// get returns the in-process Suggestion service for the Suggestion and creates it at the first run.
+func (e *embeddedServers) get(instance *suggestionsv1beta1.Suggestion) suggestionapi.SuggestionServer {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	name := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
+	server, ok := e.servers[name]
+	if !ok {
+		server = embeddedFactories[instance.Spec.Algorithm.AlgorithmName]()
+		e.servers[name] = server
+	}
+	return server
+}
+
+func (e *embeddedServers) delete(name types.NamespacedName) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.servers, name)
+}
+
+// embeddedClient calls the in-process Suggestion service as the gRPC client.
+type embeddedClient struct {
+	server suggestionapi.SuggestionServer
+}
+
+func (c *embeddedClient) GetSuggestions(ctx context.Context, in *suggestionapi.GetSuggestionsRequest,
+	opts ...grpc.CallOption) (*suggestionapi.GetSuggestionsReply, error) {
+	return c.server.GetSuggestions(ctx, in)
+}
+
+func (c *embeddedClient) ValidateAlgorithmSettings(ctx context.Context, in *suggestionapi.ValidateAlgorithmSettingsRequest,
+	opts ...grpc.CallOption) (*suggestionapi.ValidateAlgorithmSettingsReply, error) {
+	return c.server.ValidateAlgorithmSettings(ctx, in)
+}
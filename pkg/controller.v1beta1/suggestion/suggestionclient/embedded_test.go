@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestionclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+const (
+	embeddedAlgorithmName     = "embedded-algorithm"
+	unregisteredAlgorithmName = "unregistered-algorithm"
+	sharedAlgorithmName       = "shared-algorithm"
+)
+
+// fakeEmbeddedServer counts the requests to check that the state is kept per Suggestion.
+type fakeEmbeddedServer struct {
+	requests int
+}
+
+func (s *fakeEmbeddedServer) GetSuggestions(ctx context.Context, req *suggestionapi.GetSuggestionsRequest) (*suggestionapi.GetSuggestionsReply, error) {
+	s.requests++
+	reply := &suggestionapi.GetSuggestionsReply{}
+	for i := 0; i < int(req.RequestNumber); i++ {
+		reply.ParameterAssignments = append(reply.ParameterAssignments,
+			&suggestionapi.GetSuggestionsReply_ParameterAssignments{
+				Assignments: []*suggestionapi.ParameterAssignment{
+					{
+						Name:  "param1-name",
+						Value: "1",
+					},
+					{
+						Name:  "param2-name",
+						Value: "0.3",
+					},
+				},
+				TrialName: fmt.Sprintf("trial-%v-%v", s.requests, i),
+			})
+	}
+	return reply, nil
+}
+
+func (s *fakeEmbeddedServer) ValidateAlgorithmSettings(ctx context.Context, req *suggestionapi.ValidateAlgorithmSettingsRequest) (*suggestionapi.ValidateAlgorithmSettingsReply, error) {
+	return &suggestionapi.ValidateAlgorithmSettingsReply{}, nil
+}
+
+func TestGetEmbeddedSuggestionConfig(t *testing.T) {
+	RegisterEmbeddedAlgorithm(func() suggestionapi.SuggestionServer {
+		return &fakeEmbeddedServer{}
+	}, embeddedAlgorithmName)
+	c := newFakeKatibConfigClient()
+
+	tcs := []struct {
+		algorithmName string
+		expectedErr   bool
+		expected      bool
+		testDesc      string
+	}{
+		{
+			algorithmName: embeddedAlgorithmName,
+			expected:      true,
+			testDesc:      "Embedded algorithm",
+		},
+		{
+			algorithmName: algorithmName,
+			expected:      false,
+			testDesc:      "Algorithm is not embedded in Katib config",
+		},
+		{
+			algorithmName: unregisteredAlgorithmName,
+			expectedErr:   true,
+			testDesc:      "Algorithm without in-process Suggestion service is embedded in Katib config",
+		},
+	}
+	for _, tc := range tcs {
+		suggestionConfig, err := katibconfig.GetSuggestionConfigData(tc.algorithmName, c)
+		if !tc.expectedErr && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDesc, err)
+		} else if tc.expectedErr && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDesc)
+		} else if embedded := IsEmbedded(suggestionConfig); !tc.expectedErr && embedded != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDesc, tc.expected, embedded)
+		}
+	}
+}
+
+func TestSyncAssignmentsEmbedded(t *testing.T) {
+	servers := []*fakeEmbeddedServer{}
+	RegisterEmbeddedAlgorithm(func() suggestionapi.SuggestionServer {
+		server := &fakeEmbeddedServer{}
+		servers = append(servers, server)
+		return server
+	}, embeddedAlgorithmName)
+
+	suggestionClient := New(newFakeKatibConfigClient())
+	newSuggestion := func(name string) *suggestionsv1beta1.Suggestion {
+		sug := newFakeSuggestion()
+		sug.Name = name
+		sug.Spec.Algorithm.AlgorithmName = embeddedAlgorithmName
+		sug.Spec.EarlyStopping = nil
+		return sug
+	}
+
+	// Suggestion service state is kept for the Suggestion between the calls.
+	for i := 0; i < 2; i++ {
+		sug := newSuggestion("suggestion-1")
+		if err := suggestionClient.SyncAssignments(sug, newFakeExperiment(), newFakeTrials(), nil); err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+		if len(sug.Status.Suggestions) != 2 {
+			t.Errorf("Expected 2 suggestions, got %v", sug.Status.Suggestions)
+		}
+	}
+	if len(servers) != 1 || servers[0].requests != 2 {
+		t.Errorf("Expected 1 Suggestion service with 2 requests, got %v services", len(servers))
+	}
+
+	// Another Suggestion has a separate state.
+	if err := suggestionClient.SyncAssignments(newSuggestion("suggestion-2"), newFakeExperiment(), newFakeTrials(), nil); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+	if len(servers) != 2 {
+		t.Errorf("Expected 2 Suggestion services, got %v", len(servers))
+	}
+
+	// State is created again after it is deleted.
+	suggestionClient.DeleteEmbeddedState(types.NamespacedName{Name: "suggestion-1", Namespace: "namespace"})
+	if err := suggestionClient.SyncAssignments(newSuggestion("suggestion-1"), newFakeExperiment(), newFakeTrials(), nil); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+	if len(servers) != 3 || servers[2].requests != 1 {
+		t.Errorf("Expected new Suggestion service after the state is deleted, got %v services", len(servers))
+	}
+}
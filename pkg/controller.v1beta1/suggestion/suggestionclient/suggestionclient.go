@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -38,6 +39,8 @@ import (
 	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 var (
@@ -57,74 +60,203 @@ var (
 // SuggestionClient is the interface to communicate with algorithm services.
 type SuggestionClient interface {
 	SyncAssignments(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment,
-		ts []trialsv1beta1.Trial) error
+		ts []trialsv1beta1.Trial, warmStartTrials []trialsv1beta1.Trial) error
 
 	ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error
+
+	ValidateEarlyStoppingSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error
+
+	DeleteEmbeddedState(name types.NamespacedName)
 }
 
 // General is the implementation for SuggestionClient.
 type General struct {
+	client.Client
+	embedded *embeddedServers
 }
 
 // New creates a new SuggestionClient.
-func New() SuggestionClient {
-	return &General{}
+func New(c client.Client) SuggestionClient {
+	return &General{
+		Client:   c,
+		embedded: newEmbeddedServers(),
+	}
+}
+
+// DeleteEmbeddedState deletes state of the in-process Suggestion service for the Suggestion.
+func (g *General) DeleteEmbeddedState(name types.NamespacedName) {
+	g.embedded.delete(name)
+}
+
+// getSuggestionClient returns the client for the Suggestion service and the function to close the connection.
+// If the Suggestion service is embedded, the in-process service is called without the connection.
+func (g *General) getSuggestionClient(instance *suggestionsv1beta1.Suggestion, suggestionConfigData katibconfig.SuggestionConfig,
+	opts ...grpc.DialOption) (suggestionapi.SuggestionClient, func(), error) {
+	if IsEmbedded(suggestionConfigData) {
+		return &embeddedClient{server: g.embedded.get(instance)}, func() {}, nil
+	}
+
+	tlsOpt, err := g.dialOption(suggestionConfigData.GRPCTLS)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := util.GetAlgorithmEndpoint(instance, suggestionConfigData)
+	conn, err := grpc.Dial(endpoint, append([]grpc.DialOption{tlsOpt}, opts...)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return getRPCClientSuggestion(conn), func() { conn.Close() }, nil
+}
+
+// dialOption returns gRPC dial option for the suggestion or early stopping service.
+// Service is called with TLS only if it opts in with grpcTLS and gRPC TLS is configured in Katib config,
+// the same as the service is deployed by the composer.
+func (g *General) dialOption(serviceGRPCTLS bool) (grpc.DialOption, error) {
+	tlsConfigData, err := katibconfig.GetGRPCTLSConfigData(g.Client)
+	if err != nil {
+		return nil, err
+	}
+	if !tlsConfigData.EnabledFor(serviceGRPCTLS) {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := grpctls.NewConfigFromEnv()
+	if !tlsConfig.ClientEnabled() {
+		return nil, fmt.Errorf("gRPC TLS is configured in Katib config, but %v is not set for the controller", consts.GRPCTLSCAFileEnvName)
+	}
+	return tlsConfig.DialOption()
 }
 
 // SyncAssignments syncs assignments from Suggestion and EarlyStopping service.
 // If early stopping is set, we call GetEarlyStoppingRules after GetSuggestions
+// Trials of the warm start Experiments are sent to the Suggestion service with the Experiment Trials.
 func (g *General) SyncAssignments(
 	instance *suggestionsv1beta1.Suggestion,
 	e *experimentsv1beta1.Experiment,
-	ts []trialsv1beta1.Trial) error {
+	ts []trialsv1beta1.Trial,
+	warmStartTrials []trialsv1beta1.Trial) error {
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	requestNum := int(instance.Spec.Requests) - int(instance.Status.SuggestionCount)
 	if requestNum <= 0 {
 		return nil
 	}
 
-	endpoint := util.GetAlgorithmEndpoint(instance)
-	connSuggestion, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	suggestionConfigData, err := katibconfig.GetSuggestionConfigData(instance.Spec.Algorithm.AlgorithmName, g.Client)
 	if err != nil {
 		return err
 	}
-	defer connSuggestion.Close()
 
 	// Create client for Suggestion service
-	rpcClientSuggestion := getRPCClientSuggestion(connSuggestion)
-	ctx, cancelSuggestion := context.WithTimeout(context.Background(), timeout)
-	defer cancelSuggestion()
-
-	// Overwrite Experiment algorithm settings from the Suggestion status before the gRPC request.
-	// Original algorithm settings are located in Suggestion.Spec.Algorithm.AlgorithmSettings.
-	filledE := e.DeepCopy()
-	appendAlgorithmSettingsFromSuggestion(filledE,
-		instance.Status.AlgorithmSettings)
-
-	requestSuggestion := &suggestionapi.GetSuggestionsRequest{
-		Experiment:    g.ConvertExperiment(filledE),
-		Trials:        g.ConvertTrials(ts),
-		RequestNumber: int32(requestNum),
+	endpoint := util.GetAlgorithmEndpoint(instance, suggestionConfigData)
+	rpcClientSuggestion, closeSuggestion, err := g.getSuggestionClient(instance, suggestionConfigData)
+	if err != nil {
+		return err
 	}
+	defer closeSuggestion()
 
-	// Get new suggestions
-	responseSuggestion, err := rpcClientSuggestion.GetSuggestions(ctx, requestSuggestion)
+	constraints, err := parseConstraints(e.Spec.Constraints)
 	if err != nil {
 		return err
 	}
-	logger.Info("Getting suggestions", "endpoint", endpoint, "response", responseSuggestion,
-		"request", requestSuggestion)
-	if len(responseSuggestion.ParameterAssignments) != requestNum {
-		err := fmt.Errorf("The response contains unexpected trials")
-		logger.Error(err, "The response contains unexpected trials", "requestNum", requestNum, "response", responseSuggestion)
-		return err
+
+	// Succeeded Trials of the warm start Experiments are sent to the Suggestion service as completed Trials.
+	warmStartTrials = filterWarmStartTrials(e, warmStartTrials)
+	if len(warmStartTrials) > 0 {
+		logger.Info("Warm starting with Trials of previous Experiments", "Trial Count", len(warmStartTrials))
+	}
+	suggestionTrials := g.ConvertTrials(append(warmStartTrials, ts...))
+
+	// Assignments which violate the Experiment constraints are reported back to the Suggestion service
+	// as infeasible and new assignments are requested instead of them.
+	assignments := []*suggestionapi.GetSuggestionsReply_ParameterAssignments{}
+	exhausted := false
+	var filledE *experimentsv1beta1.Experiment
+	for attempt := 0; attempt < maxSuggestionAttempts && len(assignments) < requestNum && !exhausted; attempt++ {
+		// Overwrite Experiment algorithm settings from the Suggestion status before the gRPC request.
+		// Original algorithm settings are located in Suggestion.Spec.Algorithm.AlgorithmSettings.
+		filledE = e.DeepCopy()
+		appendAlgorithmSettingsFromSuggestion(filledE,
+			instance.Status.AlgorithmSettings)
+
+		attemptNum := requestNum - len(assignments)
+		requestSuggestion := &suggestionapi.GetSuggestionsRequest{
+			Experiment:       g.ConvertExperiment(filledE),
+			Trials:           suggestionTrials,
+			RequestNumber:    int32(attemptNum),
+			InfeasibleTrials: convertInfeasibleSuggestions(instance.Status.InfeasibleSuggestions),
+			ExperimentId:     getExperimentID(e),
+		}
+
+		// Get new suggestions
+		responseSuggestion, err := getSuggestions(rpcClientSuggestion, requestSuggestion)
+		if err != nil {
+			return err
+		}
+		logger.Info("Getting suggestions", "endpoint", endpoint, "response", responseSuggestion,
+			"request", requestSuggestion)
+		// Suggestion service returns less assignments than requested only if search space is exhausted.
+		if len(responseSuggestion.ParameterAssignments) != attemptNum &&
+			!(responseSuggestion.SearchSpaceExhausted && len(responseSuggestion.ParameterAssignments) < attemptNum) {
+			err := fmt.Errorf("The response contains unexpected trials")
+			logger.Error(err, "The response contains unexpected trials", "requestNum", attemptNum, "response", responseSuggestion)
+			return err
+		}
+		if responseSuggestion.Algorithm != nil {
+			updateAlgorithmSettings(instance, responseSuggestion.Algorithm)
+		}
+		exhausted = responseSuggestion.SearchSpaceExhausted
+
+		for _, t := range responseSuggestion.ParameterAssignments {
+			reason := checkConstraints(constraints, composeParameterAssignments(t.Assignments))
+			if reason == "" {
+				assignments = append(assignments, t)
+				continue
+			}
+			logger.Info("Rejecting infeasible assignments", "reason", reason, "assignments", t.Assignments)
+			// Suggestion service can set the Trial name to track the Trial.
+			trialName := t.TrialName
+			if trialName == "" {
+				trialName = fmt.Sprintf("%s-%s", instance.Name, utilrand.String(8))
+			}
+			instance.Status.InfeasibleSuggestions = append(instance.Status.InfeasibleSuggestions,
+				suggestionsv1beta1.TrialAssignment{
+					Name:                 trialName,
+					ParameterAssignments: composeParameterAssignments(t.Assignments),
+				})
+		}
+		if n := len(instance.Status.InfeasibleSuggestions); n > maxInfeasibleSuggestions {
+			instance.Status.InfeasibleSuggestions = instance.Status.InfeasibleSuggestions[n-maxInfeasibleSuggestions:]
+		}
+	}
+	if len(assignments) == 0 && !exhausted {
+		return fmt.Errorf("Unable to get assignments which satisfy the Experiment constraints in %d attempts",
+			maxSuggestionAttempts)
+	}
+
+	// Trial names are generated before the early stopping request, so the service can return rules for each Trial.
+	trialNames := make([]string, 0, len(assignments))
+	for _, t := range assignments {
+		// Suggestion service can set the Trial name to track the Trial.
+		trialName := t.TrialName
+		if trialName == "" {
+			trialName = fmt.Sprintf("%s-%s", instance.Name, utilrand.String(8))
+		}
+		trialNames = append(trialNames, trialName)
 	}
 
 	earlyStoppingRules := []commonapiv1beta1.EarlyStoppingRule{}
+	trialEarlyStoppingRules := map[string][]commonapiv1beta1.EarlyStoppingRule{}
 	// If early stopping is set, call GetEarlyStoppingRules after GetSuggestions.
 	if instance.Spec.EarlyStopping != nil && instance.Spec.EarlyStopping.AlgorithmName != "" {
+		earlyStoppingConfigData, err := katibconfig.GetEarlyStoppingConfigData(instance.Spec.EarlyStopping.AlgorithmName, g.Client)
+		if err != nil {
+			return err
+		}
+		tlsOpt, err := g.dialOption(earlyStoppingConfigData.GRPCTLS)
+		if err != nil {
+			return err
+		}
 		endpoint = util.GetEarlyStoppingEndpoint(instance)
-		connEarlyStopping, err := grpc.Dial(endpoint, grpc.WithInsecure())
+		connEarlyStopping, err := grpc.Dial(endpoint, tlsOpt)
 		if err != nil {
 			return err
 		}
@@ -139,6 +271,7 @@ func (g *General) SyncAssignments(
 			Experiment:       g.ConvertExperiment(filledE),
 			Trials:           g.ConvertTrials(ts),
 			DbManagerAddress: katibmanagerv1beta1.GetDBManagerAddr(),
+			TrialNames:       trialNames,
 		}
 
 		// Get new early stopping rules
@@ -149,53 +282,67 @@ func (g *General) SyncAssignments(
 
 		logger.Info("Getting early stopping rules", "endpoint", endpoint, "response", responseEarlyStopping)
 
-		for _, rule := range responseEarlyStopping.EarlyStoppingRules {
-			earlyStoppingRules = append(earlyStoppingRules,
-				commonapiv1beta1.EarlyStoppingRule{
-					Name:       rule.Name,
-					Value:      rule.Value,
-					Comparison: convertComparison(rule.Comparison),
-					StartStep:  int(rule.StartStep),
-				},
-			)
+		earlyStoppingRules = convertEarlyStoppingRules(responseEarlyStopping.EarlyStoppingRules)
+		for _, trialRules := range responseEarlyStopping.TrialEarlyStoppingRules {
+			trialEarlyStoppingRules[trialRules.TrialName] = convertEarlyStoppingRules(trialRules.EarlyStoppingRules)
 		}
 	}
 
-	for _, t := range responseSuggestion.ParameterAssignments {
+	for i, t := range assignments {
+		rules, ok := trialEarlyStoppingRules[trialNames[i]]
+		if !ok {
+			rules = earlyStoppingRules
+		}
 		instance.Status.Suggestions = append(instance.Status.Suggestions,
 			suggestionsv1beta1.TrialAssignment{
-				Name:                 fmt.Sprintf("%s-%s", instance.Name, utilrand.String(8)),
+				Name:                 trialNames[i],
 				ParameterAssignments: composeParameterAssignments(t.Assignments),
-				EarlyStoppingRules:   earlyStoppingRules,
+				EarlyStoppingRules:   rules,
 			})
 	}
 	instance.Status.SuggestionCount = int32(len(instance.Status.Suggestions))
 
-	if responseSuggestion.Algorithm != nil {
-		updateAlgorithmSettings(instance, responseSuggestion.Algorithm)
+	if exhausted {
+		msg := "Suggestion service has suggested all assignments of the search space"
+		instance.MarkSuggestionStatusExhausted(suggestionsv1beta1.SuggestionExhaustedReason, msg)
+		logger.Info(msg, "Suggestion Count", instance.Status.SuggestionCount)
 	}
 	return nil
 }
 
+// getExperimentID returns the unique identity of the Experiment for the shared Suggestion service.
+// UID is used, so the state of the deleted Experiment is not reused by the new Experiment with the same name.
+func getExperimentID(e *experimentsv1beta1.Experiment) string {
+	return fmt.Sprintf("%s/%s/%s", e.Namespace, e.Name, e.UID)
+}
+
+// getSuggestions calls GetSuggestions with the timeout.
+func getSuggestions(client suggestionapi.SuggestionClient, request *suggestionapi.GetSuggestionsRequest) (*suggestionapi.GetSuggestionsReply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return client.GetSuggestions(ctx, request)
+}
+
 // ValidateAlgorithmSettings validates if the algorithm specific configurations are valid.
 func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error {
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	endpoint := util.GetAlgorithmEndpoint(instance)
 
 	callOpts := []grpc_retry.CallOption{
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(consts.DefaultGRPCRetryPeriod)),
 		grpc_retry.WithMax(consts.DefaultGRPCRetryAttempts),
 	}
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure(),
+	suggestionConfigData, err := katibconfig.GetSuggestionConfigData(instance.Spec.Algorithm.AlgorithmName, g.Client)
+	if err != nil {
+		return err
+	}
+	rpcClient, closeConn, err := g.getSuggestionClient(instance, suggestionConfigData,
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(callOpts...)),
 		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callOpts...)),
 	)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
-
-	rpcClient := getRPCClientSuggestion(conn)
+	defer closeConn()
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -229,6 +376,64 @@ func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggest
 	return nil
 }
 
+// ValidateEarlyStoppingSettings validates if the early stopping specific configurations are valid.
+func (g *General) ValidateEarlyStoppingSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error {
+	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	endpoint := util.GetEarlyStoppingEndpoint(instance)
+
+	earlyStoppingConfigData, err := katibconfig.GetEarlyStoppingConfigData(instance.Spec.EarlyStopping.AlgorithmName, g.Client)
+	if err != nil {
+		return err
+	}
+	tlsOpt, err := g.dialOption(earlyStoppingConfigData.GRPCTLS)
+	if err != nil {
+		return err
+	}
+	callOpts := []grpc_retry.CallOption{
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(consts.DefaultGRPCRetryPeriod)),
+		grpc_retry.WithMax(consts.DefaultGRPCRetryAttempts),
+	}
+	conn, err := grpc.Dial(endpoint, tlsOpt,
+		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(callOpts...)),
+		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callOpts...)),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	rpcClient := getRPCClientEarlyStopping(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	request := &suggestionapi.ValidateEarlyStoppingSettingsRequest{
+		Experiment: g.ConvertExperiment(e),
+	}
+
+	_, err = rpcClient.ValidateEarlyStoppingSettings(ctx, request, grpc.WaitForReady(true))
+	statusCode, _ := status.FromError(err)
+
+	// validation error
+	if statusCode.Code() == codes.InvalidArgument || statusCode.Code() == codes.Unknown {
+		logger.Error(err, "ValidateEarlyStoppingSettings error")
+		return fmt.Errorf("ValidateEarlyStoppingSettings Error: %v", statusCode.Message())
+	}
+
+	// Connection error
+	if statusCode.Code() == codes.Unavailable {
+		logger.Error(err, "Connection to EarlyStopping service currently unavailable")
+		return err
+	}
+
+	// Validate to true as function is not implemented
+	if statusCode.Code() == codes.Unimplemented {
+		logger.Info("Method ValidateEarlyStoppingSettings not found", "EarlyStopping service", e.Spec.EarlyStopping.AlgorithmName)
+		return nil
+	}
+	logger.Info("Early stopping settings validated")
+	return nil
+}
+
 // ConvertExperiment converts CRD to the GRPC definition.
 func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestionapi.Experiment {
 	res := &suggestionapi.Experiment{}
@@ -242,6 +447,7 @@ func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestio
 			Type:                  convertObjectiveType(e.Spec.Objective.Type),
 			ObjectiveMetricName:   e.Spec.Objective.ObjectiveMetricName,
 			AdditionalMetricNames: e.Spec.Objective.AdditionalMetricNames,
+			Objectives:            convertObjectives(e.Spec.Objective.Objectives),
 		},
 		ParameterSpecs: &suggestionapi.ExperimentSpec_ParameterSpecs{
 			Parameters: convertParameters(e.Spec.Parameters),
@@ -286,6 +492,7 @@ func (g *General) ConvertTrials(ts []trialsv1beta1.Trial) []*suggestionapi.Trial
 					Type:                  convertObjectiveType(t.Spec.Objective.Type),
 					ObjectiveMetricName:   t.Spec.Objective.ObjectiveMetricName,
 					AdditionalMetricNames: t.Spec.Objective.AdditionalMetricNames,
+					Objectives:            convertObjectives(t.Spec.Objective.Objectives),
 				},
 				ParameterAssignments: convertTrialParameterAssignments(
 					t.Spec.ParameterAssignments),
@@ -334,7 +541,7 @@ func convertTrialConditionType(conditionType trialsv1beta1.TrialConditionType) s
 		return suggestionapi.TrialStatus_RUNNING
 	case trialsv1beta1.TrialSucceeded:
 		return suggestionapi.TrialStatus_SUCCEEDED
-	case trialsv1beta1.TrialKilled:
+	case trialsv1beta1.TrialKilled, trialsv1beta1.TrialTimedOut:
 		return suggestionapi.TrialStatus_KILLED
 	case trialsv1beta1.TrialFailed:
 		return suggestionapi.TrialStatus_FAILED
@@ -412,6 +619,21 @@ func convertObjectiveType(typ commonapiv1beta1.ObjectiveType) suggestionapi.Obje
 	}
 }
 
+func convertObjectives(objectives []commonapiv1beta1.Objective) []*suggestionapi.Objective {
+	res := make([]*suggestionapi.Objective, 0)
+	for _, o := range objectives {
+		objective := &suggestionapi.Objective{
+			Type:                convertObjectiveType(o.Type),
+			ObjectiveMetricName: o.ObjectiveMetricName,
+		}
+		if o.Goal != nil {
+			objective.Goal = *o.Goal
+		}
+		res = append(res, objective)
+	}
+	return res
+}
+
 func convertAlgorithmSettings(as []commonapiv1beta1.AlgorithmSetting) []*suggestionapi.AlgorithmSetting {
 	res := make([]*suggestionapi.AlgorithmSetting, 0)
 	for _, s := range as {
@@ -441,11 +663,22 @@ func convertParameters(ps []experimentsv1beta1.ParameterSpec) []*suggestionapi.P
 			Name:          p.Name,
 			ParameterType: convertParameterType(p.ParameterType),
 			FeasibleSpace: convertFeasibleSpace(p.FeasibleSpace),
+			Condition:     convertParameterCondition(p.Condition),
 		})
 	}
 	return res
 }
 
+func convertParameterCondition(condition *experimentsv1beta1.ParameterCondition) *suggestionapi.ParameterCondition {
+	if condition == nil {
+		return nil
+	}
+	return &suggestionapi.ParameterCondition{
+		Parameter: condition.Parameter,
+		Values:    condition.Values,
+	}
+}
+
 func convertParameterType(typ experimentsv1beta1.ParameterType) suggestionapi.ParameterType {
 	switch typ {
 	case experimentsv1beta1.ParameterTypeDiscrete:
@@ -467,6 +700,34 @@ func convertFeasibleSpace(fs experimentsv1beta1.FeasibleSpace) *suggestionapi.Fe
 		Min:  fs.Min,
 		List: fs.List,
 		Step: fs.Step,
+
+		Distribution: convertDistribution(fs.Distribution),
+		Mean:         fs.Mean,
+		Std:          fs.Std,
+	}
+	return res
+}
+
+func convertDistribution(distribution experimentsv1beta1.Distribution) suggestionapi.Distribution {
+	switch distribution {
+	case experimentsv1beta1.DistributionLogUniform:
+		return suggestionapi.Distribution_LOG_UNIFORM
+	case experimentsv1beta1.DistributionNormal:
+		return suggestionapi.Distribution_NORMAL
+	default:
+		return suggestionapi.Distribution_UNIFORM
+	}
+}
+
+func convertEarlyStoppingRules(rules []*suggestionapi.EarlyStoppingRule) []commonapiv1beta1.EarlyStoppingRule {
+	res := []commonapiv1beta1.EarlyStoppingRule{}
+	for _, rule := range rules {
+		res = append(res, commonapiv1beta1.EarlyStoppingRule{
+			Name:       rule.Name,
+			Value:      rule.Value,
+			Comparison: convertComparison(rule.Comparison),
+			StartStep:  int(rule.StartStep),
+		})
 	}
 	return res
 }
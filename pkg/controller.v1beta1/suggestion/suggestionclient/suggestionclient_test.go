@@ -17,8 +17,10 @@ limitations under the License.
 package suggestionclient
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -31,6 +33,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -88,7 +92,7 @@ func TestSyncAssignments(t *testing.T) {
 		return rpcClientEarlyStopping
 	}
 
-	suggestionClient := New()
+	suggestionClient := New(newFakeKatibConfigClient())
 
 	expectedRequestSuggestion := newFakeRequest()
 	expectedRequestEarlyStopping := &suggestionapi.GetEarlyStoppingRulesRequest{
@@ -122,6 +126,7 @@ func TestSyncAssignments(t *testing.T) {
 						Value: "0.4",
 					},
 				},
+				TrialName: "trial-from-suggestion",
 			},
 		},
 		Algorithm: &suggestionapi.AlgorithmSpec{
@@ -152,10 +157,34 @@ func TestSyncAssignments(t *testing.T) {
 				Comparison: suggestionapi.ComparisonType_EQUAL,
 			},
 		},
+		TrialEarlyStoppingRules: []*suggestionapi.GetEarlyStoppingRulesReply_TrialEarlyStoppingRules{
+			{
+				TrialName: "trial-from-suggestion",
+				EarlyStoppingRules: []*suggestionapi.EarlyStoppingRule{
+					{
+						Name:       "accuracy",
+						Value:      "0.8",
+						Comparison: suggestionapi.ComparisonType_LESS,
+						StartStep:  2,
+					},
+				},
+			},
+		},
 	}
 
 	validRunGetSuggestions := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), k8sMatcher{expectedRequestSuggestion}).Return(getSuggestionReply, nil)
-	validRunGetEarlyStopRules := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), k8sMatcher{expectedRequestEarlyStopping}).Return(getEarlyStoppingRulesReply, nil)
+	validRunGetEarlyStopRules := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *suggestionapi.GetEarlyStoppingRulesRequest) (*suggestionapi.GetEarlyStoppingRulesReply, error) {
+			// Generated Trial name is random, so only the Trial name from the suggestion service is checked.
+			if len(req.TrialNames) != 2 || req.TrialNames[1] != "trial-from-suggestion" {
+				return nil, fmt.Errorf("Unexpected Trial names in the request: %v", req.TrialNames)
+			}
+			expectedRequestEarlyStopping.TrialNames = req.TrialNames
+			if !equality.Semantic.DeepEqual(expectedRequestEarlyStopping, req) {
+				return nil, fmt.Errorf("Unexpected request: %v", req)
+			}
+			return getEarlyStoppingRulesReply, nil
+		})
 	getSuggestionsFail := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(nil, errors.New("Suggestion service connection error"))
 
 	invalidAssignmentsCount := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(
@@ -175,6 +204,13 @@ func TestSyncAssignments(t *testing.T) {
 	validRunGetSuggestions2 := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), k8sMatcher{expectedRequestSuggestion}).Return(getSuggestionReply, nil)
 	getEarlyStopRulesFail := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).Return(nil, errors.New("Suggestion service connection error"))
 
+	searchSpaceExhausted := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(
+		&suggestionapi.GetSuggestionsReply{
+			ParameterAssignments: getSuggestionReply.ParameterAssignments[:1],
+			SearchSpaceExhausted: true,
+		}, nil)
+	validRunGetEarlyStopRules2 := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).Return(getEarlyStoppingRulesReply, nil)
+
 	gomock.InOrder(
 		validRunGetSuggestions,
 		validRunGetEarlyStopRules,
@@ -182,6 +218,8 @@ func TestSyncAssignments(t *testing.T) {
 		invalidAssignmentsCount,
 		validRunGetSuggestions2,
 		getEarlyStopRulesFail,
+		searchSpaceExhausted,
+		validRunGetEarlyStopRules2,
 	)
 
 	tcs := []struct {
@@ -234,15 +272,195 @@ func TestSyncAssignments(t *testing.T) {
 			Err:             true,
 			TestDescription: "Unable to execute GetEarlyStoppingRules",
 		},
+		// searchSpaceExhausted + validRunGetEarlyStopRules2 case
+		{
+			Experiment:      newFakeExperiment(),
+			Suggestion:      newFakeSuggestion(),
+			Trials:          newFakeTrials(),
+			Err:             false,
+			TestDescription: "Search space is exhausted, ParameterAssignments from response < request number",
+		},
 	}
 	for _, tc := range tcs {
-		err := suggestionClient.SyncAssignments(tc.Suggestion, tc.Experiment, tc.Trials)
+		err := suggestionClient.SyncAssignments(tc.Suggestion, tc.Experiment, tc.Trials, nil)
 		if !tc.Err && err != nil {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.TestDescription, err)
 		} else if tc.Err && err == nil {
 			t.Errorf("Case: %v failed. Expected err, got nil", tc.TestDescription)
 		}
 	}
+
+	// Trial name from the suggestion service must be used in the assignment.
+	assignments := tcs[0].Suggestion.Status.Suggestions
+	if name := assignments[len(assignments)-1].Name; name != "trial-from-suggestion" {
+		t.Errorf("Expected Trial name from the suggestion service trial-from-suggestion, got %v", name)
+	}
+
+	// Trial gets own early stopping rules if the early stopping service returns them, otherwise the common rules.
+	if rules := assignments[0].EarlyStoppingRules; len(rules) != 2 {
+		t.Errorf("Expected 2 common early stopping rules, got %v", rules)
+	}
+	if rules := assignments[len(assignments)-1].EarlyStoppingRules; len(rules) != 1 || rules[0].Value != "0.8" {
+		t.Errorf("Expected own early stopping rule of trial-from-suggestion, got %v", rules)
+	}
+
+	// Suggestion must be exhausted if search space is exhausted.
+	if exhausted := tcs[len(tcs)-1].Suggestion; !exhausted.IsExhausted() {
+		t.Errorf("Expected exhausted Suggestion, got conditions %v", exhausted.Status.Conditions)
+	}
+}
+
+func TestSyncAssignmentsWithConstraints(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	rpcClientEarlyStopping := suggestionapimock.NewMockEarlyStoppingClient(mockCtrl)
+
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+	getRPCClientEarlyStopping = func(conn *grpc.ClientConn) suggestionapi.EarlyStoppingClient {
+		return rpcClientEarlyStopping
+	}
+
+	suggestionClient := New(newFakeKatibConfigClient())
+
+	newReply := func(param1Values ...string) *suggestionapi.GetSuggestionsReply {
+		reply := &suggestionapi.GetSuggestionsReply{}
+		for i, v := range param1Values {
+			reply.ParameterAssignments = append(reply.ParameterAssignments,
+				&suggestionapi.GetSuggestionsReply_ParameterAssignments{
+					Assignments: []*suggestionapi.ParameterAssignment{
+						{
+							Name:  "param1-name",
+							Value: v,
+						},
+						{
+							Name:  "param2-name",
+							Value: "0.3",
+						},
+					},
+					TrialName: fmt.Sprintf("trial-%v-%v", i, v),
+				})
+		}
+		return reply
+	}
+
+	requests := []*suggestionapi.GetSuggestionsRequest{}
+	recordRequest := func(reply *suggestionapi.GetSuggestionsReply) interface{} {
+		return func(_ interface{}, req *suggestionapi.GetSuggestionsRequest, _ ...grpc.CallOption) (*suggestionapi.GetSuggestionsReply, error) {
+			requests = append(requests, req)
+			return reply, nil
+		}
+	}
+
+	gomock.InOrder(
+		// The second assignment is infeasible and it is requested again.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).DoAndReturn(recordRequest(newReply("1", "4"))),
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).DoAndReturn(recordRequest(newReply("2"))),
+		rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).Return(&suggestionapi.GetEarlyStoppingRulesReply{}, nil),
+		// All assignments are infeasible.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("5", "5"), nil).Times(maxSuggestionAttempts),
+	)
+
+	exp := newFakeExperiment()
+	exp.Spec.Constraints = []experimentsv1beta1.ParameterConstraint{
+		{
+			Name:       "param1-limit",
+			Expression: "${param1-name} * 10 < 35",
+		},
+		{
+			// Constraint with unassigned parameter is not checked.
+			Expression: "param3 > 1",
+		},
+	}
+
+	sug := newFakeSuggestion()
+	if err := suggestionClient.SyncAssignments(sug, exp, newFakeTrials(), nil); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 GetSuggestions requests, got %v", len(requests))
+	}
+	if num := requests[1].RequestNumber; num != 1 {
+		t.Errorf("Expected request number 1 after rejected assignment, got %v", num)
+	}
+	if infeasible := requests[1].InfeasibleTrials; len(infeasible) != 1 || infeasible[0].Name != "trial-1-4" {
+		t.Errorf("Expected infeasible Trial trial-1-4 in the request, got %v", infeasible)
+	}
+	if len(sug.Status.Suggestions) != 2 {
+		t.Errorf("Expected 2 suggestions, got %v", sug.Status.Suggestions)
+	}
+	for _, a := range sug.Status.Suggestions {
+		if a.ParameterAssignments[0].Value == "4" {
+			t.Errorf("Infeasible assignment %v is suggested", a.Name)
+		}
+	}
+	if len(sug.Status.InfeasibleSuggestions) != 1 {
+		t.Errorf("Expected 1 infeasible suggestion, got %v", sug.Status.InfeasibleSuggestions)
+	}
+
+	sug = newFakeSuggestion()
+	if err := suggestionClient.SyncAssignments(sug, exp, newFakeTrials(), nil); err == nil {
+		t.Errorf("Expected error if all assignments are infeasible, got nil")
+	}
+}
+
+func TestFilterWarmStartTrials(t *testing.T) {
+	newWarmStartTrial := func(name string, param1, param2 string) trialsv1beta1.Trial {
+		trial := newFakeTrials()[0]
+		trial.Name = name
+		trial.Namespace = "previous-namespace"
+		trial.Spec.ParameterAssignments[0].Value = param1
+		trial.Spec.ParameterAssignments[1].Value = param2
+		trial.Status.Conditions = []trialsv1beta1.TrialCondition{
+			{
+				Type:   trialsv1beta1.TrialSucceeded,
+				Status: corev1.ConditionTrue,
+			},
+		}
+		return trial
+	}
+
+	trials := []trialsv1beta1.Trial{
+		newWarmStartTrial("feasible-trial", "5", "0.1"),
+		newWarmStartTrial("int-out-of-range", "6", "0.1"),
+		newWarmStartTrial("double-out-of-range", "5", "0.5"),
+		newWarmStartTrial("not-int", "2.5", "0.1"),
+		func() trialsv1beta1.Trial {
+			trial := newWarmStartTrial("missing-parameter", "5", "0.1")
+			trial.Spec.ParameterAssignments = trial.Spec.ParameterAssignments[:1]
+			return trial
+		}(),
+		func() trialsv1beta1.Trial {
+			trial := newWarmStartTrial("extra-parameter", "5", "0.1")
+			trial.Spec.ParameterAssignments = append(trial.Spec.ParameterAssignments,
+				commonapiv1beta1.ParameterAssignment{Name: "param3-name", Value: "1"})
+			return trial
+		}(),
+		func() trialsv1beta1.Trial {
+			trial := newWarmStartTrial("failed-trial", "5", "0.1")
+			trial.Status.Conditions[0].Type = trialsv1beta1.TrialFailed
+			return trial
+		}(),
+		func() trialsv1beta1.Trial {
+			trial := newWarmStartTrial("different-objective", "5", "0.1")
+			trial.Spec.Objective = newFakeObjective()
+			trial.Spec.Objective.ObjectiveMetricName = "loss"
+			return trial
+		}(),
+	}
+
+	warmStartTrials := filterWarmStartTrials(newFakeExperiment(), trials)
+	if len(warmStartTrials) != 1 || warmStartTrials[0].Name != "feasible-trial" {
+		names := []string{}
+		for _, t := range warmStartTrials {
+			names = append(names, t.Name)
+		}
+		t.Errorf("Expected warm start Trials [feasible-trial], got %v", names)
+	}
 }
 
 func TestValidateAlgorithmSettings(t *testing.T) {
@@ -274,7 +492,7 @@ func TestValidateAlgorithmSettings(t *testing.T) {
 	unimplementedMethod := rpcClientSuggestion.EXPECT().ValidateAlgorithmSettings(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
 		status.Error(codes.Unimplemented, "Method not implemented"))
 
-	suggestionClient := New()
+	suggestionClient := New(newFakeKatibConfigClient())
 
 	exp := newFakeExperiment()
 	sug := newFakeSuggestion()
@@ -330,6 +548,155 @@ func TestValidateAlgorithmSettings(t *testing.T) {
 	}
 }
 
+func TestValidateEarlyStoppingSettings(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	rpcClientEarlyStopping := suggestionapimock.NewMockEarlyStoppingClient(mockCtrl)
+
+	getRPCClientEarlyStopping = func(conn *grpc.ClientConn) suggestionapi.EarlyStoppingClient {
+		return rpcClientEarlyStopping
+	}
+
+	expectedRequest := &suggestionapi.ValidateEarlyStoppingSettingsRequest{
+		Experiment: newFakeRequest().Experiment,
+	}
+	expectedRequest.Experiment.Spec.Algorithm.AlgorithmSettings = []*suggestionapi.AlgorithmSetting{
+		{
+			Name:  "overridden-name",
+			Value: "value",
+		},
+	}
+
+	validRun := rpcClientEarlyStopping.EXPECT().ValidateEarlyStoppingSettings(gomock.Any(), k8sMatcher{expectedRequest}, gomock.Any()).Return(nil, nil)
+
+	invalidExperiment := rpcClientEarlyStopping.EXPECT().ValidateEarlyStoppingSettings(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+		status.Error(codes.InvalidArgument, "Invalid early stopping setting"))
+	connectionError := rpcClientEarlyStopping.EXPECT().ValidateEarlyStoppingSettings(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+		status.Error(codes.Unavailable, "Unable to connect"))
+	unimplementedMethod := rpcClientEarlyStopping.EXPECT().ValidateEarlyStoppingSettings(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil,
+		status.Error(codes.Unimplemented, "Method not implemented"))
+
+	suggestionClient := New(newFakeKatibConfigClient())
+
+	exp := newFakeExperiment()
+	sug := newFakeSuggestion()
+
+	gomock.InOrder(
+		validRun,
+		invalidExperiment,
+		connectionError,
+		unimplementedMethod)
+
+	tcs := []struct {
+		Experiment      *experimentsv1beta1.Experiment
+		Suggestion      *suggestionsv1beta1.Suggestion
+		Err             bool
+		TestDescription string
+	}{
+		// validRun case
+		{
+			Experiment:      exp,
+			Suggestion:      sug,
+			Err:             false,
+			TestDescription: "ValidateEarlyStoppingSettings valid run",
+		},
+		// invalidExperiment case
+		{
+			Experiment:      exp,
+			Suggestion:      sug,
+			Err:             true,
+			TestDescription: "Invalid argument return in early stopping validation",
+		},
+		// connectionError case
+		{
+			Experiment:      exp,
+			Suggestion:      sug,
+			Err:             true,
+			TestDescription: "Connection to early stopping service error",
+		},
+		// unimplementedMethod case
+		{
+			Experiment:      exp,
+			Suggestion:      sug,
+			Err:             false,
+			TestDescription: "Unimplemented ValidateEarlyStoppingSettings method",
+		},
+	}
+	for _, tc := range tcs {
+		err := suggestionClient.ValidateEarlyStoppingSettings(tc.Suggestion, tc.Experiment)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.TestDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.TestDescription)
+		}
+	}
+}
+
+func TestDialOption(t *testing.T) {
+	newConfigClient := func(tlsConfigured bool) client.Client {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      consts.KatibConfigMapName,
+				Namespace: consts.DefaultKatibNamespace,
+			},
+			Data: map[string]string{},
+		}
+		if tlsConfigured {
+			configMap.Data[consts.LabelGRPCTLSTag] = `{"secretName": "katib-grpc-tls"}`
+		}
+		return fake.NewClientBuilder().WithRuntimeObjects(configMap).Build()
+	}
+
+	tcs := []struct {
+		tlsConfigured  bool
+		serviceGRPCTLS bool
+		caFile         string
+		err            bool
+		testDesc       string
+	}{
+		{
+			tlsConfigured:  false,
+			serviceGRPCTLS: true,
+			caFile:         "missing-ca.crt",
+			err:            false,
+			testDesc:       "Insecure if gRPC TLS is not configured in Katib config",
+		},
+		{
+			tlsConfigured:  true,
+			serviceGRPCTLS: false,
+			caFile:         "missing-ca.crt",
+			err:            false,
+			testDesc:       "Insecure if service does not opt in",
+		},
+		{
+			tlsConfigured:  true,
+			serviceGRPCTLS: true,
+			caFile:         "missing-ca.crt",
+			err:            true,
+			testDesc:       "TLS if service opts in, CA certificate of the controller is loaded",
+		},
+		{
+			tlsConfigured:  true,
+			serviceGRPCTLS: true,
+			caFile:         "",
+			err:            true,
+			testDesc:       "TLS if service opts in, CA certificate is not set for the controller",
+		},
+	}
+	defer os.Unsetenv(consts.GRPCTLSCAFileEnvName)
+	for _, tc := range tcs {
+		os.Setenv(consts.GRPCTLSCAFileEnvName, tc.caFile)
+		suggestionClient := &General{Client: newConfigClient(tc.tlsConfigured)}
+		_, err := suggestionClient.dialOption(tc.serviceGRPCTLS)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDesc, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDesc)
+		}
+	}
+}
+
 func TestConvertTrialConditionType(t *testing.T) {
 
 	tcs := []struct {
@@ -367,6 +734,11 @@ func TestConvertTrialConditionType(t *testing.T) {
 			ExpectedCondition: suggestionapi.TrialStatus_EARLYSTOPPED,
 			TestDescription:   "Convert early stopped Trial condition",
 		},
+		{
+			InCondition:       trialsv1beta1.TrialTimedOut,
+			ExpectedCondition: suggestionapi.TrialStatus_KILLED,
+			TestDescription:   "Convert timed out Trial condition",
+		},
 		{
 			InCondition:       "Unknown",
 			ExpectedCondition: suggestionapi.TrialStatus_UNKNOWN,
@@ -413,6 +785,36 @@ func TestConvertObjectiveType(t *testing.T) {
 	}
 }
 
+func TestConvertObjectives(t *testing.T) {
+	goal := 0.1
+	objectives := []commonapiv1beta1.Objective{
+		{
+			Type:                commonv1beta1.ObjectiveTypeMaximize,
+			ObjectiveMetricName: "accuracy",
+		},
+		{
+			Type:                commonv1beta1.ObjectiveTypeMinimize,
+			Goal:                &goal,
+			ObjectiveMetricName: "latency",
+		},
+	}
+	expected := []*suggestionapi.Objective{
+		{
+			Type:                suggestionapi.ObjectiveType_MAXIMIZE,
+			ObjectiveMetricName: "accuracy",
+		},
+		{
+			Type:                suggestionapi.ObjectiveType_MINIMIZE,
+			Goal:                0.1,
+			ObjectiveMetricName: "latency",
+		},
+	}
+	actual := convertObjectives(objectives)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Convert objectives failed. Expected objectives %v, got %v", expected, actual)
+	}
+}
+
 func TestConvertParameterType(t *testing.T) {
 
 	tcs := []struct {
@@ -454,6 +856,112 @@ func TestConvertParameterType(t *testing.T) {
 	}
 }
 
+func TestConvertParameters(t *testing.T) {
+	parameters := []experimentsv1beta1.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				List: []string{"sgd", "adam"},
+			},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: experimentsv1beta1.ParameterTypeDouble,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min: "0.5",
+				Max: "0.9",
+			},
+			Condition: &experimentsv1beta1.ParameterCondition{
+				Parameter:     "optimizer",
+				Values:        []string{"sgd"},
+				InactiveValue: "0",
+			},
+		},
+	}
+	expected := []*suggestionapi.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: suggestionapi.ParameterType_CATEGORICAL,
+			FeasibleSpace: &suggestionapi.FeasibleSpace{
+				List: []string{"sgd", "adam"},
+			},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: suggestionapi.ParameterType_DOUBLE,
+			FeasibleSpace: &suggestionapi.FeasibleSpace{
+				Min: "0.5",
+				Max: "0.9",
+			},
+			Condition: &suggestionapi.ParameterCondition{
+				Parameter: "optimizer",
+				Values:    []string{"sgd"},
+			},
+		},
+	}
+	actual := convertParameters(parameters)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Convert parameters failed. Expected parameters %v, got %v", expected, actual)
+	}
+}
+
+func TestConvertFeasibleSpace(t *testing.T) {
+
+	tcs := []struct {
+		InFeasibleSpace       experimentsv1beta1.FeasibleSpace
+		ExpectedFeasibleSpace *suggestionapi.FeasibleSpace
+		TestDescription       string
+	}{
+		{
+			InFeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min:  "1",
+				Max:  "5",
+				Step: "2",
+			},
+			ExpectedFeasibleSpace: &suggestionapi.FeasibleSpace{
+				Min:          "1",
+				Max:          "5",
+				Step:         "2",
+				Distribution: suggestionapi.Distribution_UNIFORM,
+			},
+			TestDescription: "Convert feasible space without distribution",
+		},
+		{
+			InFeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min:          "0.0001",
+				Max:          "0.1",
+				Distribution: experimentsv1beta1.DistributionLogUniform,
+			},
+			ExpectedFeasibleSpace: &suggestionapi.FeasibleSpace{
+				Min:          "0.0001",
+				Max:          "0.1",
+				Distribution: suggestionapi.Distribution_LOG_UNIFORM,
+			},
+			TestDescription: "Convert log-uniform feasible space",
+		},
+		{
+			InFeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Mean:         "0.5",
+				Std:          "0.1",
+				Distribution: experimentsv1beta1.DistributionNormal,
+			},
+			ExpectedFeasibleSpace: &suggestionapi.FeasibleSpace{
+				Mean:         "0.5",
+				Std:          "0.1",
+				Distribution: suggestionapi.Distribution_NORMAL,
+			},
+			TestDescription: "Convert normal feasible space",
+		},
+	}
+	for _, tc := range tcs {
+		actualFeasibleSpace := convertFeasibleSpace(tc.InFeasibleSpace)
+		if !reflect.DeepEqual(actualFeasibleSpace, tc.ExpectedFeasibleSpace) {
+			t.Errorf("Case: %v failed. Expected feasible space %v, got %v", tc.TestDescription, tc.ExpectedFeasibleSpace, actualFeasibleSpace)
+		}
+	}
+}
+
 func TestConvertTrialObservation(t *testing.T) {
 
 	tcs := []struct {
@@ -911,5 +1419,27 @@ func newFakeRequest() *suggestionapi.GetSuggestionsRequest {
 			},
 		},
 		RequestNumber: 2,
+		ExperimentId:  "namespace/experiment-name/",
+	}
+}
+
+func newFakeKatibConfigClient() client.Client {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consts.KatibConfigMapName,
+			Namespace: consts.DefaultKatibNamespace,
+		},
+		Data: map[string]string{
+			consts.LabelSuggestionTag: fmt.Sprintf(`{
+				%q: {"embedded": true},
+				%q: {"embedded": true},
+				%q: {"sharedServiceEndpoint": "shared-service.kubeflow:6789"},
+				%q: {"image": "algorithm-image"}
+			}`, embeddedAlgorithmName, unregisteredAlgorithmName, sharedAlgorithmName, algorithmName),
+			consts.LabelEarlyStoppingTag: fmt.Sprintf(`{
+				%q: {"image": "early-stopping-image"}
+			}`, earlyStoppingAlgorithmName),
+		},
 	}
+	return fake.NewClientBuilder().WithRuntimeObjects(configMap).Build()
 }
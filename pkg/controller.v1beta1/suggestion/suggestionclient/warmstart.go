@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestionclient
+
+import (
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+)
+
+// filterWarmStartTrials returns succeeded Trials with the same objective metric as the Experiment
+// and assignments in the Experiment search space.
+func filterWarmStartTrials(e *experimentsv1beta1.Experiment, ts []trialsv1beta1.Trial) []trialsv1beta1.Trial {
+	warmStartTrials := []trialsv1beta1.Trial{}
+	if e.Spec.Objective == nil {
+		return warmStartTrials
+	}
+	for _, t := range ts {
+		if !t.IsSucceeded() || t.Spec.Objective == nil ||
+			t.Spec.Objective.ObjectiveMetricName != e.Spec.Objective.ObjectiveMetricName {
+			continue
+		}
+		if len(e.Spec.Parameters) > 0 && util.ValidateParameterAssignments(e.Spec.Parameters, t.Spec.ParameterAssignments) == nil {
+			warmStartTrials = append(warmStartTrials, t)
+		}
+	}
+	return warmStartTrials
+}
@@ -209,6 +209,13 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 		}
 	}
 
+	// Trial must be reconciled when max duration is reached, even if Job is not changed.
+	if remaining, ok := getRemainingDuration(instance, time.Now()); ok && remaining > 0 && !instance.IsCompleted() {
+		return reconcile.Result{
+			RequeueAfter: remaining,
+		}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -228,6 +235,18 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 		return err
 	}
 
+	// Job is deleted to be retried, Trial is reconciled again when Job is removed.
+	if deployedJob != nil && !deployedJob.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	// If max duration is exceeded, Trial is timed out with the current observation and Job is deleted.
+	if deployedJob != nil && !instance.IsCompleted() {
+		if remaining, ok := getRemainingDuration(instance, time.Now()); ok && remaining <= 0 {
+			return r.timeoutTrial(instance, deployedJob.GetName())
+		}
+	}
+
 	// Job already exists.
 	// If Trial is EarlyStopped we need to verify/update observation logs.
 	if deployedJob != nil && (!instance.IsCompleted() || instance.IsEarlyStopped()) {
@@ -256,6 +275,18 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 			return errMetricsNotReported
 		}
 
+		// If Job has failed and failure is retryable, Job is created again with the same assignments.
+		var exitCodes []int32
+		if jobStatus.Condition == trialutil.JobFailed && instance.Spec.RetryPolicy != nil && len(instance.Spec.RetryPolicy.ExitCodes) > 0 {
+			if exitCodes, err = r.getPrimaryContainerExitCodes(instance, deployedJob); err != nil {
+				logger.Error(err, "Get primary container exit codes error")
+				return err
+			}
+		}
+		if !instance.IsCompleted() && trialutil.IsRetryableFailure(instance.Spec.RetryPolicy, instance.Status.Retries, jobStatus, exitCodes) {
+			return r.retryTrialJob(instance, deployedJob, jobStatus)
+		}
+
 		// Update Trial job status only
 		//    if job has succeeded and if observation field is available.
 		//    if job has failed
@@ -311,7 +342,8 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 			return nil, err
 		}
 	} else {
-		if instance.IsCompleted() && !instance.Spec.RetainRun {
+		// Timed out Trial run is deleted even if it must be retained.
+		if instance.IsCompleted() && (!instance.Spec.RetainRun || instance.IsTimedOut()) {
 			if err = r.Delete(context.TODO(), desiredJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
 				logger.Error(err, "Delete job error")
 				return nil, err
@@ -29,6 +29,8 @@ const (
 	TrialSucceededReason          = "TrialSucceeded"
 	TrialMetricsUnavailableReason = "MetricsUnavailable"
 	TrialFailedReason             = "TrialFailed"
+	TrialRetryingReason           = "TrialRetrying"
+	TrialTimedOutReason           = "TrialTimedOut"
 
 	// For Jobs
 	JobCreatedReason            = "JobCreated"
@@ -37,6 +39,8 @@ const (
 	JobMetricsUnavailableReason = "MetricsUnavailable"
 	JobFailedReason             = "JobFailed"
 	JobRunningReason            = "JobRunning"
+	JobRetriedReason            = "JobRetried"
+	JobTimedOutReason           = "JobTimedOut"
 )
 
 type updateStatusFunc func(instance *trialsv1beta1.Trial) error
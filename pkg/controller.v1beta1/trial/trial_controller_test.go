@@ -35,6 +35,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -454,6 +456,55 @@ func TestGetObjectiveMetricValue(t *testing.T) {
 	g.Expect(err).To(gomega.HaveOccurred())
 }
 
+func TestGetPrimaryContainerExitCodes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	trial := newFakeTrialBatchJob()
+	deployedJob := trial.Spec.RunSpec.DeepCopy()
+
+	newFakePod := func(name, ownerName string, state, lastState *corev1.ContainerStateTerminated) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "batch/v1",
+						Kind:       "Job",
+						Name:       ownerName,
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 trial.Spec.PrimaryContainerName,
+						State:                corev1.ContainerState{Terminated: state},
+						LastTerminationState: corev1.ContainerState{Terminated: lastState},
+					},
+					{
+						Name:  "metrics-logger-and-collector",
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2}},
+					},
+				},
+			},
+		}
+	}
+
+	r := &ReconcileTrial{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			newFakePod("job-pod-1", batchJobName,
+				&corev1.ContainerStateTerminated{ExitCode: 137}, &corev1.ContainerStateTerminated{ExitCode: 1}),
+			newFakePod("job-pod-2", batchJobName, nil, nil),
+			newFakePod("other-job-pod", "other-job", &corev1.ContainerStateTerminated{ExitCode: 143}, nil),
+		).Build(),
+	}
+
+	exitCodes, err := r.getPrimaryContainerExitCodes(trial, deployedJob)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(exitCodes).To(gomega.ConsistOf(int32(137), int32(1)))
+}
+
 func newFakeTrialTFJob() *trialsv1beta1.Trial {
 	primaryContainer := "tensorflow"
 
@@ -24,7 +24,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -122,6 +124,96 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 	return
 }
 
+// retryTrialJob deletes the failed Job and its observation logs.
+// Job is created again from the Trial run spec on the next reconcile, when deletion is finished.
+func (r *ReconcileTrial) retryTrialJob(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured, jobStatus *trialutil.TrialJobStatus) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	if err := r.Delete(context.TODO(), deployedJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+		logger.Error(err, "Delete failed job error")
+		return err
+	}
+	if _, err := r.DeleteTrialObservationLog(instance); err != nil {
+		logger.Error(err, "Delete trial observation log error")
+		return err
+	}
+	instance.Status.Observation = nil
+	instance.Status.Retries++
+
+	msg := fmt.Sprintf("Trial is retrying, retry %v of %v", instance.Status.Retries, instance.Spec.RetryPolicy.MaxRetries)
+	if jobStatus.Message != "" {
+		msg = fmt.Sprintf("%v. Job message: %v", msg, jobStatus.Message)
+	}
+	reason := TrialRetryingReason
+	if jobStatus.Reason != "" {
+		reason = fmt.Sprintf("%v. Job reason: %v", reason, jobStatus.Reason)
+	}
+	instance.MarkTrialStatusRunning(reason, msg)
+
+	eventMsg := fmt.Sprintf("Job %v has failed and it is retried", deployedJob.GetName())
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, JobRetriedReason, eventMsg)
+	logger.Info("Trial job is retried", "retries", instance.Status.Retries)
+	return nil
+}
+
+// getPrimaryContainerExitCodes returns exit codes of the terminated primary containers in the Pods of the Job.
+// Only Pods, which are owned by the Job directly, are checked.
+func (r *ReconcileTrial) getPrimaryContainerExitCodes(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured) ([]int32, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(context.TODO(), pods, client.InNamespace(instance.GetNamespace()),
+		client.MatchingLabels(instance.Spec.PrimaryPodLabels)); err != nil {
+		return nil, err
+	}
+	exitCodes := []int32{}
+	for _, pod := range pods.Items {
+		if !isOwnedBy(pod.GetOwnerReferences(), deployedJob) {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != instance.Spec.PrimaryContainerName {
+				continue
+			}
+			if status.State.Terminated != nil {
+				exitCodes = append(exitCodes, status.State.Terminated.ExitCode)
+			}
+			if status.LastTerminationState.Terminated != nil {
+				exitCodes = append(exitCodes, status.LastTerminationState.Terminated.ExitCode)
+			}
+		}
+	}
+	return exitCodes, nil
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, owner *unstructured.Unstructured) bool {
+	for _, ref := range refs {
+		if ref.Kind == owner.GetKind() && ref.Name == owner.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// timeoutTrial marks Trial timed out and keeps the observation collected so far.
+// Job is deleted on the next reconcile, since Trial is completed.
+func (r *ReconcileTrial) timeoutTrial(instance *trialsv1beta1.Trial, deployedJobName string) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	if err := r.UpdateTrialStatusObservation(instance); err != nil {
+		logger.Error(err, "Update trial status observation error")
+		return err
+	}
+
+	timeNow := metav1.Now()
+	msg := fmt.Sprintf("Trial has timed out because max duration %v has exceeded", instance.Spec.MaxDuration.Duration)
+	instance.MarkTrialStatusTimedOut(TrialTimedOutReason, msg)
+	instance.Status.CompletionTime = &timeNow
+
+	eventMsg := fmt.Sprintf("Job %v has timed out", deployedJobName)
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, JobTimedOutReason, eventMsg)
+	logger.Info("Trial status changed to TimedOut")
+	return nil
+}
+
 func (r *ReconcileTrial) UpdateTrialStatusObservation(instance *trialsv1beta1.Trial) error {
 	reply, err := r.GetTrialObservationLog(instance)
 	if err != nil {
@@ -163,6 +255,16 @@ func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finaliz
 	}
 }
 
+// getRemainingDuration returns the duration until max duration of the Trial is reached.
+// It returns false if max duration is not specified or Trial is not started.
+func getRemainingDuration(instance *trialsv1beta1.Trial, now time.Time) (time.Duration, bool) {
+	if instance.Spec.MaxDuration == nil || instance.Status.StartTime == nil {
+		return 0, false
+	}
+	deadline := instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration)
+	return deadline.Sub(now), true
+}
+
 func isTrialObservationAvailable(instance *trialsv1beta1.Trial) bool {
 	objectiveMetricName := instance.Spec.Objective.ObjectiveMetricName
 	if instance.Status.Observation != nil && instance.Status.Observation.Metrics != nil {
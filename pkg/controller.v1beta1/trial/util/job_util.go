@@ -19,11 +19,15 @@ package util
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/tidwall/gjson"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 )
@@ -58,6 +62,8 @@ const (
 
 var (
 	log = logf.Log.WithName("job-util")
+
+	exitCodeRegexp = regexp.MustCompile(`(?i)exit\s*code\D{0,3}(\d+)`)
 )
 
 // GetDeployedJobStatus returns internal representation for deployed Job status.
@@ -123,3 +129,36 @@ func GetDeployedJobStatus(trial *trialsv1beta1.Trial, deployedJob *unstructured.
 	// Otherwise returns nil object and Trial status doesn't need to be updated
 	return nil, nil
 }
+
+// IsRetryableFailure returns true if the failed Job can be created again according to the retry policy.
+// retries is the number of times the Job has been already retried.
+// exitCodes are the exit codes of the terminated primary containers. If none of them is retryable,
+// exit code is taken from the failure condition message, e.g. "exit code 137".
+func IsRetryableFailure(retryPolicy *commonv1beta1.RetryPolicy, retries int32, jobStatus *TrialJobStatus, exitCodes []int32) bool {
+	if retryPolicy == nil || jobStatus == nil || jobStatus.Condition != JobFailed || retries >= retryPolicy.MaxRetries {
+		return false
+	}
+	if len(retryPolicy.Reasons) == 0 && len(retryPolicy.ExitCodes) == 0 {
+		return true
+	}
+	for _, reason := range retryPolicy.Reasons {
+		if reason != "" && (strings.Contains(jobStatus.Reason, reason) || strings.Contains(jobStatus.Message, reason)) {
+			return true
+		}
+	}
+	for _, match := range exitCodeRegexp.FindAllStringSubmatch(jobStatus.Message, -1) {
+		exitCode, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		exitCodes = append(exitCodes, int32(exitCode))
+	}
+	for _, exitCode := range exitCodes {
+		for _, retryableExitCode := range retryPolicy.ExitCodes {
+			if exitCode == retryableExitCode {
+				return true
+			}
+		}
+	}
+	return false
+}
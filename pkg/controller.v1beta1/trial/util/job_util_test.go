@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 )
@@ -122,6 +123,113 @@ func TestGetDeployedJobStatus(t *testing.T) {
 	}
 }
 
+func TestIsRetryableFailure(t *testing.T) {
+	failedJobStatus := &TrialJobStatus{
+		Condition: JobFailed,
+		Reason:    "BackoffLimitExceeded",
+		Message:   "Pod was terminated with exit code 137",
+	}
+
+	tcs := []struct {
+		retryPolicy     *commonv1beta1.RetryPolicy
+		retries         int32
+		jobStatus       *TrialJobStatus
+		exitCodes       []int32
+		expected        bool
+		testDescription string
+	}{
+		{
+			retryPolicy:     nil,
+			jobStatus:       failedJobStatus,
+			expected:        false,
+			testDescription: "Retry policy is not specified",
+		},
+		{
+			retryPolicy:     &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			jobStatus:       failedJobStatus,
+			expected:        true,
+			testDescription: "All failures are retryable",
+		},
+		{
+			retryPolicy:     &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			retries:         2,
+			jobStatus:       failedJobStatus,
+			expected:        false,
+			testDescription: "Retries are exhausted",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			jobStatus: &TrialJobStatus{
+				Condition: JobRunning,
+			},
+			expected:        false,
+			testDescription: "Job is not failed",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 2,
+				Reasons:    []string{"Evicted", "BackoffLimitExceeded"},
+			},
+			jobStatus:       failedJobStatus,
+			expected:        true,
+			testDescription: "Failure reason is retryable",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 2,
+				ExitCodes:  []int32{143, 137},
+			},
+			jobStatus:       failedJobStatus,
+			expected:        true,
+			testDescription: "Exit code is retryable",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 2,
+				Reasons:    []string{"Evicted"},
+				ExitCodes:  []int32{1},
+			},
+			jobStatus:       failedJobStatus,
+			expected:        false,
+			testDescription: "Failure reason and exit code are not retryable",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 2,
+				ExitCodes:  []int32{137},
+			},
+			jobStatus: &TrialJobStatus{
+				Condition: JobFailed,
+				Reason:    "BackoffLimitExceeded",
+				Message:   "Job has reached the specified backoff limit",
+			},
+			exitCodes:       []int32{1, 137},
+			expected:        true,
+			testDescription: "Exit code of the primary container is retryable",
+		},
+		{
+			retryPolicy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 2,
+				ExitCodes:  []int32{137},
+			},
+			jobStatus: &TrialJobStatus{
+				Condition: JobFailed,
+				Reason:    "BackoffLimitExceeded",
+				Message:   "Job has reached the specified backoff limit",
+			},
+			expected:        false,
+			testDescription: "Exit code is unknown for batch Job",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual := IsRetryableFailure(tc.retryPolicy, tc.retries, tc.jobStatus, tc.exitCodes)
+		if actual != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+}
+
 func newFakeTrial(successCondition, failureCondition string) *trialsv1beta1.Trial {
 	return &trialsv1beta1.Trial{
 		Spec: trialsv1beta1.TrialSpec{
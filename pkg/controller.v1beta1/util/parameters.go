@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+// ValidateParameterAssignments checks that the assignments are in the feasible space of the parameters.
+// Conditional parameter must be assigned only if its parent parameter has one of the condition values.
+func ValidateParameterAssignments(params []experimentsv1beta1.ParameterSpec, assignments []commonv1beta1.ParameterAssignment) error {
+	paramsMap := make(map[string]experimentsv1beta1.ParameterSpec, len(params))
+	for _, p := range params {
+		paramsMap[p.Name] = p
+	}
+	assignmentsMap := make(map[string]string, len(assignments))
+	for _, a := range assignments {
+		if _, ok := paramsMap[a.Name]; !ok {
+			return fmt.Errorf("parameter %v is not in the search space", a.Name)
+		}
+		if _, ok := assignmentsMap[a.Name]; ok {
+			return fmt.Errorf("parameter %v is assigned more than once", a.Name)
+		}
+		assignmentsMap[a.Name] = a.Value
+	}
+
+	for _, p := range params {
+		value, ok := assignmentsMap[p.Name]
+		isActive := true
+		if p.Condition != nil {
+			parentValue, isParentAssigned := assignmentsMap[p.Condition.Parameter]
+			isActive = isParentAssigned && containsValue(p.Condition.Values, parentValue)
+		}
+		if !ok {
+			if isActive {
+				return fmt.Errorf("parameter %v is not assigned", p.Name)
+			}
+			continue
+		}
+		if !isActive {
+			return fmt.Errorf("parameter %v is assigned, but its condition on parameter %v is not met", p.Name, p.Condition.Parameter)
+		}
+
+		switch p.ParameterType {
+		case experimentsv1beta1.ParameterTypeCategorical, experimentsv1beta1.ParameterTypeDiscrete:
+			if !containsValue(p.FeasibleSpace.List, value) {
+				return fmt.Errorf("value %v of parameter %v is not in the feasible space list", value, p.Name)
+			}
+		case experimentsv1beta1.ParameterTypeInt, experimentsv1beta1.ParameterTypeDouble:
+			if p.ParameterType == experimentsv1beta1.ParameterTypeInt {
+				if _, err := strconv.Atoi(value); err != nil {
+					return fmt.Errorf("value %v of parameter %v must be an integer", value, p.Name)
+				}
+			}
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("value %v of parameter %v must be a number", value, p.Name)
+			}
+			if min, err := strconv.ParseFloat(p.FeasibleSpace.Min, 64); err == nil && v < min {
+				return fmt.Errorf("value %v of parameter %v is less than the feasible space min", value, p.Name)
+			}
+			if max, err := strconv.ParseFloat(p.FeasibleSpace.Max, 64); err == nil && v > max {
+				return fmt.Errorf("value %v of parameter %v is greater than the feasible space max", value, p.Name)
+			}
+			if p.FeasibleSpace.Step != "" && !isOnStep(v, p.FeasibleSpace) {
+				return fmt.Errorf("value %v of parameter %v is not a multiple of the feasible space step", value, p.Name)
+			}
+		default:
+			return fmt.Errorf("parameter %v has unknown type %v", p.Name, p.ParameterType)
+		}
+	}
+	return nil
+}
+
+// isOnStep returns true if the value is the min plus the multiple of the step.
+// Values of the log-uniform parameter are rounded to the multiple of the step.
+func isOnStep(v float64, fs experimentsv1beta1.FeasibleSpace) bool {
+	step, err := strconv.ParseFloat(fs.Step, 64)
+	if err != nil || step <= 0 {
+		return true
+	}
+	if fs.Distribution != experimentsv1beta1.DistributionLogUniform {
+		if min, err := strconv.ParseFloat(fs.Min, 64); err == nil {
+			v -= min
+		}
+	}
+	n := v / step
+	return math.Abs(n-math.Round(n)) <= 1e-9*math.Max(1, math.Abs(n))
+}
+
+func containsValue(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+func TestValidateParameterAssignments(t *testing.T) {
+	params := []experimentsv1beta1.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				List: []string{"sgd", "adam"},
+			},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: experimentsv1beta1.ParameterTypeDouble,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min:  "0.5",
+				Max:  "0.9",
+				Step: "0.1",
+			},
+			Condition: &experimentsv1beta1.ParameterCondition{
+				Parameter: "optimizer",
+				Values:    []string{"sgd"},
+			},
+		},
+		{
+			Name:          "num-layers",
+			ParameterType: experimentsv1beta1.ParameterTypeInt,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min:  "1",
+				Max:  "9",
+				Step: "2",
+			},
+		},
+		{
+			Name:          "batch-size",
+			ParameterType: experimentsv1beta1.ParameterTypeInt,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Min:          "16",
+				Max:          "512",
+				Step:         "32",
+				Distribution: experimentsv1beta1.DistributionLogUniform,
+			},
+		},
+	}
+	newAssignments := func(optimizer, momentum, numLayers, batchSize string) []commonv1beta1.ParameterAssignment {
+		assignments := []commonv1beta1.ParameterAssignment{
+			{Name: "optimizer", Value: optimizer},
+			{Name: "num-layers", Value: numLayers},
+			{Name: "batch-size", Value: batchSize},
+		}
+		if momentum != "" {
+			assignments = append(assignments, commonv1beta1.ParameterAssignment{Name: "momentum", Value: momentum})
+		}
+		return assignments
+	}
+
+	testCases := []struct {
+		assignments []commonv1beta1.ParameterAssignment
+		expectedErr bool
+		testDesc    string
+	}{
+		{
+			assignments: newAssignments("sgd", "0.7", "3", "64"),
+			testDesc:    "Active conditional parameter is assigned",
+		},
+		{
+			assignments: newAssignments("adam", "", "3", "64"),
+			testDesc:    "Inactive conditional parameter is not assigned",
+		},
+		{
+			assignments: newAssignments("sgd", "", "3", "64"),
+			expectedErr: true,
+			testDesc:    "Active conditional parameter is not assigned",
+		},
+		{
+			assignments: newAssignments("adam", "0.7", "3", "64"),
+			expectedErr: true,
+			testDesc:    "Inactive conditional parameter is assigned",
+		},
+		{
+			assignments: newAssignments("adam", "", "4", "64"),
+			expectedErr: true,
+			testDesc:    "Int value is not on the step from min",
+		},
+		{
+			assignments: newAssignments("sgd", "0.75", "3", "64"),
+			expectedErr: true,
+			testDesc:    "Double value is not on the step from min",
+		},
+		{
+			assignments: newAssignments("adam", "", "3", "96"),
+			testDesc:    "Log-uniform value is the multiple of the step",
+		},
+		{
+			assignments: newAssignments("adam", "", "3", "80"),
+			expectedErr: true,
+			testDesc:    "Log-uniform value is not the multiple of the step",
+		},
+		{
+			assignments: newAssignments("adam", "", "11", "64"),
+			expectedErr: true,
+			testDesc:    "Value is greater than max",
+		},
+		{
+			assignments: newAssignments("rmsprop", "", "3", "64"),
+			expectedErr: true,
+			testDesc:    "Value is not in the feasible space list",
+		},
+		{
+			assignments: append(newAssignments("adam", "", "3", "64"), commonv1beta1.ParameterAssignment{Name: "lr", Value: "0.1"}),
+			expectedErr: true,
+			testDesc:    "Parameter is not in the search space",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateParameterAssignments(params, tc.assignments)
+		if !tc.expectedErr && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDesc, err)
+		} else if tc.expectedErr && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDesc)
+		}
+	}
+}
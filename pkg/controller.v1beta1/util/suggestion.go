@@ -21,6 +21,7 @@ import (
 
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 // GetSuggestionDeploymentName returns name for the suggestion's deployment
@@ -48,8 +49,17 @@ func GetSuggestionRBACName(s *suggestionsv1beta1.Suggestion) string {
 	return s.Name + "-" + s.Spec.Algorithm.AlgorithmName
 }
 
-// GetAlgorithmEndpoint returns the endpoint of the Suggestion service with HP or NAS algorithm
-func GetAlgorithmEndpoint(s *suggestionsv1beta1.Suggestion) string {
+// IsSharedSuggestion returns true if the Suggestion uses the shared Suggestion service from Katib config.
+func IsSharedSuggestion(suggestionConfig katibconfig.SuggestionConfig) bool {
+	return suggestionConfig.SharedServiceEndpoint != ""
+}
+
+// GetAlgorithmEndpoint returns the endpoint of the Suggestion service with HP or NAS algorithm.
+// If the Suggestion uses the shared Suggestion service, endpoint of the shared service is returned.
+func GetAlgorithmEndpoint(s *suggestionsv1beta1.Suggestion, suggestionConfig katibconfig.SuggestionConfig) string {
+	if IsSharedSuggestion(suggestionConfig) {
+		return suggestionConfig.SharedServiceEndpoint
+	}
 	serviceName := GetSuggestionServiceName(s)
 	return fmt.Sprintf("%s.%s:%d",
 		serviceName,
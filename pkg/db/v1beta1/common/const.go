@@ -23,6 +23,10 @@ const (
 
 	MySqlDBNameEnvValue = "mysql"
 
+	PostgreSQLDBNameEnvValue = "postgres"
+
+	SQLiteDBNameEnvValue = "sqlite"
+
 	DBPasswordEnvName = "DB_PASSWORD"
 
 	MySQLDBHostEnvName = "KATIB_MYSQL_DB_HOST"
@@ -33,4 +37,19 @@ const (
 	DefaultMySQLDatabase = "katib"
 	DefaultMySQLHost     = "katib-mysql"
 	DefaultMySQLPort     = "3306"
+
+	PostgreSQLDBHostEnvName = "KATIB_POSTGRESQL_DB_HOST"
+	PostgreSQLDBPortEnvName = "KATIB_POSTGRESQL_DB_PORT"
+	PostgreSQLDatabase      = "KATIB_POSTGRESQL_DB_DATABASE"
+	PostgreSQLSSLMode       = "KATIB_POSTGRESQL_SSL_MODE"
+
+	DefaultPostgreSQLUser     = "katib"
+	DefaultPostgreSQLDatabase = "katib"
+	DefaultPostgreSQLHost     = "katib-postgres"
+	DefaultPostgreSQLPort     = "5432"
+	DefaultPostgreSQLSSLMode  = "disable"
+
+	SQLiteDBPathEnvName = "KATIB_SQLITE_DB_PATH"
+
+	DefaultSQLiteDBPath = "/var/lib/katib/katib.db"
 )
@@ -25,6 +25,8 @@ type KatibDBInterface interface {
 	SelectOne() error
 
 	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error
-	GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
+	// GetObservationLog returns a page of the Trial metric logs and the token of the next page.
+	// The token is empty for the last page.
+	GetObservationLog(trialName string, metricName string, startTime string, endTime string, opts *ObservationLogOptions) (*v1beta1.ObservationLog, string, error)
 	DeleteObservationLog(trialName string) error
 }
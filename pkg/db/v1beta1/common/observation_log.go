@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// ObservationLogOptions controls pagination and downsampling of GetObservationLog.
+// nil returns every metric log in one page.
+type ObservationLogOptions struct {
+	// PageSize is the maximum number of metric logs in one page. 0 means no limit.
+	PageSize int32
+	// PageToken is the next page token of the previous page. Empty for the first page.
+	PageToken string
+	// Downsample is applied before pagination. nil means no downsampling.
+	Downsample *v1beta1.DownsampleSpec
+}
+
+// ObservationLogRow is one row of the observation_logs table.
+type ObservationLogRow struct {
+	ID         int64
+	Time       time.Time
+	MetricName string
+	Value      string
+}
+
+// ObservationLogCursor is the position after which the next page starts.
+// Rows with time > Time, or time = Time and id > ID, belong to the next page.
+// IDs start from 1, so ID 0 means that every row at Time is included.
+type ObservationLogCursor struct {
+	Time time.Time
+	ID   int64
+}
+
+type pageToken struct {
+	Time string `json:"time"`
+	ID   int64  `json:"id,omitempty"`
+}
+
+// ObservationLogPager builds a page of the observation log from rows ordered by time and id.
+// DB backends query rows after Cursor, feed them to Add until it returns false and call Result.
+type ObservationLogPager struct {
+	pageSize       int
+	downsampleType v1beta1.DownsampleType
+	everyNth       int
+	bucketDuration time.Duration
+
+	cursor   *ObservationLogCursor
+	count    int
+	logs     []*v1beta1.MetricLog
+	nextPage *ObservationLogCursor
+
+	// Last emitted row, used as the cursor of EVERY_NTH and not downsampled pages.
+	lastRow *ObservationLogRow
+	// Aggregations of the current bucket.
+	bucketStart time.Time
+	buckets     map[string]*bucketAggregation
+}
+
+type bucketAggregation struct {
+	min   float64
+	max   float64
+	sum   float64
+	count int
+}
+
+// NewObservationLogPager validates opts and returns a pager for them.
+func NewObservationLogPager(opts *ObservationLogOptions) (*ObservationLogPager, error) {
+	p := &ObservationLogPager{}
+	if opts == nil {
+		return p, nil
+	}
+	if opts.PageSize < 0 {
+		return nil, fmt.Errorf("Invalid page size %v, must be >= 0", opts.PageSize)
+	}
+	p.pageSize = int(opts.PageSize)
+
+	if opts.Downsample != nil {
+		p.downsampleType = opts.Downsample.Type
+		switch opts.Downsample.Type {
+		case v1beta1.DownsampleType_NO_DOWNSAMPLE:
+		case v1beta1.DownsampleType_EVERY_NTH:
+			if opts.Downsample.EveryNth < 1 {
+				return nil, fmt.Errorf("Invalid every_nth %v, must be >= 1", opts.Downsample.EveryNth)
+			}
+			p.everyNth = int(opts.Downsample.EveryNth)
+		case v1beta1.DownsampleType_BUCKET_MIN, v1beta1.DownsampleType_BUCKET_MAX, v1beta1.DownsampleType_BUCKET_MEAN:
+			d, err := time.ParseDuration(opts.Downsample.BucketDuration)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing bucket duration %s: %v", opts.Downsample.BucketDuration, err)
+			}
+			if d <= 0 {
+				return nil, fmt.Errorf("Invalid bucket duration %s, must be positive", opts.Downsample.BucketDuration)
+			}
+			p.bucketDuration = d
+		default:
+			return nil, fmt.Errorf("Unknown downsample type %v", opts.Downsample.Type)
+		}
+	}
+
+	if opts.PageToken != "" {
+		cursor, err := decodePageToken(opts.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		p.cursor = cursor
+		// The previous page ends with an emitted row, so the next emitted row is N rows after it.
+		p.count = 1
+	}
+	return p, nil
+}
+
+// Cursor returns the position after which rows must be queried, nil for the first page.
+func (p *ObservationLogPager) Cursor() *ObservationLogCursor {
+	return p.cursor
+}
+
+// Limit returns the maximum number of rows needed for the page, 0 means no limit.
+func (p *ObservationLogPager) Limit() int {
+	if p.pageSize == 0 || p.downsampleType != v1beta1.DownsampleType_NO_DOWNSAMPLE {
+		return 0
+	}
+	// One extra row tells whether there is a next page.
+	return p.pageSize + 1
+}
+
+// Add adds the next row. It returns false when the page is complete and no more rows are needed.
+func (p *ObservationLogPager) Add(row *ObservationLogRow) bool {
+	switch p.downsampleType {
+	case v1beta1.DownsampleType_BUCKET_MIN, v1beta1.DownsampleType_BUCKET_MAX, v1beta1.DownsampleType_BUCKET_MEAN:
+		return p.addToBucket(row)
+	case v1beta1.DownsampleType_EVERY_NTH:
+		emit := p.count%p.everyNth == 0
+		p.count++
+		if !emit {
+			return true
+		}
+	}
+	if p.pageSize > 0 && len(p.logs) >= p.pageSize {
+		p.nextPage = &ObservationLogCursor{Time: p.lastRow.Time, ID: p.lastRow.ID}
+		return false
+	}
+	p.logs = append(p.logs, newMetricLog(row.Time, row.MetricName, row.Value))
+	p.lastRow = row
+	return true
+}
+
+func (p *ObservationLogPager) addToBucket(row *ObservationLogRow) bool {
+	// Buckets are aligned to the Unix epoch, unlike time.Truncate which uses the zero time.
+	nanos := row.Time.UnixNano()
+	offset := nanos % int64(p.bucketDuration)
+	if offset < 0 {
+		offset += int64(p.bucketDuration)
+	}
+	start := time.Unix(0, nanos-offset).UTC()
+	if p.buckets != nil && !start.Equal(p.bucketStart) {
+		p.flushBucket()
+		if p.nextPage != nil {
+			return false
+		}
+	}
+	value, err := strconv.ParseFloat(row.Value, 64)
+	if err != nil {
+		klog.Warningf("Skip non numeric value %s of metric %s: %v", row.Value, row.MetricName, err)
+		return true
+	}
+	if p.buckets == nil {
+		p.bucketStart = start
+		p.buckets = map[string]*bucketAggregation{}
+	}
+	b, ok := p.buckets[row.MetricName]
+	if !ok {
+		b = &bucketAggregation{min: math.Inf(1), max: math.Inf(-1)}
+		p.buckets[row.MetricName] = b
+	}
+	b.min = math.Min(b.min, value)
+	b.max = math.Max(b.max, value)
+	b.sum += value
+	b.count++
+	return true
+}
+
+func (p *ObservationLogPager) flushBucket() {
+	if p.buckets == nil {
+		return
+	}
+	// A page holds whole buckets, so it can exceed the page size if one bucket has more metrics.
+	if p.pageSize > 0 && len(p.logs) > 0 && len(p.logs)+len(p.buckets) > p.pageSize {
+		p.nextPage = &ObservationLogCursor{Time: p.bucketStart}
+		p.buckets = nil
+		return
+	}
+	names := make([]string, 0, len(p.buckets))
+	for name := range p.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b := p.buckets[name]
+		var value float64
+		switch p.downsampleType {
+		case v1beta1.DownsampleType_BUCKET_MIN:
+			value = b.min
+		case v1beta1.DownsampleType_BUCKET_MAX:
+			value = b.max
+		case v1beta1.DownsampleType_BUCKET_MEAN:
+			value = b.sum / float64(b.count)
+		}
+		p.logs = append(p.logs, newMetricLog(p.bucketStart, name, strconv.FormatFloat(value, 'g', -1, 64)))
+	}
+	p.buckets = nil
+}
+
+// Result returns the page and the token of the next page, which is empty for the last page.
+func (p *ObservationLogPager) Result() (*v1beta1.ObservationLog, string, error) {
+	if p.nextPage == nil {
+		p.flushBucket()
+	}
+	result := &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{},
+	}
+	result.MetricLogs = append(result.MetricLogs, p.logs...)
+	if p.nextPage == nil {
+		return result, "", nil
+	}
+	token, err := encodePageToken(p.nextPage)
+	if err != nil {
+		return nil, "", err
+	}
+	return result, token, nil
+}
+
+func newMetricLog(t time.Time, name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: t.UTC().Format(time.RFC3339Nano),
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func encodePageToken(cursor *ObservationLogCursor) (string, error) {
+	b, err := json.Marshal(pageToken{
+		Time: cursor.Time.UTC().Format(time.RFC3339Nano),
+		ID:   cursor.ID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed to encode page token: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(token string) (*ObservationLogCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token %s: %v", token, err)
+	}
+	t := pageToken{}
+	if err = json.Unmarshal(b, &t); err != nil {
+		return nil, fmt.Errorf("Invalid page token %s: %v", token, err)
+	}
+	cursorTime, err := time.Parse(time.RFC3339Nano, t.Time)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid page token %s: %v", token, err)
+	}
+	return &ObservationLogCursor{Time: cursorTime, ID: t.ID}, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestNewObservationLogPager(t *testing.T) {
+	testCases := []struct {
+		opts     *ObservationLogOptions
+		isValid  bool
+		testDesc string
+	}{
+		{
+			opts:     nil,
+			isValid:  true,
+			testDesc: "No options",
+		},
+		{
+			opts:     &ObservationLogOptions{PageSize: -1},
+			isValid:  false,
+			testDesc: "Negative page size",
+		},
+		{
+			opts:     &ObservationLogOptions{PageToken: "invalid"},
+			isValid:  false,
+			testDesc: "Invalid page token",
+		},
+		{
+			opts: &ObservationLogOptions{
+				Downsample: &v1beta1.DownsampleSpec{Type: v1beta1.DownsampleType_EVERY_NTH},
+			},
+			isValid:  false,
+			testDesc: "Every Nth without N",
+		},
+		{
+			opts: &ObservationLogOptions{
+				Downsample: &v1beta1.DownsampleSpec{Type: v1beta1.DownsampleType_BUCKET_MEAN, BucketDuration: "1m"},
+			},
+			isValid:  true,
+			testDesc: "Valid bucket duration",
+		},
+		{
+			opts: &ObservationLogOptions{
+				Downsample: &v1beta1.DownsampleSpec{Type: v1beta1.DownsampleType_BUCKET_MIN, BucketDuration: "-1m"},
+			},
+			isValid:  false,
+			testDesc: "Negative bucket duration",
+		},
+		{
+			opts: &ObservationLogOptions{
+				Downsample: &v1beta1.DownsampleSpec{Type: v1beta1.DownsampleType(10)},
+			},
+			isValid:  false,
+			testDesc: "Unknown downsample type",
+		},
+	}
+	for _, tc := range testCases {
+		_, err := NewObservationLogPager(tc.opts)
+		if tc.isValid && err != nil {
+			t.Errorf("Case: %v. Expected valid options, got error: %v", tc.testDesc, err)
+		} else if !tc.isValid && err == nil {
+			t.Errorf("Case: %v. Expected error, got nil", tc.testDesc)
+		}
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	rowTime := time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC)
+	pager, err := NewObservationLogPager(&ObservationLogOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("NewObservationLogPager failed: %v", err)
+	}
+	if pager.Limit() != 2 {
+		t.Errorf("Expected limit 2, got %v", pager.Limit())
+	}
+	pager.Add(&ObservationLogRow{ID: 3, Time: rowTime, MetricName: "loss", Value: "0.5"})
+	if pager.Add(&ObservationLogRow{ID: 4, Time: rowTime, MetricName: "loss", Value: "0.4"}) {
+		t.Errorf("Expected the page to be complete")
+	}
+	obsLog, pageToken, err := pager.Result()
+	if err != nil {
+		t.Fatalf("Result failed: %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || pageToken == "" {
+		t.Fatalf("Expected one log and a next page token, got %v, %v", obsLog, pageToken)
+	}
+
+	next, err := NewObservationLogPager(&ObservationLogOptions{PageSize: 1, PageToken: pageToken})
+	if err != nil {
+		t.Fatalf("NewObservationLogPager failed: %v", err)
+	}
+	cursor := next.Cursor()
+	if cursor == nil || !cursor.Time.Equal(rowTime) || cursor.ID != 3 {
+		t.Errorf("Expected cursor at %v with id 3, got %v", rowTime, cursor)
+	}
+}
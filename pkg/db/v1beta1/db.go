@@ -21,12 +21,18 @@ import (
 
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/mysql"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/postgres"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/sqlite"
 )
 
 func NewKatibDBInterface(dbName string) (common.KatibDBInterface, error) {
 
 	if dbName == common.MySqlDBNameEnvValue {
 		return mysql.NewDBInterface()
+	} else if dbName == common.PostgreSQLDBNameEnvValue {
+		return postgres.NewDBInterface()
+	} else if dbName == common.SQLiteDBNameEnvValue {
+		return sqlite.NewDBInterface()
 	}
 	return nil, errors.New("Invalid DB Name")
 }
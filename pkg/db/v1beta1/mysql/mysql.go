@@ -146,7 +146,11 @@ func (d *dbConn) DeleteObservationLog(trialName string) error {
 	return err
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
+func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string, opts *common.ObservationLogOptions) (*v1beta1.ObservationLog, string, error) {
+	pager, err := common.NewObservationLogPager(opts)
+	if err != nil {
+		return nil, "", err
+	}
 	qfield := []interface{}{trialName}
 	qstr := ""
 	if metricName != "" {
@@ -156,7 +160,7 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 	if startTime != "" {
 		s_time, err := time.Parse(time.RFC3339Nano, startTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+			return nil, "", fmt.Errorf("Error parsing start time %s: %v", startTime, err)
 		}
 		formattedStartTime := s_time.UTC().Format(mysqlTimeFmt)
 		qstr += " AND time >= ?"
@@ -165,23 +169,31 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 	if endTime != "" {
 		e_time, err := time.Parse(time.RFC3339Nano, endTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+			return nil, "", fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
 		}
 		formattedEndTime := e_time.UTC().Format(mysqlTimeFmt)
 		qstr += " AND time <= ?"
 		qfield = append(qfield, formattedEndTime)
 	}
-	rows, err := d.db.Query("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr+" ORDER BY time",
+	if cursor := pager.Cursor(); cursor != nil {
+		formattedCursorTime := cursor.Time.UTC().Format(mysqlTimeFmt)
+		qstr += " AND (time > ? OR (time = ? AND id > ?))"
+		qfield = append(qfield, formattedCursorTime, formattedCursorTime, cursor.ID)
+	}
+	qstr += " ORDER BY time, id"
+	if limit := pager.Limit(); limit > 0 {
+		qstr += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := d.db.Query("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr,
 		qfield...)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
-	}
-	result := &v1beta1.ObservationLog{
-		MetricLogs: []*v1beta1.MetricLog{},
+		return nil, "", fmt.Errorf("Failed to get ObservationLogs %v", err)
 	}
+	defer rows.Close()
 	for rows.Next() {
+		var id int64
 		var mname, mvalue, sqlTimeStr string
-		err := rows.Scan(&sqlTimeStr, &mname, &mvalue)
+		err := rows.Scan(&id, &sqlTimeStr, &mname, &mvalue)
 		if err != nil {
 			klog.Errorf("Error scanning log: %v", err)
 			continue
@@ -191,14 +203,15 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
 			continue
 		}
-		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
-		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
-			TimeStamp: timeStamp,
-			Metric: &v1beta1.Metric{
-				Name:  mname,
-				Value: mvalue,
-			},
-		})
+		row := &common.ObservationLogRow{
+			ID:         id,
+			Time:       ptime,
+			MetricName: mname,
+			Value:      mvalue,
+		}
+		if !pager.Add(row) {
+			break
+		}
 	}
-	return result, nil
+	return pager.Result()
 }
@@ -19,6 +19,7 @@ package mysql
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -101,30 +102,86 @@ func TestRegisterObservationLog(t *testing.T) {
 
 func TestGetObservationLog(t *testing.T) {
 	mock.ExpectQuery("SELECT").WillReturnRows(
-		sqlmock.NewRows([]string{"time", "metric_name", "value"}).AddRow(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value"}).AddRow(
+			1,
 			"2016-12-31 21:02:05.123456",
 			"loss",
 			"0.9",
 		).AddRow(
+			2,
 			"2016-12-31 22:02:05.123456",
 			"loss",
 			"0.9",
 		),
 	)
-	obsLog, err := dbInterface.GetObservationLog(
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog(
 		"test1_trial1",
 		"loss",
 		"2016-12-31T21:01:05.123456Z",
 		"2016-12-31T22:10:20.123456Z",
+		nil,
 	)
 	if err != nil {
 		t.Errorf("GetObservationLog failed %v", err)
 	} else if len(obsLog.MetricLogs) != 2 {
 		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	} else if nextPageToken != "" {
+		t.Errorf("GetObservationLog incorrect next page token %v", nextPageToken)
 	}
 
 }
 
+func TestGetObservationLogPage(t *testing.T) {
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = ? ORDER BY time, id LIMIT 2"),
+	).WithArgs("test1_trial1").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value"}).AddRow(
+			1,
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+		).AddRow(
+			2,
+			"2016-12-31 22:02:05.123456",
+			"loss",
+			"0.8",
+		),
+	)
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog("test1_trial1", "", "", "", &common.ObservationLogOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	} else if len(obsLog.MetricLogs) != 1 || nextPageToken == "" {
+		t.Fatalf("GetObservationLog incorrect return %v, next page token %v", obsLog, nextPageToken)
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = ? AND (time > ? OR (time = ? AND id > ?)) ORDER BY time, id LIMIT 2"),
+	).WithArgs(
+		"test1_trial1",
+		"2016-12-31 21:02:05.123456",
+		"2016-12-31 21:02:05.123456",
+		1,
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value"}).AddRow(
+			2,
+			"2016-12-31 22:02:05.123456",
+			"loss",
+			"0.8",
+		),
+	)
+	obsLog, nextPageToken, err = dbInterface.GetObservationLog("test1_trial1", "", "", "", &common.ObservationLogOptions{
+		PageSize:  1,
+		PageToken: nextPageToken,
+	})
+	if err != nil {
+		t.Errorf("GetObservationLog failed %v", err)
+	} else if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Metric.Value != "0.8" {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	} else if nextPageToken != "" {
+		t.Errorf("GetObservationLog incorrect next page token %v", nextPageToken)
+	}
+}
+
 func TestDeleteObservationLog(t *testing.T) {
 	trialName := "test1_trial1"
 
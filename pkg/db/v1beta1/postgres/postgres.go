@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+)
+
+const (
+	dbDriver        = "postgres"
+	dbNameTmpl      = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s connect_timeout=5"
+	postgresTimeFmt = "2006-01-02 15:04:05.999999"
+
+	connectInterval = 5 * time.Second
+	connectTimeout  = 60 * time.Second
+)
+
+type dbConn struct {
+	db *sql.DB
+}
+
+func getDbName() string {
+	dbPassEnvName := common.DBPasswordEnvName
+	dbPass := os.Getenv(dbPassEnvName)
+	dbUser := env.GetEnvOrDefault(
+		common.DBUserEnvName, common.DefaultPostgreSQLUser)
+	dbHost := env.GetEnvOrDefault(
+		common.PostgreSQLDBHostEnvName, common.DefaultPostgreSQLHost)
+	dbPort := env.GetEnvOrDefault(
+		common.PostgreSQLDBPortEnvName, common.DefaultPostgreSQLPort)
+	dbName := env.GetEnvOrDefault(common.PostgreSQLDatabase,
+		common.DefaultPostgreSQLDatabase)
+	sslMode := env.GetEnvOrDefault(common.PostgreSQLSSLMode,
+		common.DefaultPostgreSQLSSLMode)
+
+	return fmt.Sprintf(dbNameTmpl, dbHost, dbPort, dbUser, quoteConnValue(dbPass), dbName, sslMode)
+}
+
+// quoteConnValue quotes a value of the libpq key/value connection string,
+// so that empty passwords or passwords with spaces are passed as is.
+func quoteConnValue(v string) string {
+	quoted := "'"
+	for _, c := range v {
+		if c == '\'' || c == '\\' {
+			quoted += "\\"
+		}
+		quoted += string(c)
+	}
+	return quoted + "'"
+}
+
+func openSQLConn(driverName string, dataSourceName string, interval time.Duration,
+	timeout time.Duration) (*sql.DB, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	timeoutC := time.After(timeout)
+	for {
+		select {
+		case <-ticker.C:
+			if db, err := sql.Open(driverName, dataSourceName); err == nil {
+				if err = db.Ping(); err == nil {
+					return db, nil
+				}
+				klog.Errorf("Ping to Katib db failed: %v", err)
+			} else {
+				klog.Errorf("Open sql connection failed: %v", err)
+			}
+		case <-timeoutC:
+			return nil, fmt.Errorf("Timeout waiting for DB conn successfully opened.")
+		}
+	}
+}
+
+func NewWithSQLConn(db *sql.DB) (common.KatibDBInterface, error) {
+	d := new(dbConn)
+	d.db = db
+	return d, nil
+}
+
+func NewDBInterface() (common.KatibDBInterface, error) {
+	db, err := openSQLConn(dbDriver, getDbName(), connectInterval, connectTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("DB open failed: %v", err)
+	}
+	return NewWithSQLConn(db)
+}
+
+func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+	values := []interface{}{}
+
+	for _, mlog := range observationLog.MetricLogs {
+		if mlog.TimeStamp == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, mlog.TimeStamp)
+		if err != nil {
+			return fmt.Errorf("Error parsing start time %s: %v", mlog.TimeStamp, err)
+		}
+		sqlTimeStr := t.UTC().Format(postgresTimeFmt)
+
+		n := len(values)
+		sqlQuery += fmt.Sprintf("($%d, $%d, $%d, $%d),", n+1, n+2, n+3, n+4)
+		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
+	}
+	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
+
+	// Prepare the statement
+	stmt, err := d.db.Prepare(sqlQuery)
+	if err != nil {
+		return fmt.Errorf("Pepare SQL statement failed: %v", err)
+	}
+	defer stmt.Close()
+
+	// Execute INSERT
+	_, err = stmt.Exec(values...)
+	if err != nil {
+		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *dbConn) DeleteObservationLog(trialName string) error {
+	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1", trialName)
+	return err
+}
+
+func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string, opts *common.ObservationLogOptions) (*v1beta1.ObservationLog, string, error) {
+	pager, err := common.NewObservationLogPager(opts)
+	if err != nil {
+		return nil, "", err
+	}
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if metricName != "" {
+		qfield = append(qfield, metricName)
+		qstr += fmt.Sprintf(" AND metric_name = $%d", len(qfield))
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedStartTime)
+		qstr += fmt.Sprintf(" AND time >= $%d", len(qfield))
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedEndTime)
+		qstr += fmt.Sprintf(" AND time <= $%d", len(qfield))
+	}
+	if cursor := pager.Cursor(); cursor != nil {
+		formattedCursorTime := cursor.Time.UTC().Format(postgresTimeFmt)
+		qfield = append(qfield, formattedCursorTime, cursor.ID)
+		qstr += fmt.Sprintf(" AND (time > $%d OR (time = $%d AND id > $%d))", len(qfield)-1, len(qfield)-1, len(qfield))
+	}
+	qstr += " ORDER BY time, id"
+	if limit := pager.Limit(); limit > 0 {
+		qstr += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := d.db.Query("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = $1"+qstr,
+		qfield...)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var mname, mvalue string
+		// TIMESTAMP columns are returned as time.Time by lib/pq.
+		var ptime time.Time
+		err := rows.Scan(&id, &ptime, &mname, &mvalue)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		row := &common.ObservationLogRow{
+			ID:         id,
+			Time:       ptime,
+			MetricName: mname,
+			Value:      mvalue,
+		}
+		if !pager.Add(row) {
+			break
+		}
+	}
+	return pager.Result()
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+var dbInterface common.KatibDBInterface
+var mock sqlmock.Sqlmock
+
+func TestMain(m *testing.M) {
+	db, sm, err := sqlmock.New()
+	mock = sm
+	if err != nil {
+		fmt.Printf("error opening db: %v\n", err)
+		os.Exit(1)
+	}
+	dbInterface, err = NewWithSQLConn(db)
+	if err != nil {
+		fmt.Printf("error NewWithSQLConn: %v\n", err)
+	}
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	dbInterface.DBInit()
+	mock.ExpectExec("SELECT 1").WillReturnResult(sqlmock.NewResult(0, 0))
+	err = dbInterface.SelectOne()
+	if err != nil {
+		fmt.Printf("error `SELECT 1` probing: %v\n", err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestRegisterObservationLog(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "f1_score",
+					Value: "88.95",
+				},
+			},
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+		},
+	}
+	query := regexp.QuoteMeta("INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES ($1, $2, $3, $4),($5, $6, $7, $8)")
+	mock.ExpectPrepare(query)
+	mock.ExpectExec(
+		query,
+	).WithArgs(
+		"test1_trial1",
+		"2016-12-31 20:02:05.123456",
+		"f1_score",
+		"88.95",
+		"test1_trial1",
+		"2016-12-31 20:02:05.123456",
+		"loss",
+		"0.5",
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog)
+	if err != nil {
+		t.Errorf("RegisterExperiment failed: %v", err)
+	}
+}
+
+func TestGetObservationLog(t *testing.T) {
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = $1 AND metric_name = $2 AND time >= $3 AND time <= $4 ORDER BY time, id"),
+	).WithArgs(
+		"test1_trial1",
+		"loss",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value"}).AddRow(
+			1,
+			time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+		).AddRow(
+			2,
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+		),
+	)
+	obsLog, _, err := dbInterface.GetObservationLog(
+		"test1_trial1",
+		"loss",
+		"2016-12-31T21:01:05.123456Z",
+		"2016-12-31T22:10:20.123456Z",
+		nil,
+	)
+	if err != nil {
+		t.Errorf("GetObservationLog failed %v", err)
+	} else if len(obsLog.MetricLogs) != 2 {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	} else if obsLog.MetricLogs[0].TimeStamp != "2016-12-31T21:02:05.123456Z" {
+		t.Errorf("GetObservationLog incorrect timestamp %v", obsLog.MetricLogs[0].TimeStamp)
+	}
+}
+
+func TestGetObservationLogPage(t *testing.T) {
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = $1 AND (time > $2 OR (time = $2 AND id > $3)) ORDER BY time, id LIMIT 3"),
+	).WithArgs(
+		"test1_trial1",
+		"2016-12-31 21:02:05.123456",
+		5,
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value"}).AddRow(
+			6,
+			time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC),
+			"loss",
+			"0.9",
+		),
+	)
+	// Build the token of a page which ends at the row with id 5.
+	pager, _ := common.NewObservationLogPager(&common.ObservationLogOptions{PageSize: 1})
+	pager.Add(&common.ObservationLogRow{ID: 5, Time: time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC)})
+	pager.Add(&common.ObservationLogRow{ID: 6, Time: time.Date(2016, 12, 31, 22, 2, 5, 123456000, time.UTC)})
+	_, pageToken, _ := pager.Result()
+
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog("test1_trial1", "", "", "", &common.ObservationLogOptions{
+		PageSize:  2,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		t.Errorf("GetObservationLog failed %v", err)
+	} else if len(obsLog.MetricLogs) != 1 || nextPageToken != "" {
+		t.Errorf("GetObservationLog incorrect return %v, next page token %v", obsLog, nextPageToken)
+	}
+}
+
+func TestDeleteObservationLog(t *testing.T) {
+	trialName := "test1_trial1"
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("DELETE FROM observation_logs WHERE trial_name = $1"),
+	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.DeleteObservationLog(trialName)
+	if err != nil {
+		t.Errorf("DeleteObservationLog failed: %v", err)
+	}
+}
+
+func TestGetDbName(t *testing.T) {
+	dbName := "host=katib-postgres port=5432 user=katib password='' dbname=katib sslmode=disable connect_timeout=5"
+
+	if getDbName() != dbName {
+		t.Errorf("getDbName returns wrong value %v", getDbName())
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog"
+	_ "modernc.org/sqlite"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+)
+
+const (
+	dbDriver = "sqlite"
+	// SQLite has no native time type, time is stored as TEXT.
+	// Fractional seconds are zero padded, so that the lexicographical order
+	// of the column is the same as the chronological one.
+	sqliteTimeFmt = "2006-01-02 15:04:05.000000"
+)
+
+type dbConn struct {
+	db *sql.DB
+}
+
+func getDbPath() string {
+	return env.GetEnvOrDefault(common.SQLiteDBPathEnvName, common.DefaultSQLiteDBPath)
+}
+
+func NewWithSQLConn(db *sql.DB) (common.KatibDBInterface, error) {
+	// SQLite allows only one writer at a time and every connection to
+	// ":memory:" opens a separate database, so the pool is limited to one connection.
+	db.SetMaxOpenConns(1)
+	d := new(dbConn)
+	d.db = db
+	return d, nil
+}
+
+func NewDBInterface() (common.KatibDBInterface, error) {
+	dbPath := getDbPath()
+	if dir := filepath.Dir(dbPath); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("Failed to create directory for DB file %s: %v", dbPath, err)
+		}
+	}
+	db, err := sql.Open(dbDriver, dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("DB open failed: %v", err)
+	}
+	if err = db.Ping(); err != nil {
+		return nil, fmt.Errorf("Ping to Katib db failed: %v", err)
+	}
+	return NewWithSQLConn(db)
+}
+
+func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+	values := []interface{}{}
+
+	for _, mlog := range observationLog.MetricLogs {
+		if mlog.TimeStamp == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, mlog.TimeStamp)
+		if err != nil {
+			return fmt.Errorf("Error parsing start time %s: %v", mlog.TimeStamp, err)
+		}
+		sqlTimeStr := t.UTC().Format(sqliteTimeFmt)
+
+		sqlQuery += "(?, ?, ?, ?),"
+		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
+
+	// Prepare the statement
+	stmt, err := d.db.Prepare(sqlQuery)
+	if err != nil {
+		return fmt.Errorf("Pepare SQL statement failed: %v", err)
+	}
+	defer stmt.Close()
+
+	// Execute INSERT
+	_, err = stmt.Exec(values...)
+	if err != nil {
+		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	}
+
+	return nil
+}
+
+func (d *dbConn) DeleteObservationLog(trialName string) error {
+	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ?", trialName)
+	return err
+}
+
+func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string, opts *common.ObservationLogOptions) (*v1beta1.ObservationLog, string, error) {
+	pager, err := common.NewObservationLogPager(opts)
+	if err != nil {
+		return nil, "", err
+	}
+	qfield := []interface{}{trialName}
+	qstr := ""
+	if metricName != "" {
+		qstr += " AND metric_name = ?"
+		qfield = append(qfield, metricName)
+	}
+	if startTime != "" {
+		s_time, err := time.Parse(time.RFC3339Nano, startTime)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+		}
+		formattedStartTime := s_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time >= ?"
+		qfield = append(qfield, formattedStartTime)
+	}
+	if endTime != "" {
+		e_time, err := time.Parse(time.RFC3339Nano, endTime)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+		}
+		formattedEndTime := e_time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND time <= ?"
+		qfield = append(qfield, formattedEndTime)
+	}
+	if cursor := pager.Cursor(); cursor != nil {
+		formattedCursorTime := cursor.Time.UTC().Format(sqliteTimeFmt)
+		qstr += " AND (time > ? OR (time = ? AND id > ?))"
+		qfield = append(qfield, formattedCursorTime, formattedCursorTime, cursor.ID)
+	}
+	qstr += " ORDER BY time, id"
+	if limit := pager.Limit(); limit > 0 {
+		qstr += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := d.db.Query("SELECT id, time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr,
+		qfield...)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to get ObservationLogs %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var mname, mvalue, sqlTimeStr string
+		err := rows.Scan(&id, &sqlTimeStr, &mname, &mvalue)
+		if err != nil {
+			klog.Errorf("Error scanning log: %v", err)
+			continue
+		}
+		ptime, err := time.Parse(sqliteTimeFmt, sqlTimeStr)
+		if err != nil {
+			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
+			continue
+		}
+		row := &common.ObservationLogRow{
+			ID:         id,
+			Time:       ptime,
+			MetricName: mname,
+			Value:      mvalue,
+		}
+		if !pager.Add(row) {
+			break
+		}
+	}
+	return pager.Result()
+}
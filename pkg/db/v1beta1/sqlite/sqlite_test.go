@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+var dbInterface common.KatibDBInterface
+
+func TestMain(m *testing.M) {
+	// The driver is pure Go, so tests run against a real in-memory database.
+	db, err := sql.Open(dbDriver, ":memory:")
+	if err != nil {
+		fmt.Printf("error opening db: %v\n", err)
+		os.Exit(1)
+	}
+	dbInterface, err = NewWithSQLConn(db)
+	if err != nil {
+		fmt.Printf("error NewWithSQLConn: %v\n", err)
+	}
+	dbInterface.DBInit()
+	err = dbInterface.SelectOne()
+	if err != nil {
+		fmt.Printf("error `SELECT 1` probing: %v\n", err)
+	}
+	os.Exit(m.Run())
+}
+
+func newMetricLog(timeStamp, name, value string) *api_pb.MetricLog {
+	return &api_pb.MetricLog{
+		TimeStamp: timeStamp,
+		Metric: &api_pb.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestObservationLog(t *testing.T) {
+	trialName := "test1_trial1"
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+			newMetricLog("2016-12-31T21:02:05.5Z", "loss", "0.4"),
+			newMetricLog("2016-12-31T22:02:05Z", "loss", "0.3"),
+			newMetricLog("", "loss", "0.1"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog(trialName, obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	otherLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T21:02:05Z", "loss", "0.7"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test1_trial2", otherLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	testCases := []struct {
+		metricName string
+		startTime  string
+		endTime    string
+		expected   []*api_pb.MetricLog
+		testDesc   string
+	}{
+		{
+			expected: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+				newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+				newMetricLog("2016-12-31T21:02:05.5Z", "loss", "0.4"),
+				newMetricLog("2016-12-31T22:02:05Z", "loss", "0.3"),
+			},
+			testDesc: "All metrics",
+		},
+		{
+			metricName: "loss",
+			startTime:  "2016-12-31T20:02:05.2Z",
+			endTime:    "2016-12-31T22:02:05Z",
+			expected: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T21:02:05.5Z", "loss", "0.4"),
+				newMetricLog("2016-12-31T22:02:05Z", "loss", "0.3"),
+			},
+			testDesc: "Metric name and time range",
+		},
+		{
+			endTime: "2016-12-31T21:00:00Z",
+			expected: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+				newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+			},
+			testDesc: "End time only",
+		},
+	}
+	for _, tc := range testCases {
+		obsLog, _, err := dbInterface.GetObservationLog(trialName, tc.metricName, tc.startTime, tc.endTime, nil)
+		if err != nil {
+			t.Errorf("Case: %v. GetObservationLog failed: %v", tc.testDesc, err)
+			continue
+		}
+		if len(obsLog.MetricLogs) != len(tc.expected) {
+			t.Errorf("Case: %v. Expected %v logs, got %v", tc.testDesc, len(tc.expected), obsLog.MetricLogs)
+			continue
+		}
+		for i, l := range obsLog.MetricLogs {
+			e := tc.expected[i]
+			if l.TimeStamp != e.TimeStamp || l.Metric.Name != e.Metric.Name || l.Metric.Value != e.Metric.Value {
+				t.Errorf("Case: %v. Expected log %v, got %v", tc.testDesc, e, l)
+			}
+		}
+	}
+
+	if _, _, err := dbInterface.GetObservationLog(trialName, "", "invalid", "", nil); err == nil {
+		t.Errorf("Expected error for invalid start time")
+	}
+
+	if err := dbInterface.DeleteObservationLog(trialName); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	obsLog, _, err := dbInterface.GetObservationLog(trialName, "", "", "", nil)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(obsLog.MetricLogs) != 0 {
+		t.Errorf("Expected no logs after delete, got %v", obsLog.MetricLogs)
+	}
+	obsLog, _, err = dbInterface.GetObservationLog("test1_trial2", "", "", "", nil)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 {
+		t.Errorf("Expected logs of other trials to be kept, got %v", obsLog.MetricLogs)
+	}
+}
+
+func TestObservationLogPages(t *testing.T) {
+	trialName := "test2_trial1"
+	obsLog := &api_pb.ObservationLog{}
+	// Two metric logs per second, the first one of every second has the same time stamp.
+	for i := 0; i < 10; i++ {
+		obsLog.MetricLogs = append(obsLog.MetricLogs,
+			newMetricLog(fmt.Sprintf("2016-12-31T20:02:%02dZ", i), "loss", fmt.Sprint(i)),
+			newMetricLog(fmt.Sprintf("2016-12-31T20:02:%02d.5Z", i), "loss", fmt.Sprint(i+1)),
+		)
+	}
+	obsLog.MetricLogs = append(obsLog.MetricLogs, newMetricLog("2016-12-31T20:02:00Z", "accuracy", "0.5"))
+	if err := dbInterface.RegisterObservationLog(trialName, obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	testCases := []struct {
+		metricName string
+		opts       *common.ObservationLogOptions
+		expected   []string
+		testDesc   string
+	}{
+		{
+			metricName: "loss",
+			opts:       &common.ObservationLogOptions{PageSize: 7},
+			expected:   []string{"0", "1", "1", "2", "2", "3", "3", "4", "4", "5", "5", "6", "6", "7", "7", "8", "8", "9", "9", "10"},
+			testDesc:   "Pages without downsampling",
+		},
+		{
+			opts:     &common.ObservationLogOptions{PageSize: 2},
+			expected: []string{"0", "0.5", "1", "1", "2", "2", "3", "3", "4", "4", "5", "5", "6", "6", "7", "7", "8", "8", "9", "9", "10"},
+			testDesc: "Pages with the same time stamp",
+		},
+		{
+			metricName: "loss",
+			opts: &common.ObservationLogOptions{
+				PageSize: 2,
+				Downsample: &api_pb.DownsampleSpec{
+					Type:     api_pb.DownsampleType_EVERY_NTH,
+					EveryNth: 3,
+				},
+			},
+			expected: []string{"0", "2", "3", "5", "6", "8", "9"},
+			testDesc: "Every Nth",
+		},
+		{
+			metricName: "loss",
+			opts: &common.ObservationLogOptions{
+				PageSize: 2,
+				Downsample: &api_pb.DownsampleSpec{
+					Type:           api_pb.DownsampleType_BUCKET_MEAN,
+					BucketDuration: "4s",
+				},
+			},
+			expected: []string{"2", "6", "9"},
+			testDesc: "Bucket mean",
+		},
+		{
+			opts: &common.ObservationLogOptions{
+				PageSize: 1,
+				Downsample: &api_pb.DownsampleSpec{
+					Type:           api_pb.DownsampleType_BUCKET_MAX,
+					BucketDuration: "5s",
+				},
+			},
+			expected: []string{"0.5", "5", "10"},
+			testDesc: "Bucket max with several metrics",
+		},
+	}
+	for _, tc := range testCases {
+		values := []string{}
+		pageToken := ""
+		for {
+			tc.opts.PageToken = pageToken
+			page, nextPageToken, err := dbInterface.GetObservationLog(trialName, tc.metricName, "", "", tc.opts)
+			if err != nil {
+				t.Fatalf("Case: %v. GetObservationLog failed: %v", tc.testDesc, err)
+			}
+			for _, l := range page.MetricLogs {
+				values = append(values, l.Metric.Value)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		if !reflect.DeepEqual(values, tc.expected) {
+			t.Errorf("Case: %v. Expected values %v, got %v", tc.testDesc, tc.expected, values)
+		}
+	}
+}
+
+func TestNewDBInterface(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "data", "katib.db")
+	os.Setenv(common.SQLiteDBPathEnvName, dbPath)
+	defer os.Unsetenv(common.SQLiteDBPathEnvName)
+
+	dbIf, err := NewDBInterface()
+	if err != nil {
+		t.Fatalf("NewDBInterface failed: %v", err)
+	}
+	dbIf.DBInit()
+	if _, err := os.Stat(dbPath); err != nil {
+		t.Errorf("Expected DB file %v to be created: %v", dbPath, err)
+	}
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package earlystopping_asha_v1beta1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	escommon "github.com/kubeflow/katib/pkg/earlystopping/v1beta1/common"
+)
+
+const (
+	AlgorithmASHA = "asha"
+
+	settingMinResource     = "min_resource"
+	settingReductionFactor = "reduction_factor"
+	settingMaxRungs        = "max_rungs"
+
+	defaultMinResource     = 1
+	defaultReductionFactor = 3
+	defaultMaxRungs        = 4
+)
+
+// getObservationLog is the function to get metric logs from Katib DB manager.
+// It is replaced in the unit tests.
+var getObservationLog = common.GetObservationLog
+
+// EarlyStoppingService implements the asynchronous successive halving algorithm (ASHA).
+// Rung k is reached after min_resource * reduction_factor^k reported metrics. Trial is stopped at the rung,
+// if its best objective value is not in the top 1/reduction_factor of the completed Trials at this rung.
+//
+// Metrics collector applies one rule for each metric, so each Trial gets the rule of the next rung,
+// which it has not reached yet and which has enough completed Trials to compute the threshold.
+// Metrics collector refreshes the rule during training, so the Trial moves to the next rungs.
+type EarlyStoppingService struct {
+	mu sync.Mutex
+	// Client to update Trial status in the namespace of the service.
+	client    client.Client
+	namespace string
+	// Completed Trial name -> best objective values at the reached rungs.
+	trialsRungHistory map[string][]float64
+}
+
+// NewEarlyStoppingService returns the ASHA service which sets status of Trials in the namespace.
+func NewEarlyStoppingService(c client.Client, namespace string) *EarlyStoppingService {
+	return &EarlyStoppingService{
+		client:            c,
+		namespace:         namespace,
+		trialsRungHistory: make(map[string][]float64),
+	}
+}
+
+type ashaSettings struct {
+	minResource     int
+	reductionFactor int
+	maxRungs        int
+}
+
+// rungSteps returns the number of reported metrics to reach the rung.
+func (a *ashaSettings) rungSteps(rung int) int {
+	steps := a.minResource
+	for i := 0; i < rung; i++ {
+		steps *= a.reductionFactor
+	}
+	return steps
+}
+
+// GetEarlyStoppingRules returns the rule of the next rung with at least reduction_factor completed Trials
+// for each Trial of the request. The common rule is the rule of the new Trial, which has not reported metrics yet.
+// Request without the Experiment refreshes the rule of the running Trial, so it moves to the next rungs.
+func (s *EarlyStoppingService) GetEarlyStoppingRules(
+	ctx context.Context,
+	req *api_v1_beta1.GetEarlyStoppingRulesRequest,
+) (*api_v1_beta1.GetEarlyStoppingRulesReply, error) {
+	experiment, trials, err := escommon.GetExperiment(ctx, s.client, s.namespace, req)
+	if err != nil {
+		klog.Errorf("Failed to get Experiment: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	settings, err := parseSettings(experiment.GetSpec().GetEarlyStopping().GetAlgorithmSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objective := experiment.GetSpec().GetObjective()
+	isMaximize := objective.GetType() == api_v1_beta1.ObjectiveType_MAXIMIZE
+
+	rungValues, err := s.getRungValues(trials, objective.GetObjectiveMetricName(), isMaximize, settings)
+	if err != nil {
+		klog.Errorf("Failed to get rung values: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Rule of each rung, it is nil if the rung doesn't have enough completed Trials.
+	rungRules := make([]*api_v1_beta1.EarlyStoppingRule, len(rungValues))
+	for rung, values := range rungValues {
+		threshold, ok := getThreshold(values, settings.reductionFactor, isMaximize)
+		if !ok {
+			continue
+		}
+		comparison := api_v1_beta1.ComparisonType_GREATER
+		if isMaximize {
+			comparison = api_v1_beta1.ComparisonType_LESS
+		}
+		rungRules[rung] = &api_v1_beta1.EarlyStoppingRule{
+			Name:       objective.GetObjectiveMetricName(),
+			Value:      strconv.FormatFloat(threshold, 'f', -1, 64),
+			Comparison: comparison,
+			StartStep:  int32(settings.rungSteps(rung)),
+		}
+	}
+
+	reply := &api_v1_beta1.GetEarlyStoppingRulesReply{
+		EarlyStoppingRules: getNextRungRules(rungRules, 0),
+	}
+	for _, trialName := range req.GetTrialNames() {
+		steps, err := getReportedSteps(trials, trialName, objective.GetObjectiveMetricName(), settings)
+		if err != nil {
+			klog.Errorf("Failed to get reported steps: %v", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.TrialEarlyStoppingRules = append(reply.TrialEarlyStoppingRules,
+			&api_v1_beta1.GetEarlyStoppingRulesReply_TrialEarlyStoppingRules{
+				TrialName:          trialName,
+				EarlyStoppingRules: getNextRungRules(rungRules, steps),
+			})
+	}
+	klog.Infof("New early stopping rules are: %v", reply)
+
+	return reply, nil
+}
+
+// getNextRungRules returns the rule of the first rung, which is reached after more than the reported steps.
+// Empty rules are returned if the next rungs don't have enough completed Trials.
+func getNextRungRules(rungRules []*api_v1_beta1.EarlyStoppingRule, steps int) []*api_v1_beta1.EarlyStoppingRule {
+	for _, rule := range rungRules {
+		if rule != nil && int(rule.StartStep) > steps {
+			return []*api_v1_beta1.EarlyStoppingRule{rule}
+		}
+	}
+	return []*api_v1_beta1.EarlyStoppingRule{}
+}
+
+// getReportedSteps returns the number of the objective metrics, which are reported by the running Trial.
+// New Trials, which are not created yet, don't have the reported metrics.
+func getReportedSteps(trials []*api_v1_beta1.Trial, trialName string, metricName string, settings *ashaSettings) (int, error) {
+	isRunning := false
+	for _, trial := range trials {
+		if trial.GetName() == trialName {
+			isRunning = trial.GetStatus().GetCondition() == api_v1_beta1.TrialStatus_RUNNING
+			break
+		}
+	}
+	if !isRunning {
+		return 0, nil
+	}
+	// The last rung is reached after maxSteps metrics, so the Trial doesn't get the rules after it.
+	reply, err := getObservationLog(&api_v1_beta1.GetObservationLogRequest{
+		TrialName:  trialName,
+		MetricName: metricName,
+		PageSize:   int32(settings.rungSteps(settings.maxRungs - 1)),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get observation log of Trial %v: %v", trialName, err)
+	}
+	return len(reply.GetObservationLog().GetMetricLogs()), nil
+}
+
+// getRungValues returns the best objective values of the completed Trials for each rung.
+func (s *EarlyStoppingService) getRungValues(
+	trials []*api_v1_beta1.Trial,
+	metricName string,
+	isMaximize bool,
+	settings *ashaSettings,
+) ([][]float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maxSteps := settings.rungSteps(settings.maxRungs - 1)
+	for _, trial := range trials {
+		condition := trial.GetStatus().GetCondition()
+		if condition != api_v1_beta1.TrialStatus_SUCCEEDED && condition != api_v1_beta1.TrialStatus_EARLYSTOPPED {
+			continue
+		}
+		if _, ok := s.trialsRungHistory[trial.GetName()]; ok {
+			continue
+		}
+		// Metrics are ordered by time, so the first page contains the metrics of all rungs.
+		reply, err := getObservationLog(&api_v1_beta1.GetObservationLogRequest{
+			TrialName:  trial.GetName(),
+			MetricName: metricName,
+			PageSize:   int32(maxSteps),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get observation log of Trial %v: %v", trial.GetName(), err)
+		}
+
+		// Metrics collector compares the best reported value with the rule, so the same value is stored for the rung.
+		values := []float64{}
+		var best float64
+		for i, log := range reply.GetObservationLog().GetMetricLogs() {
+			if i >= maxSteps {
+				break
+			}
+			v, err := strconv.ParseFloat(log.GetMetric().GetValue(), 64)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to parse metric %v of Trial %v: %v", metricName, trial.GetName(), err)
+			}
+			if i == 0 || (isMaximize && v > best) || (!isMaximize && v < best) {
+				best = v
+			}
+			if i+1 == settings.rungSteps(len(values)) {
+				values = append(values, best)
+			}
+		}
+		s.trialsRungHistory[trial.GetName()] = values
+		klog.Infof("Adding new completed Trial: %v with rung values: %v", trial.GetName(), values)
+	}
+
+	rungValues := make([][]float64, settings.maxRungs)
+	for _, values := range s.trialsRungHistory {
+		for rung, v := range values {
+			rungValues[rung] = append(rungValues[rung], v)
+		}
+	}
+	return rungValues, nil
+}
+
+// getThreshold returns the worst value of the top 1/reductionFactor values.
+// It returns false if there are less than reductionFactor values.
+func getThreshold(values []float64, reductionFactor int, isMaximize bool) (float64, bool) {
+	top := len(values) / reductionFactor
+	if top == 0 {
+		return 0, false
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	if isMaximize {
+		sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+	} else {
+		sort.Float64s(sorted)
+	}
+	return sorted[top-1], true
+}
+
+// SetTrialStatus adds the EarlyStopped condition to the Trial.
+func (s *EarlyStoppingService) SetTrialStatus(
+	ctx context.Context,
+	req *api_v1_beta1.SetTrialStatusRequest,
+) (*api_v1_beta1.SetTrialStatusReply, error) {
+	trialName := req.GetTrialName()
+	klog.Infof("Update status for Trial: %v", trialName)
+
+	if err := escommon.MarkTrialEarlyStopped(ctx, s.client, s.namespace, trialName); err != nil {
+		klog.Errorf("Update status for Trial: %v in namespace: %v failed: %v", trialName, s.namespace, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	klog.Infof("Changed status to: %v for Trial: %v in namespace: %v", trialsv1beta1.TrialEarlyStopped, trialName, s.namespace)
+	return &api_v1_beta1.SetTrialStatusReply{}, nil
+}
+
+// ValidateEarlyStoppingSettings validates the ASHA settings of the Experiment.
+func (s *EarlyStoppingService) ValidateEarlyStoppingSettings(
+	ctx context.Context,
+	req *api_v1_beta1.ValidateEarlyStoppingSettingsRequest,
+) (*api_v1_beta1.ValidateEarlyStoppingSettingsReply, error) {
+	if err := ValidateSettings(req.GetExperiment().GetSpec().GetEarlyStopping().GetAlgorithmSettings()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &api_v1_beta1.ValidateEarlyStoppingSettingsReply{}, nil
+}
+
+// ValidateSettings returns error if the ASHA settings are invalid.
+func ValidateSettings(algorithmSettings []*api_v1_beta1.EarlyStoppingSetting) error {
+	_, err := parseSettings(algorithmSettings)
+	return err
+}
+
+func parseSettings(algorithmSettings []*api_v1_beta1.EarlyStoppingSetting) (*ashaSettings, error) {
+	settings := &ashaSettings{
+		minResource:     defaultMinResource,
+		reductionFactor: defaultReductionFactor,
+		maxRungs:        defaultMaxRungs,
+	}
+	for _, setting := range algorithmSettings {
+		var target *int
+		minValue := 1
+		switch setting.GetName() {
+		case settingMinResource:
+			target = &settings.minResource
+		case settingReductionFactor:
+			target = &settings.reductionFactor
+			minValue = 2
+		case settingMaxRungs:
+			target = &settings.maxRungs
+		default:
+			return nil, fmt.Errorf("Unknown setting %v for ASHA early stopping", setting.GetName())
+		}
+		v, err := strconv.Atoi(setting.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("%v must be an integer, got %v", setting.GetName(), setting.GetValue())
+		}
+		if v < minValue {
+			return nil, fmt.Errorf("%v must be greater than or equal to %v, got %v", setting.GetName(), minValue, v)
+		}
+		*target = v
+	}
+
+	// Page size of the observation log request must fit the number of steps of the last rung.
+	steps := settings.minResource
+	for rung := 1; rung < settings.maxRungs; rung++ {
+		if steps > math.MaxInt32/settings.reductionFactor {
+			return nil, fmt.Errorf("Number of steps of the last rung must be less than %v", math.MaxInt32)
+		}
+		steps *= settings.reductionFactor
+	}
+	return settings, nil
+}
+
+// This is a compile-time assertion to ensure that EarlyStoppingService
+// implements an api_v1_beta1.EarlyStoppingServer interface.
+var _ api_v1_beta1.EarlyStoppingServer = &EarlyStoppingService{}
@@ -0,0 +1,289 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package earlystopping_asha_v1beta1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+)
+
+// fakeDBManager returns metric logs of the Trials.
+type fakeDBManager struct {
+	metricLogs map[string][]string
+}
+
+func (f *fakeDBManager) GetObservationLog(req *api_v1_beta1.GetObservationLogRequest) (*api_v1_beta1.GetObservationLogReply, error) {
+	values, ok := f.metricLogs[req.TrialName]
+	if !ok {
+		return nil, fmt.Errorf("Trial %v not found", req.TrialName)
+	}
+	metricLogs := []*api_v1_beta1.MetricLog{}
+	for i, v := range values {
+		if req.PageSize > 0 && i >= int(req.PageSize) {
+			break
+		}
+		metricLogs = append(metricLogs, &api_v1_beta1.MetricLog{
+			TimeStamp: fmt.Sprintf("2021-01-01T00:00:%02dZ", i),
+			Metric:    &api_v1_beta1.Metric{Name: req.MetricName, Value: v},
+		})
+	}
+	return &api_v1_beta1.GetObservationLogReply{
+		ObservationLog: &api_v1_beta1.ObservationLog{MetricLogs: metricLogs},
+	}, nil
+}
+
+func newFakeExperiment(objectiveType api_v1_beta1.ObjectiveType, settings []*api_v1_beta1.EarlyStoppingSetting) *api_v1_beta1.Experiment {
+	return &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                objectiveType,
+				ObjectiveMetricName: "accuracy",
+			},
+			EarlyStopping: &api_v1_beta1.EarlyStoppingSpec{
+				AlgorithmName:     AlgorithmASHA,
+				AlgorithmSettings: settings,
+			},
+		},
+	}
+}
+
+func newFakeTrials(names ...string) []*api_v1_beta1.Trial {
+	trials := []*api_v1_beta1.Trial{}
+	for _, name := range names {
+		trials = append(trials, &api_v1_beta1.Trial{
+			Name:   name,
+			Status: &api_v1_beta1.TrialStatus{Condition: api_v1_beta1.TrialStatus_SUCCEEDED},
+		})
+	}
+	return append(trials, &api_v1_beta1.Trial{
+		Name:   "trial-running",
+		Status: &api_v1_beta1.TrialStatus{Condition: api_v1_beta1.TrialStatus_RUNNING},
+	})
+}
+
+func TestGetEarlyStoppingRules(t *testing.T) {
+	getObservationLog = (&fakeDBManager{
+		metricLogs: map[string][]string{
+			// Rungs are reached after 1, 2 and 4 metrics.
+			"trial-1": {"0.1", "0.5", "0.3", "0.6"},
+			"trial-2": {"0.2", "0.3", "0.4", "0.5"},
+			"trial-3": {"0.3", "0.2", "0.2", "0.4"},
+			"trial-4": {"0.4", "0.4"},
+			"trial-5": {"0.5"},
+			// Running Trial has reached the second rung.
+			"trial-running": {"0.5", "0.6"},
+		},
+	}).GetObservationLog
+	defer func() {
+		getObservationLog = common.GetObservationLog
+	}()
+	settings := []*api_v1_beta1.EarlyStoppingSetting{
+		{Name: "min_resource", Value: "1"},
+		{Name: "reduction_factor", Value: "2"},
+		{Name: "max_rungs", Value: "3"},
+	}
+
+	testCases := []struct {
+		objectiveType       api_v1_beta1.ObjectiveType
+		settings            []*api_v1_beta1.EarlyStoppingSetting
+		trials              []*api_v1_beta1.Trial
+		expectedRules       []*api_v1_beta1.EarlyStoppingRule
+		expectedRunningRule []*api_v1_beta1.EarlyStoppingRule
+		expectedCode        codes.Code
+		testDesc            string
+	}{
+		{
+			objectiveType:       api_v1_beta1.ObjectiveType_MAXIMIZE,
+			settings:            settings,
+			trials:              newFakeTrials("trial-1"),
+			expectedRules:       []*api_v1_beta1.EarlyStoppingRule{},
+			expectedRunningRule: []*api_v1_beta1.EarlyStoppingRule{},
+			testDesc:            "Not enough completed Trials",
+		},
+		{
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			settings:      settings,
+			trials:        newFakeTrials("trial-4", "trial-5"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.5",
+					Comparison: api_v1_beta1.ComparisonType_LESS,
+					StartStep:  1,
+				},
+			},
+			expectedRunningRule: []*api_v1_beta1.EarlyStoppingRule{},
+			testDesc:            "Rule of the first rung, running Trial has passed it",
+		},
+		{
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			settings:      settings,
+			trials:        newFakeTrials("trial-1", "trial-2", "trial-3", "trial-4", "trial-5"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.4",
+					Comparison: api_v1_beta1.ComparisonType_LESS,
+					StartStep:  1,
+				},
+			},
+			expectedRunningRule: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.6",
+					Comparison: api_v1_beta1.ComparisonType_LESS,
+					StartStep:  4,
+				},
+			},
+			testDesc: "Rules of the first and the last rungs for maximize objective",
+		},
+		{
+			objectiveType: api_v1_beta1.ObjectiveType_MINIMIZE,
+			settings:      settings,
+			trials:        newFakeTrials("trial-1", "trial-2", "trial-3", "trial-4", "trial-5"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.2",
+					Comparison: api_v1_beta1.ComparisonType_GREATER,
+					StartStep:  1,
+				},
+			},
+			expectedRunningRule: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "accuracy",
+					Value:      "0.1",
+					Comparison: api_v1_beta1.ComparisonType_GREATER,
+					StartStep:  4,
+				},
+			},
+			testDesc: "Rules of the first and the last rungs for minimize objective",
+		},
+		{
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "reduction_factor", Value: "1"},
+			},
+			trials:       newFakeTrials("trial-1"),
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Invalid reduction_factor",
+		},
+		{
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			trials:        newFakeTrials("trial-unknown"),
+			expectedCode:  codes.Internal,
+			testDesc:      "Observation log is not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := NewEarlyStoppingService(nil, "default")
+		reply, err := s.GetEarlyStoppingRules(context.TODO(), &api_v1_beta1.GetEarlyStoppingRulesRequest{
+			Experiment: newFakeExperiment(tc.objectiveType, tc.settings),
+			Trials:     tc.trials,
+			TrialNames: []string{"trial-new", "trial-running"},
+		})
+		if code := status.Code(err); code != tc.expectedCode {
+			t.Errorf("Case: %v. Expected code %v, got %v", tc.testDesc, tc.expectedCode, code)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(reply.EarlyStoppingRules, tc.expectedRules) {
+			t.Errorf("Case: %v. Expected rules %v, got %v", tc.testDesc, tc.expectedRules, reply.EarlyStoppingRules)
+		}
+		// New Trial gets the common rules, running Trial gets the rule of its next rung.
+		expectedTrialRules := map[string][]*api_v1_beta1.EarlyStoppingRule{
+			"trial-new":     tc.expectedRules,
+			"trial-running": tc.expectedRunningRule,
+		}
+		trialRules := map[string][]*api_v1_beta1.EarlyStoppingRule{}
+		for _, r := range reply.TrialEarlyStoppingRules {
+			trialRules[r.TrialName] = r.EarlyStoppingRules
+		}
+		if !reflect.DeepEqual(trialRules, expectedTrialRules) {
+			t.Errorf("Case: %v. Expected Trial rules %v, got %v", tc.testDesc, expectedTrialRules, trialRules)
+		}
+	}
+}
+
+func TestValidateEarlyStoppingSettings(t *testing.T) {
+	testCases := []struct {
+		settings     []*api_v1_beta1.EarlyStoppingSetting
+		expectedCode codes.Code
+		testDesc     string
+	}{
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "min_resource", Value: "2"},
+				{Name: "reduction_factor", Value: "4"},
+				{Name: "max_rungs", Value: "5"},
+			},
+			expectedCode: codes.OK,
+			testDesc:     "Valid settings",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "min_resource", Value: "0"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Invalid min_resource",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "max_rungs", Value: "many"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Invalid max_rungs",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "reduction_factor", Value: "10"},
+				{Name: "max_rungs", Value: "20"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Too many steps in the last rung",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "eta", Value: "3"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Unknown setting",
+		},
+	}
+
+	s := NewEarlyStoppingService(nil, "default")
+	for _, tc := range testCases {
+		_, err := s.ValidateEarlyStoppingSettings(context.TODO(), &api_v1_beta1.ValidateEarlyStoppingSettingsRequest{
+			Experiment: newFakeExperiment(api_v1_beta1.ObjectiveType_MAXIMIZE, tc.settings),
+		})
+		if code := status.Code(err); code != tc.expectedCode {
+			t.Errorf("Case: %v. Expected code %v, got %v", tc.testDesc, tc.expectedCode, code)
+		}
+	}
+}
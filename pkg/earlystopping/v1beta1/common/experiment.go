@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// GetExperiment returns the Experiment and the Trials to compute the early stopping rules.
+// Suggestion controller sends the Experiment and the Trials in the request. Metrics collectors refresh
+// the rules of the running Trial only with the Trial name, so the Experiment and its current Trials
+// are got from the Kubernetes API. It keeps the rules up to date after the service is restarted.
+func GetExperiment(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	req *api_v1_beta1.GetEarlyStoppingRulesRequest,
+) (*api_v1_beta1.Experiment, []*api_v1_beta1.Trial, error) {
+	if req.GetExperiment() != nil {
+		return req.GetExperiment(), req.GetTrials(), nil
+	}
+	if len(req.GetTrialNames()) == 0 {
+		return nil, nil, fmt.Errorf("Experiment or Trial names must be set in the request")
+	}
+
+	trial := &trialsv1beta1.Trial{}
+	if err := c.Get(ctx, types.NamespacedName{Name: req.GetTrialNames()[0], Namespace: namespace}, trial); err != nil {
+		return nil, nil, fmt.Errorf("Failed to get Trial %v: %v", req.GetTrialNames()[0], err)
+	}
+	experimentName := trial.Labels[consts.LabelExperimentName]
+	experiment := &experimentsv1beta1.Experiment{}
+	if err := c.Get(ctx, types.NamespacedName{Name: experimentName, Namespace: namespace}, experiment); err != nil {
+		return nil, nil, fmt.Errorf("Failed to get Experiment %v: %v", experimentName, err)
+	}
+	trials := &trialsv1beta1.TrialList{}
+	if err := c.List(ctx, trials, client.InNamespace(namespace), client.MatchingLabels{consts.LabelExperimentName: experimentName}); err != nil {
+		return nil, nil, fmt.Errorf("Failed to list Trials of Experiment %v: %v", experimentName, err)
+	}
+	return convertExperiment(experiment), convertTrials(trials.Items), nil
+}
+
+// convertExperiment converts the fields of the Experiment, which are used by the Early Stopping services.
+func convertExperiment(e *experimentsv1beta1.Experiment) *api_v1_beta1.Experiment {
+	res := &api_v1_beta1.Experiment{
+		Name: e.Name,
+		Spec: &api_v1_beta1.ExperimentSpec{},
+	}
+	if e.Spec.Objective != nil {
+		res.Spec.Objective = &api_v1_beta1.ObjectiveSpec{
+			ObjectiveMetricName: e.Spec.Objective.ObjectiveMetricName,
+		}
+		switch e.Spec.Objective.Type {
+		case commonv1beta1.ObjectiveTypeMaximize:
+			res.Spec.Objective.Type = api_v1_beta1.ObjectiveType_MAXIMIZE
+		case commonv1beta1.ObjectiveTypeMinimize:
+			res.Spec.Objective.Type = api_v1_beta1.ObjectiveType_MINIMIZE
+		}
+	}
+	if e.Spec.EarlyStopping != nil {
+		res.Spec.EarlyStopping = &api_v1_beta1.EarlyStoppingSpec{
+			AlgorithmName: e.Spec.EarlyStopping.AlgorithmName,
+		}
+		for _, s := range e.Spec.EarlyStopping.AlgorithmSettings {
+			res.Spec.EarlyStopping.AlgorithmSettings = append(res.Spec.EarlyStopping.AlgorithmSettings,
+				&api_v1_beta1.EarlyStoppingSetting{
+					Name:  s.Name,
+					Value: s.Value,
+				})
+		}
+	}
+	return res
+}
+
+// convertTrials converts the names and the latest conditions of the Trials.
+// Trials with unavailable metrics are skipped, the same as in the Suggestion controller request.
+func convertTrials(ts []trialsv1beta1.Trial) []*api_v1_beta1.Trial {
+	res := make([]*api_v1_beta1.Trial, 0, len(ts))
+	for _, t := range ts {
+		if t.IsMetricsUnavailable() {
+			continue
+		}
+		trial := &api_v1_beta1.Trial{
+			Name:   t.Name,
+			Status: &api_v1_beta1.TrialStatus{},
+		}
+		if len(t.Status.Conditions) > 0 {
+			trial.Status.Condition = convertTrialConditionType(t.Status.Conditions[len(t.Status.Conditions)-1].Type)
+		}
+		res = append(res, trial)
+	}
+	return res
+}
+
+func convertTrialConditionType(conditionType trialsv1beta1.TrialConditionType) api_v1_beta1.TrialStatus_TrialConditionType {
+	switch conditionType {
+	case trialsv1beta1.TrialCreated:
+		return api_v1_beta1.TrialStatus_CREATED
+	case trialsv1beta1.TrialRunning:
+		return api_v1_beta1.TrialStatus_RUNNING
+	case trialsv1beta1.TrialSucceeded:
+		return api_v1_beta1.TrialStatus_SUCCEEDED
+	case trialsv1beta1.TrialKilled, trialsv1beta1.TrialTimedOut:
+		return api_v1_beta1.TrialStatus_KILLED
+	case trialsv1beta1.TrialFailed:
+		return api_v1_beta1.TrialStatus_FAILED
+	case trialsv1beta1.TrialEarlyStopped:
+		return api_v1_beta1.TrialStatus_EARLYSTOPPED
+	default:
+		return api_v1_beta1.TrialStatus_UNKNOWN
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+const (
+	// TrialEarlyStoppedReason is the reason of the Trial EarlyStopped condition.
+	TrialEarlyStoppedReason = "TrialEarlyStopped"
+)
+
+// MarkTrialEarlyStopped adds the EarlyStopped condition to the Trial status.
+func MarkTrialEarlyStopped(ctx context.Context, c client.Client, namespace, trialName string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		trial := &trialsv1beta1.Trial{}
+		err := c.Get(ctx, types.NamespacedName{Name: trialName, Namespace: namespace}, trial)
+		if err != nil {
+			return err
+		}
+		trial.MarkTrialStatusEarlyStopped(TrialEarlyStoppedReason, "Trial is early stopped")
+		return c.Status().Update(ctx, trial)
+	})
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package earlystopping_medianstop_v1beta1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	escommon "github.com/kubeflow/katib/pkg/earlystopping/v1beta1/common"
+)
+
+const (
+	AlgorithmMedianStop = "medianstop"
+
+	settingMinTrialsRequired = "min_trials_required"
+	settingStartStep         = "start_step"
+
+	defaultMinTrialsRequired = 3
+	defaultStartStep         = 4
+)
+
+// getObservationLog is the function to get metric logs from Katib DB manager.
+// It is replaced in the unit tests.
+var getObservationLog = common.GetObservationLog
+
+// EarlyStoppingService implements the median stopping rule.
+// Trial is stopped if its objective metric value is worse than the median of the average values of
+// the succeeded Trials, which are calculated from the first start_step reported metrics.
+type EarlyStoppingService struct {
+	mu sync.Mutex
+	// Client to update Trial status in the namespace of the service.
+	client    client.Client
+	namespace string
+	// Succeeded Trial name -> average value of the first start_step metrics.
+	trialsAvgHistory map[string]float64
+}
+
+// NewEarlyStoppingService returns the median stopping service which sets
+// status of Trials in the namespace.
+func NewEarlyStoppingService(c client.Client, namespace string) *EarlyStoppingService {
+	return &EarlyStoppingService{
+		client:           c,
+		namespace:        namespace,
+		trialsAvgHistory: make(map[string]float64),
+	}
+}
+
+type medianStopSettings struct {
+	minTrialsRequired int
+	startStep         int
+}
+
+// GetEarlyStoppingRules returns the rule with the median value of the succeeded Trials,
+// if the number of succeeded Trials is at least min_trials_required.
+// The same rule is returned for all Trials of the request. Request without the Experiment refreshes the rule
+// of the running Trial with the median of the current succeeded Trials of the Experiment.
+func (s *EarlyStoppingService) GetEarlyStoppingRules(
+	ctx context.Context,
+	req *api_v1_beta1.GetEarlyStoppingRulesRequest,
+) (*api_v1_beta1.GetEarlyStoppingRulesReply, error) {
+	experiment, trials, err := escommon.GetExperiment(ctx, s.client, s.namespace, req)
+	if err != nil {
+		klog.Errorf("Failed to get Experiment: %v", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	settings, err := parseSettings(experiment.GetSpec().GetEarlyStopping().GetAlgorithmSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objective := experiment.GetSpec().GetObjective()
+
+	median, ok, err := s.getMedianValue(trials, objective.GetObjectiveMetricName(), settings)
+	if err != nil {
+		klog.Errorf("Failed to get median value: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rules := []*api_v1_beta1.EarlyStoppingRule{}
+	if ok {
+		comparison := api_v1_beta1.ComparisonType_GREATER
+		if objective.GetType() == api_v1_beta1.ObjectiveType_MAXIMIZE {
+			comparison = api_v1_beta1.ComparisonType_LESS
+		}
+		rules = append(rules, &api_v1_beta1.EarlyStoppingRule{
+			Name:       objective.GetObjectiveMetricName(),
+			Value:      strconv.FormatFloat(median, 'f', -1, 64),
+			Comparison: comparison,
+			StartStep:  int32(settings.startStep),
+		})
+	}
+	klog.Infof("New early stopping rules are: %v", rules)
+
+	reply := &api_v1_beta1.GetEarlyStoppingRulesReply{
+		EarlyStoppingRules: rules,
+	}
+	for _, trialName := range req.GetTrialNames() {
+		reply.TrialEarlyStoppingRules = append(reply.TrialEarlyStoppingRules,
+			&api_v1_beta1.GetEarlyStoppingRulesReply_TrialEarlyStoppingRules{
+				TrialName:          trialName,
+				EarlyStoppingRules: rules,
+			})
+	}
+	return reply, nil
+}
+
+// getMedianValue returns the median of the succeeded Trials average values.
+// It returns false if there are less than min_trials_required succeeded Trials.
+func (s *EarlyStoppingService) getMedianValue(
+	trials []*api_v1_beta1.Trial,
+	metricName string,
+	settings *medianStopSettings,
+) (float64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, trial := range trials {
+		// Get metrics only for the new succeeded Trials.
+		if _, ok := s.trialsAvgHistory[trial.GetName()]; ok || trial.GetStatus().GetCondition() != api_v1_beta1.TrialStatus_SUCCEEDED {
+			continue
+		}
+		// Metrics are ordered by time, so the first page contains the first start_step metrics.
+		reply, err := getObservationLog(&api_v1_beta1.GetObservationLogRequest{
+			TrialName:  trial.GetName(),
+			MetricName: metricName,
+			PageSize:   int32(settings.startStep),
+		})
+		if err != nil {
+			return 0, false, fmt.Errorf("Failed to get observation log of Trial %v: %v", trial.GetName(), err)
+		}
+		metricLogs := reply.GetObservationLog().GetMetricLogs()
+		if len(metricLogs) == 0 {
+			klog.Warningf("Trial %v doesn't have metric %v logs", trial.GetName(), metricName)
+			continue
+		}
+		if len(metricLogs) > settings.startStep {
+			metricLogs = metricLogs[:settings.startStep]
+		}
+		sum := 0.0
+		for _, log := range metricLogs {
+			v, err := strconv.ParseFloat(log.GetMetric().GetValue(), 64)
+			if err != nil {
+				return 0, false, fmt.Errorf("Failed to parse metric %v of Trial %v: %v", metricName, trial.GetName(), err)
+			}
+			sum += v
+		}
+		s.trialsAvgHistory[trial.GetName()] = sum / float64(len(metricLogs))
+		klog.Infof("Adding new succeeded Trial: %v with average metrics value: %v", trial.GetName(), s.trialsAvgHistory[trial.GetName()])
+	}
+
+	if len(s.trialsAvgHistory) < settings.minTrialsRequired {
+		klog.Infof("Count of succeeded Trials: %v is less than min_trials_required: %v", len(s.trialsAvgHistory), settings.minTrialsRequired)
+		return 0, false, nil
+	}
+	values := make([]float64, 0, len(s.trialsAvgHistory))
+	for _, v := range s.trialsAvgHistory {
+		values = append(values, v)
+	}
+	median := getMedian(values)
+	klog.Infof("Generate new Median value: %v", median)
+	return median, true, nil
+}
+
+// SetTrialStatus adds the EarlyStopped condition to the Trial.
+func (s *EarlyStoppingService) SetTrialStatus(
+	ctx context.Context,
+	req *api_v1_beta1.SetTrialStatusRequest,
+) (*api_v1_beta1.SetTrialStatusReply, error) {
+	trialName := req.GetTrialName()
+	klog.Infof("Update status for Trial: %v", trialName)
+
+	if err := escommon.MarkTrialEarlyStopped(ctx, s.client, s.namespace, trialName); err != nil {
+		klog.Errorf("Update status for Trial: %v in namespace: %v failed: %v", trialName, s.namespace, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	klog.Infof("Changed status to: %v for Trial: %v in namespace: %v", trialsv1beta1.TrialEarlyStopped, trialName, s.namespace)
+	return &api_v1_beta1.SetTrialStatusReply{}, nil
+}
+
+// ValidateEarlyStoppingSettings validates the median stop settings of the Experiment.
+func (s *EarlyStoppingService) ValidateEarlyStoppingSettings(
+	ctx context.Context,
+	req *api_v1_beta1.ValidateEarlyStoppingSettingsRequest,
+) (*api_v1_beta1.ValidateEarlyStoppingSettingsReply, error) {
+	if err := ValidateSettings(req.GetExperiment().GetSpec().GetEarlyStopping().GetAlgorithmSettings()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &api_v1_beta1.ValidateEarlyStoppingSettingsReply{}, nil
+}
+
+// ValidateSettings returns error if the median stop settings are invalid.
+func ValidateSettings(algorithmSettings []*api_v1_beta1.EarlyStoppingSetting) error {
+	_, err := parseSettings(algorithmSettings)
+	return err
+}
+
+func parseSettings(algorithmSettings []*api_v1_beta1.EarlyStoppingSetting) (*medianStopSettings, error) {
+	settings := &medianStopSettings{
+		minTrialsRequired: defaultMinTrialsRequired,
+		startStep:         defaultStartStep,
+	}
+	for _, setting := range algorithmSettings {
+		var target *int
+		switch setting.GetName() {
+		case settingMinTrialsRequired:
+			target = &settings.minTrialsRequired
+		case settingStartStep:
+			target = &settings.startStep
+		default:
+			return nil, fmt.Errorf("Unknown setting %v for median stop early stopping", setting.GetName())
+		}
+		v, err := strconv.Atoi(setting.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("%v must be an integer, got %v", setting.GetName(), setting.GetValue())
+		}
+		if v < 1 {
+			return nil, fmt.Errorf("%v must be greater than zero, got %v", setting.GetName(), v)
+		}
+		*target = v
+	}
+	return settings, nil
+}
+
+func getMedian(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// This is a compile-time assertion to ensure that EarlyStoppingService
+// implements an api_v1_beta1.EarlyStoppingServer interface.
+var _ api_v1_beta1.EarlyStoppingServer = &EarlyStoppingService{}
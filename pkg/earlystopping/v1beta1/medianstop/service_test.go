@@ -0,0 +1,387 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package earlystopping_medianstop_v1beta1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// fakeDBManager returns metric logs of the Trials and counts the requests.
+type fakeDBManager struct {
+	metricLogs map[string][]string
+	requests   int
+}
+
+func (f *fakeDBManager) GetObservationLog(req *api_v1_beta1.GetObservationLogRequest) (*api_v1_beta1.GetObservationLogReply, error) {
+	f.requests++
+	values, ok := f.metricLogs[req.TrialName]
+	if !ok {
+		return nil, fmt.Errorf("Trial %v not found", req.TrialName)
+	}
+	metricLogs := []*api_v1_beta1.MetricLog{}
+	for i, v := range values {
+		if req.PageSize > 0 && i >= int(req.PageSize) {
+			break
+		}
+		metricLogs = append(metricLogs, &api_v1_beta1.MetricLog{
+			TimeStamp: fmt.Sprintf("2021-01-01T00:00:%02dZ", i),
+			Metric:    &api_v1_beta1.Metric{Name: req.MetricName, Value: v},
+		})
+	}
+	return &api_v1_beta1.GetObservationLogReply{
+		ObservationLog: &api_v1_beta1.ObservationLog{MetricLogs: metricLogs},
+	}, nil
+}
+
+func newFakeRequest(objectiveType api_v1_beta1.ObjectiveType, settings []*api_v1_beta1.EarlyStoppingSetting, trialNames ...string) *api_v1_beta1.GetEarlyStoppingRulesRequest {
+	trials := make([]*api_v1_beta1.Trial, 0, len(trialNames))
+	for _, name := range trialNames {
+		trials = append(trials, &api_v1_beta1.Trial{
+			Name:   name,
+			Status: &api_v1_beta1.TrialStatus{Condition: api_v1_beta1.TrialStatus_SUCCEEDED},
+		})
+	}
+	trials = append(trials, &api_v1_beta1.Trial{
+		Name:   "trial-running",
+		Status: &api_v1_beta1.TrialStatus{Condition: api_v1_beta1.TrialStatus_RUNNING},
+	})
+	return &api_v1_beta1.GetEarlyStoppingRulesRequest{
+		Experiment: &api_v1_beta1.Experiment{
+			Name: "test",
+			Spec: &api_v1_beta1.ExperimentSpec{
+				Objective: &api_v1_beta1.ObjectiveSpec{
+					Type:                objectiveType,
+					ObjectiveMetricName: "loss",
+				},
+				EarlyStopping: &api_v1_beta1.EarlyStoppingSpec{
+					AlgorithmName:     AlgorithmMedianStop,
+					AlgorithmSettings: settings,
+				},
+			},
+		},
+		Trials: trials,
+	}
+}
+
+func TestGetEarlyStoppingRules(t *testing.T) {
+	dbManager := &fakeDBManager{
+		metricLogs: map[string][]string{
+			"trial-1": {"0.5", "1.5", "2.5", "3.5", "4.5"},
+			"trial-2": {"1", "3", "5", "7", "9"},
+			"trial-3": {"3", "3", "3", "3", "3"},
+			"trial-4": {"6", "6"},
+		},
+	}
+	getObservationLog = dbManager.GetObservationLog
+	defer func() {
+		getObservationLog = common.GetObservationLog
+	}()
+
+	testCases := []struct {
+		req           *api_v1_beta1.GetEarlyStoppingRulesRequest
+		expectedRules []*api_v1_beta1.EarlyStoppingRule
+		expectedCode  codes.Code
+		testDesc      string
+	}{
+		{
+			req:           newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, nil, "trial-1", "trial-2"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{},
+			testDesc:      "Succeeded Trials are less than min_trials_required",
+		},
+		{
+			req: newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, nil, "trial-1", "trial-2", "trial-3"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "loss",
+					Value:      "3",
+					Comparison: api_v1_beta1.ComparisonType_LESS,
+					StartStep:  4,
+				},
+			},
+			testDesc: "Median of odd number of Trials for maximize objective",
+		},
+		{
+			req: newFakeRequest(api_v1_beta1.ObjectiveType_MINIMIZE, []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "min_trials_required", Value: "4"},
+				{Name: "start_step", Value: "2"},
+			}, "trial-1", "trial-2", "trial-3", "trial-4"),
+			expectedRules: []*api_v1_beta1.EarlyStoppingRule{
+				{
+					Name:       "loss",
+					Value:      "2.5",
+					Comparison: api_v1_beta1.ComparisonType_GREATER,
+					StartStep:  2,
+				},
+			},
+			testDesc: "Median of even number of Trials for minimize objective",
+		},
+		{
+			req: newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "start_step", Value: "0"},
+			}, "trial-1"),
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Invalid start_step",
+		},
+		{
+			req:          newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, nil, "trial-unknown"),
+			expectedCode: codes.Internal,
+			testDesc:     "Observation log is not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		s := NewEarlyStoppingService(nil, "default")
+		reply, err := s.GetEarlyStoppingRules(context.TODO(), tc.req)
+		if code := status.Code(err); code != tc.expectedCode {
+			t.Errorf("Case: %v. Expected code %v, got %v", tc.testDesc, tc.expectedCode, code)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(reply.EarlyStoppingRules, tc.expectedRules) {
+			t.Errorf("Case: %v. Expected rules %v, got %v", tc.testDesc, tc.expectedRules, reply.EarlyStoppingRules)
+		}
+	}
+
+	// Metrics of the succeeded Trials are requested once.
+	s := NewEarlyStoppingService(nil, "default")
+	req := newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, nil, "trial-1", "trial-2", "trial-3")
+	dbManager.requests = 0
+	for i := 0; i < 2; i++ {
+		if _, err := s.GetEarlyStoppingRules(context.TODO(), req); err != nil {
+			t.Fatalf("Failed to get early stopping rules: %v", err)
+		}
+	}
+	if dbManager.requests != 3 {
+		t.Errorf("Expected 3 observation log requests, got %v", dbManager.requests)
+	}
+
+	// Suggestion controller request gets the rules for each new Trial.
+	req = newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, nil, "trial-1", "trial-2", "trial-3")
+	req.TrialNames = []string{"trial-new-1", "trial-new-2"}
+	reply, err := NewEarlyStoppingService(nil, "default").GetEarlyStoppingRules(context.TODO(), req)
+	if err != nil {
+		t.Fatalf("Failed to get early stopping rules: %v", err)
+	}
+	if len(reply.TrialEarlyStoppingRules) != 2 {
+		t.Fatalf("Expected rules for 2 Trials, got %v", reply.TrialEarlyStoppingRules)
+	}
+	for _, trialRules := range reply.TrialEarlyStoppingRules {
+		if !reflect.DeepEqual(trialRules.EarlyStoppingRules, reply.EarlyStoppingRules) {
+			t.Errorf("Expected rules %v for Trial %v, got %v", reply.EarlyStoppingRules, trialRules.TrialName, trialRules.EarlyStoppingRules)
+		}
+	}
+}
+
+func TestRefreshEarlyStoppingRules(t *testing.T) {
+	getObservationLog = (&fakeDBManager{
+		metricLogs: map[string][]string{
+			"trial-1": {"0.5", "1.5", "2.5", "3.5", "4.5"},
+			"trial-2": {"1", "3", "5", "7", "9"},
+			"trial-3": {"3", "3", "3", "3", "3"},
+			"trial-4": {"6", "6"},
+		},
+	}).GetObservationLog
+	defer func() {
+		getObservationLog = common.GetObservationLog
+	}()
+
+	scheme := runtime.NewScheme()
+	if err := experimentsv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	if err := trialsv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	experiment := &experimentsv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: experimentsv1beta1.ExperimentSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "loss",
+			},
+			EarlyStopping: &commonv1beta1.EarlyStoppingSpec{
+				AlgorithmName: AlgorithmMedianStop,
+			},
+		},
+	}
+	objects := []runtime.Object{experiment}
+	for _, name := range []string{"trial-1", "trial-2", "trial-3", "trial-4", "trial-running"} {
+		trial := &trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels:    map[string]string{consts.LabelExperimentName: "test"},
+			},
+		}
+		trial.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+		if name != "trial-4" && name != "trial-running" {
+			trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial is succeeded")
+		}
+		objects = append(objects, trial)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	// Metrics collector refreshes the rule of the running Trial only with the Trial name.
+	refreshReq := &api_v1_beta1.GetEarlyStoppingRulesRequest{TrialNames: []string{"trial-running"}}
+	getTrialRuleValue := func(s *EarlyStoppingService) string {
+		reply, err := s.GetEarlyStoppingRules(context.TODO(), refreshReq)
+		if err != nil {
+			t.Fatalf("Failed to refresh early stopping rules: %v", err)
+		}
+		if len(reply.TrialEarlyStoppingRules) != 1 || reply.TrialEarlyStoppingRules[0].TrialName != "trial-running" ||
+			len(reply.TrialEarlyStoppingRules[0].EarlyStoppingRules) != 1 {
+			t.Fatalf("Expected one rule for trial-running, got %v", reply.TrialEarlyStoppingRules)
+		}
+		return reply.TrialEarlyStoppingRules[0].EarlyStoppingRules[0].Value
+	}
+
+	s := NewEarlyStoppingService(c, "test")
+	if value := getTrialRuleValue(s); value != "3" {
+		t.Errorf("Expected rule value 3 with the median of 3 Trials, got %v", value)
+	}
+
+	// Threshold of the running Trial changes once one more Trial is succeeded.
+	trial := &trialsv1beta1.Trial{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "trial-4", Namespace: "test"}, trial); err != nil {
+		t.Fatalf("Failed to get Trial: %v", err)
+	}
+	trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial is succeeded")
+	if err := c.Status().Update(context.TODO(), trial); err != nil {
+		t.Fatalf("Failed to update Trial status: %v", err)
+	}
+	if value := getTrialRuleValue(s); value != "3.5" {
+		t.Errorf("Expected rule value 3.5 with the median of 4 Trials, got %v", value)
+	}
+
+	// Restarted service gets the Experiment and the Trials from the Kubernetes API.
+	if value := getTrialRuleValue(NewEarlyStoppingService(c, "test")); value != "3.5" {
+		t.Errorf("Expected rule value 3.5 after the service restart, got %v", value)
+	}
+
+	_, err := s.GetEarlyStoppingRules(context.TODO(), &api_v1_beta1.GetEarlyStoppingRulesRequest{TrialNames: []string{"trial-unknown"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error for unknown Trial, got %v", err)
+	}
+}
+
+func TestSetTrialStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trial-1",
+			Namespace: "test",
+		},
+	}
+	trial.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(trial).Build()
+	s := NewEarlyStoppingService(c, "test")
+
+	_, err := s.SetTrialStatus(context.TODO(), &api_v1_beta1.SetTrialStatusRequest{TrialName: "trial-1"})
+	if err != nil {
+		t.Fatalf("Failed to set Trial status: %v", err)
+	}
+	updated := &trialsv1beta1.Trial{}
+	if err = c.Get(context.TODO(), types.NamespacedName{Name: "trial-1", Namespace: "test"}, updated); err != nil {
+		t.Fatalf("Failed to get Trial: %v", err)
+	}
+	if !updated.IsEarlyStopped() {
+		t.Errorf("Trial must be early stopped, got conditions %v", updated.Status.Conditions)
+	}
+	if !updated.IsRunning() {
+		t.Errorf("Trial must keep the Running condition, got conditions %v", updated.Status.Conditions)
+	}
+
+	_, err = s.SetTrialStatus(context.TODO(), &api_v1_beta1.SetTrialStatusRequest{TrialName: "trial-unknown"})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("Expected Internal error for unknown Trial, got %v", err)
+	}
+}
+
+func TestValidateEarlyStoppingSettings(t *testing.T) {
+	testCases := []struct {
+		settings     []*api_v1_beta1.EarlyStoppingSetting
+		expectedCode codes.Code
+		testDesc     string
+	}{
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "min_trials_required", Value: "2"},
+				{Name: "start_step", Value: "5"},
+			},
+			expectedCode: codes.OK,
+			testDesc:     "Valid settings",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "min_trials_required", Value: "two"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Invalid min_trials_required",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "start_step", Value: "-1"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Negative start_step",
+		},
+		{
+			settings: []*api_v1_beta1.EarlyStoppingSetting{
+				{Name: "start_steps", Value: "5"},
+			},
+			expectedCode: codes.InvalidArgument,
+			testDesc:     "Unknown setting",
+		},
+	}
+
+	s := NewEarlyStoppingService(nil, "default")
+	for _, tc := range testCases {
+		req := &api_v1_beta1.ValidateEarlyStoppingSettingsRequest{
+			Experiment: newFakeRequest(api_v1_beta1.ObjectiveType_MAXIMIZE, tc.settings).Experiment,
+		}
+		_, err := s.ValidateEarlyStoppingSettings(context.TODO(), req)
+		if code := status.Code(err); code != tc.expectedCode {
+			t.Errorf("Case: %v. Expected code %v, got %v", tc.testDesc, tc.expectedCode, code)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package earlystopping_v1beta1
+
+import (
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	asha "github.com/kubeflow/katib/pkg/earlystopping/v1beta1/asha"
+	medianstop "github.com/kubeflow/katib/pkg/earlystopping/v1beta1/medianstop"
+)
+
+// ValidateEarlyStoppingSettings validates settings of the early stopping algorithms implemented in Katib.
+// Settings of other algorithms are validated by their services with the ValidateEarlyStoppingSettings call.
+func ValidateEarlyStoppingSettings(spec *api_v1_beta1.EarlyStoppingSpec) error {
+	switch spec.GetAlgorithmName() {
+	case medianstop.AlgorithmMedianStop:
+		return medianstop.ValidateSettings(spec.GetAlgorithmSettings())
+	case asha.AlgorithmASHA:
+		return asha.ValidateSettings(spec.GetAlgorithmSettings())
+	}
+	return nil
+}
@@ -42,6 +42,17 @@ const (
 	// accuracy=0.98
 	DefaultFilter = `([\w|-]+)\s*=\s*((-?\d+)(\.\d+)?)`
 
+	// DefaultStreamBatchSize is the default number of metric logs which are streamed to the DB Manager in one batch.
+	// To report metrics only after the training is completed set value to 0
+	DefaultStreamBatchSize = 100
+	// DefaultStreamFlushInterval is the default interval to stream pending metric logs to the DB Manager
+	DefaultStreamFlushInterval = 10 * time.Second
+	// DefaultStopRulesRefreshInterval is the default interval to refresh the early stopping rules of the running Trial
+	// from the Early Stopping service.
+	DefaultStopRulesRefreshInterval = time.Minute
+	// DefaultEarlyStoppingRequestTimeout is the timeout of the request to the Early Stopping service.
+	DefaultEarlyStoppingRequestTimeout = 10 * time.Second
+
 	// TODO (andreyvelich): Do we need to maintain 2 names? Should we leave only 1?
 	MetricCollectorContainerName       = "metrics-collector"
 	MetricLoggerCollectorContainerName = "metrics-logger-and-collector"
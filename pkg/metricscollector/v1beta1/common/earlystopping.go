@@ -0,0 +1,310 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/process"
+	"google.golang.org/grpc"
+
+	v1beta1common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+// StopRulesFlag is the flag with the list of early stopping rules.
+// Each rule has "<metric name>;<value>;<comparison>;<start step>" format.
+type StopRulesFlag []v1beta1common.EarlyStoppingRule
+
+func (flag *StopRulesFlag) String() string {
+	stopRuleStrings := []string{}
+	for _, r := range *flag {
+		stopRuleStrings = append(stopRuleStrings, r.Name)
+		stopRuleStrings = append(stopRuleStrings, r.Value)
+		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
+		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
+	}
+	return strings.Join(stopRuleStrings, ";")
+}
+
+func (flag *StopRulesFlag) Set(value string) error {
+	stopRuleParsed := strings.Split(value, ";")
+	if len(stopRuleParsed) != 4 {
+		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
+	}
+
+	// Get int start step.
+	startStep, err := strconv.Atoi(stopRuleParsed[3])
+	if err != nil {
+		return fmt.Errorf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
+	}
+
+	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
+	// Start step is equal to 0, if it's not defined.
+	stopRule := v1beta1common.EarlyStoppingRule{
+		Name:       stopRuleParsed[0],
+		Value:      stopRuleParsed[1],
+		Comparison: v1beta1common.ComparisonType(stopRuleParsed[2]),
+		StartStep:  startStep,
+	}
+
+	*flag = append(*flag, stopRule)
+	return nil
+}
+
+// StopRulesChecker checks if the reported metrics reach all early stopping rules.
+type StopRulesChecker struct {
+	// rules contains all early stopping rules.
+	rules []v1beta1common.EarlyStoppingRule
+	// stopRules contains the rules that has not been reached yet.
+	stopRules []v1beta1common.EarlyStoppingRule
+	// metricReported is the dict where key = metric name, value = number of reported metrics.
+	// We should apply early stopping rule only if metric is reported at least "start_step" times.
+	metricReported map[string]int
+	objMetric      string
+	objType        v1beta1common.ObjectiveType
+	// For objective metric we calculate best optimal value from the recorded metrics.
+	// This is workaround for Median Stop algorithm.
+	optimalObjValue *float64
+}
+
+// NewStopRulesChecker returns the checker of the stop rules.
+// Objective metric is compared with the rules by the best reported value.
+func NewStopRulesChecker(stopRules []v1beta1common.EarlyStoppingRule, objMetric string, objType v1beta1common.ObjectiveType) (*StopRulesChecker, error) {
+	c := &StopRulesChecker{
+		metricReported: make(map[string]int),
+		objMetric:      objMetric,
+		objType:        objType,
+	}
+	if _, err := c.SetStopRules(stopRules); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetStopRules replaces the stop rules, e.g. when the Early Stopping service refreshes the rules of the running Trial.
+// Reported metrics are kept, so the new rules are applied from the current step.
+// It returns true if the rules are changed.
+func (c *StopRulesChecker) SetStopRules(stopRules []v1beta1common.EarlyStoppingRule) (bool, error) {
+	for _, stopRule := range stopRules {
+		if _, err := strconv.ParseFloat(stopRule.Value, 64); err != nil {
+			return false, fmt.Errorf("Unable to parse value %v to float for rule metric %v", stopRule.Value, stopRule.Name)
+		}
+	}
+	if reflect.DeepEqual(c.rules, stopRules) {
+		return false, nil
+	}
+	c.rules = make([]v1beta1common.EarlyStoppingRule, len(stopRules))
+	copy(c.rules, stopRules)
+	c.stopRules = make([]v1beta1common.EarlyStoppingRule, len(stopRules))
+	copy(c.stopRules, stopRules)
+	return true, nil
+}
+
+// MetricNames returns the objective metric name and the unique metric names of the stop rules.
+// Objective metric is always checked, so the refreshed rules are applied with the best reported value.
+func (c *StopRulesChecker) MetricNames() []string {
+	names := []string{c.objMetric}
+	seen := map[string]bool{c.objMetric: true}
+	for _, rule := range c.rules {
+		if !seen[rule.Name] {
+			seen[rule.Name] = true
+			names = append(names, rule.Name)
+		}
+	}
+	return names
+}
+
+// Check applies the stop rules to the reported metric value.
+// It returns true if all stop rules are reached and training must be early stopped.
+func (c *StopRulesChecker) Check(metricName string, metricValue float64) bool {
+	if c.IsEarlyStopped() {
+		return true
+	}
+	// Calculate optimalObjValue.
+	if metricName == c.objMetric {
+		if c.optimalObjValue == nil ||
+			(c.objType == v1beta1common.ObjectiveTypeMaximize && metricValue > *c.optimalObjValue) ||
+			(c.objType == v1beta1common.ObjectiveTypeMinimize && metricValue < *c.optimalObjValue) {
+			value := metricValue
+			c.optimalObjValue = &value
+		}
+		// Assign best optimal value to metric value.
+		metricValue = *c.optimalObjValue
+	}
+
+	// Count reported metrics, rule is applied once its metric is reported at least start step times.
+	c.metricReported[metricName]++
+
+	// Metric value can be equal, less or greater than stop rule.
+	// Deleting suitable stop rules from the array.
+	notReached := make([]v1beta1common.EarlyStoppingRule, 0, len(c.stopRules))
+	for _, rule := range c.stopRules {
+		// Rule values are validated in SetStopRules.
+		ruleValue, _ := strconv.ParseFloat(rule.Value, 64)
+		if rule.Name == metricName && c.metricReported[metricName] >= rule.StartStep &&
+			((rule.Comparison == v1beta1common.ComparisonTypeEqual && metricValue == ruleValue) ||
+				(rule.Comparison == v1beta1common.ComparisonTypeLess && metricValue < ruleValue) ||
+				(rule.Comparison == v1beta1common.ComparisonTypeGreater && metricValue > ruleValue)) {
+			continue
+		}
+		notReached = append(notReached, rule)
+	}
+	c.stopRules = notReached
+	return c.IsEarlyStopped()
+}
+
+// IsEarlyStopped returns true if all stop rules are reached.
+// Trial without rules is never early stopped.
+func (c *StopRulesChecker) IsEarlyStopped() bool {
+	return len(c.rules) != 0 && len(c.stopRules) == 0
+}
+
+// StopTraining marks the main training process as early stopped and terminates its child process.
+// It creates "$$$$.pid" file with "early-stopped" line in the marked directory,
+// which means that training is early stopped and Trial status is updated.
+func StopTraining(completedMarkedDirPath string, mainProc *psutil.Process) error {
+	markFile := filepath.Join(completedMarkedDirPath, fmt.Sprintf("%d.pid", mainProc.Pid))
+	if err := ioutil.WriteFile(markFile, []byte(TrainingEarlyStopped), 0644); err != nil {
+		return fmt.Errorf("Write to file %v error: %v", markFile, err)
+	}
+
+	// Get child proccess from main PID.
+	childProc, err := mainProc.Children()
+	if err != nil {
+		return fmt.Errorf("Get children proceses for main PID: %v failed: %v", mainProc.Pid, err)
+	}
+
+	// TODO (andreyvelich): Currently support only single child process.
+	if len(childProc) != 1 {
+		return fmt.Errorf("Multiple children processes are not supported. Children processes: %v", childProc)
+	}
+
+	// Terminate the child process.
+	if err = childProc[0].Terminate(); err != nil {
+		return fmt.Errorf("Unable to terminate child process %v, error: %v", childProc[0], err)
+	}
+	return nil
+}
+
+// WaitProcessCompleted waits until the process is completed or timeout is out.
+func WaitProcessCompleted(proc *psutil.Process, timeout time.Duration) error {
+	endTime := time.Now().Add(timeout)
+	isProcRunning := true
+	for isProcRunning && time.Now().Before(endTime) {
+		var err error
+		isProcRunning, err = proc.IsRunning()
+		// Ignore "no such file error". It means that process is complete.
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Check process status for PID: %v failed: %v", proc.Pid, err)
+		}
+	}
+	return nil
+}
+
+// earlyStoppingDialOption returns gRPC dial option for the Early Stopping service.
+// Early Stopping service serves gRPC with TLS only if it opts in with grpcTLS in Katib config.
+func earlyStoppingDialOption(earlyStopServiceTLS bool) (grpc.DialOption, error) {
+	if !earlyStopServiceTLS {
+		return grpc.WithInsecure(), nil
+	}
+	return grpctls.DialOption()
+}
+
+// SetTrialEarlyStopped sends request to the Early Stopping service to change Trial status to early stopped.
+func SetTrialEarlyStopped(earlyStopServiceAddr string, earlyStopServiceTLS bool, trialName string) error {
+	tlsOpt, err := earlyStoppingDialOption(earlyStopServiceTLS)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(earlyStopServiceAddr, tlsOpt)
+	if err != nil {
+		return fmt.Errorf("Could not connect to Early Stopping service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewEarlyStoppingClient(conn)
+
+	setTrialStatusReq := &api.SetTrialStatusRequest{
+		TrialName: trialName,
+	}
+	if _, err = c.SetTrialStatus(context.Background(), setTrialStatusReq); err != nil {
+		return fmt.Errorf("Set Trial status error: %v", err)
+	}
+	return nil
+}
+
+// GetEarlyStoppingRules sends request to the Early Stopping service to get the current rules of the running Trial.
+// Early Stopping service returns the rules of the Trial or the common rules of all Trials.
+func GetEarlyStoppingRules(earlyStopServiceAddr string, earlyStopServiceTLS bool, trialName string) ([]v1beta1common.EarlyStoppingRule, error) {
+	tlsOpt, err := earlyStoppingDialOption(earlyStopServiceTLS)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(earlyStopServiceAddr, tlsOpt)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to Early Stopping service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewEarlyStoppingClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultEarlyStoppingRequestTimeout)
+	defer cancel()
+	reply, err := c.GetEarlyStoppingRules(ctx, &api.GetEarlyStoppingRulesRequest{
+		TrialNames: []string{trialName},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Get early stopping rules error: %v", err)
+	}
+
+	rules := reply.GetEarlyStoppingRules()
+	for _, trialRules := range reply.GetTrialEarlyStoppingRules() {
+		if trialRules.GetTrialName() == trialName {
+			rules = trialRules.GetEarlyStoppingRules()
+			break
+		}
+	}
+	return convertEarlyStoppingRules(rules), nil
+}
+
+func convertEarlyStoppingRules(rules []*api.EarlyStoppingRule) []v1beta1common.EarlyStoppingRule {
+	res := []v1beta1common.EarlyStoppingRule{}
+	for _, rule := range rules {
+		comparison := v1beta1common.ComparisonTypeEqual
+		switch rule.GetComparison() {
+		case api.ComparisonType_LESS:
+			comparison = v1beta1common.ComparisonTypeLess
+		case api.ComparisonType_GREATER:
+			comparison = v1beta1common.ComparisonTypeGreater
+		}
+		res = append(res, v1beta1common.EarlyStoppingRule{
+			Name:       rule.GetName(),
+			Value:      rule.GetValue(),
+			Comparison: comparison,
+			StartStep:  int(rule.GetStartStep()),
+		})
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	v1beta1common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+type reportedMetric struct {
+	name  string
+	value float64
+}
+
+func TestStopRulesChecker(t *testing.T) {
+	testCases := []struct {
+		stopRules       []string
+		metrics         []reportedMetric
+		expectedStopped bool
+		testDesc        string
+	}{
+		{
+			stopRules: []string{"accuracy;0.6;less;0", "loss;2;greater;0"},
+			metrics: []reportedMetric{
+				{name: "accuracy", value: 0.5},
+				{name: "loss", value: 3},
+			},
+			expectedStopped: true,
+			testDesc:        "All stop rules are reached",
+		},
+		{
+			stopRules: []string{"accuracy;0.6;less;0"},
+			metrics: []reportedMetric{
+				{name: "accuracy", value: 0.7},
+				{name: "accuracy", value: 0.5},
+			},
+			expectedStopped: false,
+			testDesc:        "Best objective value is compared with the rule",
+		},
+		{
+			stopRules: []string{"loss;2;greater;3"},
+			metrics: []reportedMetric{
+				{name: "loss", value: 3},
+				{name: "loss", value: 3},
+			},
+			expectedStopped: false,
+			testDesc:        "Metric is reported less than start step times",
+		},
+		{
+			stopRules: []string{"loss;2;greater;2"},
+			metrics: []reportedMetric{
+				{name: "loss", value: 1},
+				{name: "loss", value: 1},
+				{name: "loss", value: 3},
+			},
+			expectedStopped: true,
+			testDesc:        "Rule is reached after start step",
+		},
+	}
+
+	for _, tc := range testCases {
+		var stopRules StopRulesFlag
+		for _, rule := range tc.stopRules {
+			if err := stopRules.Set(rule); err != nil {
+				t.Fatalf("Case: %v. Failed to parse stop rule: %v", tc.testDesc, err)
+			}
+		}
+		checker, err := NewStopRulesChecker(stopRules, "accuracy", v1beta1common.ObjectiveTypeMaximize)
+		if err != nil {
+			t.Fatalf("Case: %v. Failed to create checker: %v", tc.testDesc, err)
+		}
+		for _, m := range tc.metrics {
+			checker.Check(m.name, m.value)
+		}
+		if checker.IsEarlyStopped() != tc.expectedStopped {
+			t.Errorf("Case: %v. Expected early stopped %v, got %v", tc.testDesc, tc.expectedStopped, checker.IsEarlyStopped())
+		}
+	}
+}
+
+func TestSetStopRules(t *testing.T) {
+	checker, err := NewStopRulesChecker(nil, "accuracy", v1beta1common.ObjectiveTypeMaximize)
+	if err != nil {
+		t.Fatalf("Failed to create checker: %v", err)
+	}
+	checker.Check("accuracy", 0.5)
+	if checker.IsEarlyStopped() {
+		t.Errorf("Expected Trial without rules is not early stopped")
+	}
+
+	rules := []v1beta1common.EarlyStoppingRule{
+		{
+			Name:       "accuracy",
+			Value:      "0.6",
+			Comparison: v1beta1common.ComparisonTypeLess,
+			StartStep:  2,
+		},
+	}
+	if changed, err := checker.SetStopRules(rules); err != nil || !changed {
+		t.Fatalf("Expected changed rules, got %v, error: %v", changed, err)
+	}
+	if changed, err := checker.SetStopRules(rules); err != nil || changed {
+		t.Errorf("Expected the same rules are not changed, got %v, error: %v", changed, err)
+	}
+	invalidRules := []v1beta1common.EarlyStoppingRule{
+		{
+			Name:       "accuracy",
+			Value:      "invalid",
+			Comparison: v1beta1common.ComparisonTypeLess,
+		},
+	}
+	if _, err := checker.SetStopRules(invalidRules); err == nil {
+		t.Errorf("Expected error for invalid rule value, got nil")
+	}
+
+	// Metrics reported before the refresh are counted for the start step.
+	if !checker.Check("accuracy", 0.4) {
+		t.Errorf("Expected early stopped Trial after the rules are refreshed")
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// ObservationLogStreamer reports metric logs to the DB manager in batches while the Trial is running.
+// It remembers every stored metric log, so that the final report of the whole log does not insert them twice.
+// Streamed metric logs are counted as stored only after DB manager confirms the stream,
+// otherwise they are reported again at the end of the Trial.
+type ObservationLogStreamer struct {
+	mu        sync.Mutex
+	client    v1beta1.DBManagerClient
+	stream    v1beta1.DBManager_StreamObservationLogClient
+	trialName string
+	batchSize int
+	pending   []*v1beta1.MetricLog
+	// streamed counts the metric logs sent on the stream, which are not confirmed yet, by time stamp, name and value.
+	streamed map[string]int
+	// stored counts the metric logs confirmed by DB manager.
+	stored map[string]int
+	closed bool
+}
+
+// NewObservationLogStreamer opens the stream for the Trial.
+// A batch is sent when it has batchSize metric logs, pending metric logs are sent every flushInterval.
+func NewObservationLogStreamer(client v1beta1.DBManagerClient, trialName string, batchSize int, flushInterval time.Duration) (*ObservationLogStreamer, error) {
+	stream, err := client.StreamObservationLog(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open observation log stream: %v", err)
+	}
+	s := &ObservationLogStreamer{
+		client:    client,
+		stream:    stream,
+		trialName: trialName,
+		batchSize: batchSize,
+		streamed:  map[string]int{},
+		stored:    map[string]int{},
+	}
+	go s.flushPeriodically(flushInterval)
+	return s, nil
+}
+
+// Add queues metric logs and sends the batch if it is full.
+// Errors are logged and stop the streaming, the final report then sends the rest of the log.
+func (s *ObservationLogStreamer) Add(metricLogs []*v1beta1.MetricLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.pending = append(s.pending, metricLogs...)
+	if len(s.pending) >= s.batchSize {
+		s.flush()
+	}
+}
+
+func (s *ObservationLogStreamer) flushPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return
+		}
+		s.flush()
+		s.mu.Unlock()
+	}
+}
+
+func (s *ObservationLogStreamer) flush() {
+	if len(s.pending) == 0 {
+		return
+	}
+	err := s.stream.Send(&v1beta1.StreamObservationLogRequest{
+		TrialName: s.trialName,
+		ObservationLog: &v1beta1.ObservationLog{
+			MetricLogs: s.pending,
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to stream observation log, metrics are reported at the end of the Trial: %v", err)
+		s.closeStream()
+		return
+	}
+	for _, mlog := range s.pending {
+		s.streamed[metricLogKey(mlog)]++
+	}
+	s.pending = nil
+}
+
+func (s *ObservationLogStreamer) closeStream() {
+	s.closed = true
+	reply, err := s.stream.CloseAndRecv()
+	streamed := s.streamed
+	s.streamed = map[string]int{}
+	if err != nil {
+		// DB manager doesn't confirm the streamed metric logs, so they are reported again.
+		klog.Errorf("Observation log stream failed, streamed metric logs are reported again: %v", err)
+		return
+	}
+	for key, count := range streamed {
+		s.stored[key] += count
+	}
+	klog.Infof("Observation log stream is closed, %v metric logs are stored", reply.MetricLogsCount)
+}
+
+// Close sends the metric logs of the whole observation log, which have not been sent yet, and closes the stream.
+func (s *ObservationLogStreamer) Close(observationLog *v1beta1.ObservationLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		// The whole observation log replaces the pending metric logs.
+		s.pending = s.notSent(observationLog)
+		s.flush()
+		if !s.closed {
+			s.closeStream()
+		}
+	}
+
+	// Report the rest with unary call, if the stream is broken.
+	rest := s.notSent(observationLog)
+	if len(rest) == 0 {
+		return nil
+	}
+	_, err := s.client.ReportObservationLog(context.Background(), &v1beta1.ReportObservationLogRequest{
+		TrialName: s.trialName,
+		ObservationLog: &v1beta1.ObservationLog{
+			MetricLogs: rest,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to report observation log: %v", err)
+	}
+	for _, mlog := range rest {
+		s.stored[metricLogKey(mlog)]++
+	}
+	return nil
+}
+
+// notSent returns the metric logs of the observation log, which are neither stored nor streamed yet.
+// Equal metric logs are counted, so metrics which are reported several times with the same value are kept.
+func (s *ObservationLogStreamer) notSent(observationLog *v1beta1.ObservationLog) []*v1beta1.MetricLog {
+	rest := []*v1beta1.MetricLog{}
+	seen := map[string]int{}
+	for _, mlog := range observationLog.MetricLogs {
+		key := metricLogKey(mlog)
+		seen[key]++
+		if seen[key] > s.stored[key]+s.streamed[key] {
+			rest = append(rest, mlog)
+		}
+	}
+	return rest
+}
+
+func metricLogKey(mlog *v1beta1.MetricLog) string {
+	return mlog.TimeStamp + "/" + mlog.Metric.Name + "/" + mlog.Metric.Value
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+type fakeStream struct {
+	grpc.ClientStream
+	closeErr error
+	sent     []*v1beta1.MetricLog
+}
+
+func (f *fakeStream) Send(req *v1beta1.StreamObservationLogRequest) error {
+	f.sent = append(f.sent, req.ObservationLog.MetricLogs...)
+	return nil
+}
+
+func (f *fakeStream) CloseAndRecv() (*v1beta1.StreamObservationLogReply, error) {
+	if f.closeErr != nil {
+		return nil, f.closeErr
+	}
+	return &v1beta1.StreamObservationLogReply{MetricLogsCount: int32(len(f.sent))}, nil
+}
+
+type fakeDBManagerClient struct {
+	v1beta1.DBManagerClient
+	stream   *fakeStream
+	reported []*v1beta1.MetricLog
+}
+
+func (f *fakeDBManagerClient) StreamObservationLog(ctx context.Context, opts ...grpc.CallOption) (v1beta1.DBManager_StreamObservationLogClient, error) {
+	return f.stream, nil
+}
+
+func (f *fakeDBManagerClient) ReportObservationLog(ctx context.Context, in *v1beta1.ReportObservationLogRequest, opts ...grpc.CallOption) (*v1beta1.ReportObservationLogReply, error) {
+	f.reported = append(f.reported, in.ObservationLog.MetricLogs...)
+	return &v1beta1.ReportObservationLogReply{}, nil
+}
+
+func newMetricLog(name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestObservationLogStreamer(t *testing.T) {
+	observationLog := &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{
+			newMetricLog("loss", "0.5"),
+			newMetricLog("loss", "0.5"),
+			newMetricLog("accuracy", "0.8"),
+			newMetricLog("loss", "0.4"),
+		},
+	}
+
+	testCases := []struct {
+		closeErr         error
+		expectedStreamed int
+		expectedReported int
+		testDesc         string
+	}{
+		{
+			expectedStreamed: 4,
+			expectedReported: 0,
+			testDesc:         "Every metric log is streamed once",
+		},
+		{
+			closeErr:         status.Error(codes.Unimplemented, "unknown method"),
+			expectedStreamed: 4,
+			expectedReported: 4,
+			testDesc:         "DB Manager without streaming",
+		},
+		{
+			closeErr:         status.Error(codes.Internal, "failed to store observation log"),
+			expectedStreamed: 4,
+			expectedReported: 4,
+			testDesc:         "DB Manager fails to store streamed metric logs",
+		},
+	}
+
+	for _, tc := range testCases {
+		client := &fakeDBManagerClient{
+			stream: &fakeStream{closeErr: tc.closeErr},
+		}
+		streamer, err := NewObservationLogStreamer(client, "test-trial", 2, time.Hour)
+		if err != nil {
+			t.Fatalf("Case: %v. NewObservationLogStreamer failed: %v", tc.testDesc, err)
+		}
+		// The first batch is sent, the third metric log is pending until the end.
+		streamer.Add(observationLog.MetricLogs[:1])
+		streamer.Add(observationLog.MetricLogs[1:3])
+		if len(client.stream.sent) != 3 {
+			t.Errorf("Case: %v. Expected 3 streamed metric logs before close, got %v", tc.testDesc, len(client.stream.sent))
+		}
+
+		if err = streamer.Close(observationLog); err != nil {
+			t.Fatalf("Case: %v. Close failed: %v", tc.testDesc, err)
+		}
+		if len(client.stream.sent) != tc.expectedStreamed {
+			t.Errorf("Case: %v. Expected %v streamed metric logs, got %v", tc.testDesc, tc.expectedStreamed, client.stream.sent)
+		}
+		if len(client.reported) != tc.expectedReported {
+			t.Errorf("Case: %v. Expected %v reported metric logs, got %v", tc.testDesc, tc.expectedReported, client.reported)
+		}
+
+		// Metric logs after close are ignored.
+		streamer.Add(observationLog.MetricLogs)
+		if len(client.stream.sent) != tc.expectedStreamed {
+			t.Errorf("Case: %v. Expected no metric logs streamed after close, got %v", tc.testDesc, client.stream.sent)
+		}
+	}
+}
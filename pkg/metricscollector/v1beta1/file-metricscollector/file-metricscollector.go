@@ -17,19 +17,27 @@ limitations under the License.
 package sidecarmetricscollector
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	"k8s.io/klog"
 )
 
-func CollectObservationLog(fileName string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
+// jsonTimestampKeys are the keys of the JSON lines, which contain the metrics timestamp.
+var jsonTimestampKeys = []string{"timestamp", "ts"}
+
+func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat) (*v1beta1.ObservationLog, error) {
 	file, err := os.Open(fileName)
 	if err != nil {
 		return nil, err
@@ -37,15 +45,56 @@ func CollectObservationLog(fileName string, metrics []string, filters []string)
 	defer file.Close()
 	content, err := ioutil.ReadAll(file)
 	logs := string(content)
-	olog, err := parseLogs(strings.Split(logs, "\n"), metrics, filters)
+	olog, err := parseLogs(strings.Split(logs, "\n"), metrics, filters, fileFormat)
 	return olog, err
 }
 
-func parseLogs(logs []string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
+func parseLogs(logs []string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat) (*v1beta1.ObservationLog, error) {
 	olog := &v1beta1.ObservationLog{}
-	metricRegList := GetFilterRegexpList(filters)
-	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
+	mlogs := ParseMetricLogsWithFormat(logs, metrics, GetFilterRegexpList(filters), fileFormat)
 
+	// Metrics logs must contain at least one objective metric value
+	// Objective metric is located at first index
+	isObjectiveMetricReported := false
+	for _, mLog := range mlogs {
+		if mLog.Metric.Name == metrics[0] {
+			isObjectiveMetricReported = true
+			break
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB
+	if !isObjectiveMetricReported {
+		olog.MetricLogs = []*v1beta1.MetricLog{
+			{
+				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				Metric: &v1beta1.Metric{
+					Name:  metrics[0],
+					Value: consts.UnavailableMetricValue,
+				},
+			},
+		}
+		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+	} else {
+		olog.MetricLogs = mlogs
+	}
+
+	return olog, nil
+}
+
+// ParseMetricLogsWithFormat returns the metric logs of the log lines in the file format.
+// Metric filters are used only for the TEXT format.
+func ParseMetricLogsWithFormat(logs []string, metrics []string, metricRegList []*regexp.Regexp, fileFormat commonv1beta1.FileFormat) []*v1beta1.MetricLog {
+	if fileFormat == commonv1beta1.JsonFormat {
+		return ParseJSONMetricLogs(logs, metrics)
+	}
+	return ParseMetricLogs(logs, metrics, metricRegList)
+}
+
+// ParseMetricLogs returns the metric logs of the log lines, which match the metric filters.
+// Unlike CollectObservationLog, it does not check that the objective metric is reported,
+// so it can be used for the lines of a running training.
+func ParseMetricLogs(logs []string, metrics []string, metricRegList []*regexp.Regexp) []*v1beta1.MetricLog {
+	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
 	for _, logline := range logs {
 		// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
 		isMetricLine := false
@@ -95,32 +144,102 @@ func parseLogs(logs []string, metrics []string, filters []string) (*v1beta1.Obse
 			}
 		}
 	}
-	// Metrics logs must contain at least one objective metric value
-	// Objective metric is located at first index
-	isObjectiveMetricReported := false
-	for _, mLog := range mlogs {
-		if mLog.Metric.Name == metrics[0] {
-			isObjectiveMetricReported = true
-			break
+	return mlogs
+}
+
+// ParseJSONMetricLogs returns the metric logs of the JSON log lines.
+// Metric name is the key of the JSON object, keys of the nested objects are joined with ".".
+func ParseJSONMetricLogs(logs []string, metrics []string) []*v1beta1.MetricLog {
+	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
+	for _, logline := range logs {
+		logline = strings.TrimSpace(logline)
+		if !strings.HasPrefix(logline, "{") {
+			continue
 		}
-	}
-	// If objective metrics were not reported, insert unavailable value in the DB
-	if !isObjectiveMetricReported {
-		olog.MetricLogs = []*v1beta1.MetricLog{
-			{
-				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+		decoder := json.NewDecoder(bytes.NewReader([]byte(logline)))
+		// Use number to keep the reported metric value as it is.
+		decoder.UseNumber()
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err != nil {
+			klog.Warningf("Failed to parse JSON line %s: %v", logline, err)
+			continue
+		}
+		values := make(map[string]interface{})
+		flattenJSONObject("", object, values)
+
+		timestamp := time.Time{}.UTC().Format(time.RFC3339)
+		for _, key := range jsonTimestampKeys {
+			if v, ok := values[key]; ok {
+				if ts, err := parseJSONTimestamp(v); err != nil {
+					klog.Warningf("Metrics will not have timestamp since error parsing time %v: %v", v, err)
+				} else {
+					timestamp = ts
+				}
+				break
+			}
+		}
+
+		// Metrics are added in the order of metric names, since the order of JSON keys is not kept.
+		for _, m := range metrics {
+			v, ok := values[m]
+			if !ok {
+				continue
+			}
+			var value string
+			switch tv := v.(type) {
+			case json.Number:
+				value = tv.String()
+			case string:
+				value = tv
+			default:
+				klog.Warningf("Metric %v has unsupported value %v", m, v)
+				continue
+			}
+			mlogs = append(mlogs, &v1beta1.MetricLog{
+				TimeStamp: timestamp,
 				Metric: &v1beta1.Metric{
-					Name:  metrics[0],
-					Value: consts.UnavailableMetricValue,
+					Name:  m,
+					Value: value,
 				},
-			},
+			})
+		}
+	}
+	return mlogs
+}
+
+// flattenJSONObject adds values of the object and its nested objects to the values map.
+func flattenJSONObject(prefix string, object map[string]interface{}, values map[string]interface{}) {
+	for k, v := range object {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenJSONObject(key, nested, values)
+		} else {
+			values[key] = v
 		}
-		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", metrics[0], consts.UnavailableMetricValue)
-	} else {
-		olog.MetricLogs = mlogs
 	}
+}
 
-	return olog, nil
+// parseJSONTimestamp returns RFC3339 timestamp from RFC3339 string or Unix time in seconds.
+func parseJSONTimestamp(v interface{}) (string, error) {
+	switch tv := v.(type) {
+	case string:
+		if _, err := time.Parse(time.RFC3339Nano, tv); err != nil {
+			return "", err
+		}
+		return tv, nil
+	case json.Number:
+		seconds, err := strconv.ParseFloat(tv.String(), 64)
+		if err != nil {
+			return "", err
+		}
+		sec := int64(seconds)
+		nsec := int64((seconds - float64(sec)) * float64(time.Second))
+		return time.Unix(sec, nsec).UTC().Format(time.RFC3339Nano), nil
+	}
+	return "", fmt.Errorf("Timestamp must be RFC3339 string or Unix time, got %v", v)
 }
 
 // GetFilterRegexpList returns Regexp array from filters string array
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecarmetricscollector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func newMetricLog(timestamp, name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestParseJSONMetricLogs(t *testing.T) {
+	emptyTimestamp := time.Time{}.UTC().Format(time.RFC3339)
+
+	testCases := []struct {
+		logs          []string
+		metrics       []string
+		expectedMlogs []*v1beta1.MetricLog
+		testDesc      string
+	}{
+		{
+			logs: []string{
+				`{"step": 10, "loss": 0.3, "accuracy": 0.8, "ts": "2021-01-01T00:00:10Z"}`,
+				`{"step": 20, "loss": 0.2, "ts": "2021-01-01T00:00:20Z"}`,
+			},
+			metrics: []string{"accuracy", "loss"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2021-01-01T00:00:10Z", "accuracy", "0.8"),
+				newMetricLog("2021-01-01T00:00:10Z", "loss", "0.3"),
+				newMetricLog("2021-01-01T00:00:20Z", "loss", "0.2"),
+			},
+			testDesc: "Metrics with RFC3339 timestamp",
+		},
+		{
+			logs: []string{
+				`{"timestamp": 1609459200.5, "train": {"loss": 1e-3}, "validation": {"loss": "0.01"}}`,
+			},
+			metrics: []string{"validation.loss", "train.loss"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2021-01-01T00:00:00.5Z", "validation.loss", "0.01"),
+				newMetricLog("2021-01-01T00:00:00.5Z", "train.loss", "1e-3"),
+			},
+			testDesc: "Nested metrics with Unix timestamp",
+		},
+		{
+			logs: []string{
+				"Epoch 1 loss=0.5",
+				`{"loss": 0.4, "ts": "yesterday"}`,
+				`{"loss": [0.3]}`,
+				`{"loss": 0.2`,
+				"",
+			},
+			metrics: []string{"loss"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog(emptyTimestamp, "loss", "0.4"),
+			},
+			testDesc: "Invalid lines are skipped",
+		},
+	}
+
+	for _, tc := range testCases {
+		mlogs := ParseJSONMetricLogs(tc.logs, tc.metrics)
+		if !reflect.DeepEqual(mlogs, tc.expectedMlogs) {
+			t.Errorf("Case: %v. Expected metric logs %v, got %v", tc.testDesc, tc.expectedMlogs, mlogs)
+		}
+	}
+}
+
+func TestCollectObservationLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "katib-metrics")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	textFile := filepath.Join(dir, "metrics.log")
+	textLogs := "2021-01-01T00:00:10Z accuracy=0.8\n2021-01-01T00:00:20Z loss=0.2\n"
+	if err = ioutil.WriteFile(textFile, []byte(textLogs), 0644); err != nil {
+		t.Fatalf("Failed to write metrics file: %v", err)
+	}
+	jsonFile := filepath.Join(dir, "metrics.json")
+	jsonLogs := `{"ts": "2021-01-01T00:00:10Z", "accuracy": 0.8}` + "\n" + `{"ts": "2021-01-01T00:00:20Z", "loss": 0.2}` + "\n"
+	if err = ioutil.WriteFile(jsonFile, []byte(jsonLogs), 0644); err != nil {
+		t.Fatalf("Failed to write metrics file: %v", err)
+	}
+
+	expectedMlogs := []*v1beta1.MetricLog{
+		newMetricLog("2021-01-01T00:00:10Z", "accuracy", "0.8"),
+		newMetricLog("2021-01-01T00:00:20Z", "loss", "0.2"),
+	}
+
+	testCases := []struct {
+		fileName      string
+		metrics       []string
+		fileFormat    commonv1beta1.FileFormat
+		expectedMlogs []*v1beta1.MetricLog
+		testDesc      string
+	}{
+		{
+			fileName:      textFile,
+			metrics:       []string{"accuracy", "loss"},
+			fileFormat:    commonv1beta1.TextFormat,
+			expectedMlogs: expectedMlogs,
+			testDesc:      "Text file format",
+		},
+		{
+			fileName:      jsonFile,
+			metrics:       []string{"accuracy", "loss"},
+			fileFormat:    commonv1beta1.JsonFormat,
+			expectedMlogs: expectedMlogs,
+			testDesc:      "JSON file format",
+		},
+		{
+			fileName:   jsonFile,
+			metrics:    []string{"f1", "loss"},
+			fileFormat: commonv1beta1.JsonFormat,
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog(time.Time{}.UTC().Format(time.RFC3339), "f1", consts.UnavailableMetricValue),
+			},
+			testDesc: "Objective metric is not reported",
+		},
+	}
+
+	for _, tc := range testCases {
+		olog, err := CollectObservationLog(tc.fileName, tc.metrics, nil, tc.fileFormat)
+		if err != nil {
+			t.Errorf("Case: %v. Failed to collect observation log: %v", tc.testDesc, err)
+			continue
+		}
+		if !reflect.DeepEqual(olog.MetricLogs, tc.expectedMlogs) {
+			t.Errorf("Case: %v. Expected metric logs %v, got %v", tc.testDesc, tc.expectedMlogs, olog.MetricLogs)
+		}
+	}
+}
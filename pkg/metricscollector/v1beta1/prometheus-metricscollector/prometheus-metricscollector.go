@@ -0,0 +1,299 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package prometheusmetricscollector scrapes metrics of the training container in Prometheus text format.
+Metric name in the Experiment objective is the name of the gauge with optional label selectors,
+e.g. accuracy{phase="validation"}. Gauge value is reported each time it is changed.
+Metrics are scraped while the training is running, so the last value must be exposed
+at least for the scrape interval before the training process exits.
+*/
+package prometheusmetricscollector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	// DefaultScrapeInterval is the default interval between the metrics endpoint scrapes.
+	DefaultScrapeInterval = 5 * time.Second
+	// DefaultScrapeTimeout is the default timeout of the metrics endpoint scrape.
+	DefaultScrapeTimeout = 5 * time.Second
+)
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelRegexp      = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*=\s*("(?:[^"\\]|\\.)*")\s*(?:,|$)`)
+)
+
+// MetricSelector selects the gauge by name and label values.
+type MetricSelector struct {
+	// Name is the metric name in the Experiment, e.g. accuracy{phase="validation"}.
+	Name string
+	// Metric is the Prometheus metric name, e.g. accuracy.
+	Metric string
+	// Labels are the label values of the selected series.
+	Labels map[string]string
+}
+
+// ParseMetricSelector parses metric name with optional label selectors, e.g. accuracy{phase="validation"}.
+func ParseMetricSelector(name string) (*MetricSelector, error) {
+	selector := &MetricSelector{
+		Name:   name,
+		Metric: name,
+		Labels: make(map[string]string),
+	}
+	if i := strings.Index(name, "{"); i >= 0 {
+		if !strings.HasSuffix(name, "}") {
+			return nil, fmt.Errorf("Label selectors of metric %v must be enclosed in braces", name)
+		}
+		selector.Metric = name[:i]
+		labels := name[i+1 : len(name)-1]
+		for strings.TrimSpace(labels) != "" {
+			match := labelRegexp.FindStringSubmatch(labels)
+			if match == nil {
+				return nil, fmt.Errorf("Invalid label selectors %v of metric %v, label=\"value\" format is supported", labels, name)
+			}
+			value, err := strconv.Unquote(match[2])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid label value %v of metric %v: %v", match[2], name, err)
+			}
+			selector.Labels[match[1]] = value
+			labels = labels[len(match[0]):]
+		}
+	}
+	if !metricNameRegexp.MatchString(selector.Metric) {
+		return nil, fmt.Errorf("Invalid Prometheus metric name %v", selector.Metric)
+	}
+	return selector, nil
+}
+
+// ParseMetricSelectors parses all metric names.
+func ParseMetricSelectors(names []string) ([]*MetricSelector, error) {
+	selectors := make([]*MetricSelector, 0, len(names))
+	for _, name := range names {
+		selector, err := ParseMetricSelector(name)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors, nil
+}
+
+// matches returns true if the series has all labels of the selector.
+func (s *MetricSelector) matches(metric *dto.Metric) bool {
+	for name, value := range s.Labels {
+		found := false
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == name && label.GetValue() == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sample is the value of the selected series.
+type sample struct {
+	name  string
+	value string
+	// timestampMs is set if the timestamp is exposed with the value.
+	timestampMs *int64
+}
+
+// parseSamples returns the values of the selected gauges in Prometheus text format.
+func parseSamples(r io.Reader, selectors []*MetricSelector) ([]sample, error) {
+	parser := &expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse metrics: %v", err)
+	}
+
+	samples := make([]sample, 0, len(selectors))
+	for _, selector := range selectors {
+		family, ok := families[selector.Metric]
+		if !ok {
+			continue
+		}
+		if family.GetType() != dto.MetricType_GAUGE && family.GetType() != dto.MetricType_UNTYPED {
+			klog.Warningf("Metric %v has type %v, only gauges are supported", selector.Metric, family.GetType())
+			continue
+		}
+		var selected *dto.Metric
+		for _, metric := range family.GetMetric() {
+			if !selector.matches(metric) {
+				continue
+			}
+			if selected != nil {
+				klog.Warningf("Multiple series are selected by metric %v, the first series is reported", selector.Name)
+				break
+			}
+			selected = metric
+		}
+		if selected == nil {
+			continue
+		}
+
+		value := selected.GetGauge().GetValue()
+		if family.GetType() == dto.MetricType_UNTYPED {
+			value = selected.GetUntyped().GetValue()
+		}
+		samples = append(samples, sample{
+			name:        selector.Name,
+			value:       strconv.FormatFloat(value, 'g', -1, 64),
+			timestampMs: selected.TimestampMs,
+		})
+	}
+	return samples, nil
+}
+
+// toMetricLog returns the metric log of the sample.
+// Timestamp of the sample is used if it is exposed, otherwise scrapeTime is used.
+func (s sample) toMetricLog(scrapeTime time.Time) *v1beta1.MetricLog {
+	timestamp := scrapeTime
+	if s.timestampMs != nil {
+		timestamp = time.Unix(0, *s.timestampMs*int64(time.Millisecond))
+	}
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp.UTC().Format(time.RFC3339Nano),
+		Metric: &v1beta1.Metric{
+			Name:  s.name,
+			Value: s.value,
+		},
+	}
+}
+
+// key returns the value with the exposed timestamp to find the changed samples.
+func (s sample) key() string {
+	if s.timestampMs == nil {
+		return s.value
+	}
+	return fmt.Sprintf("%v@%v", s.value, *s.timestampMs)
+}
+
+// ParseMetricLogs returns the metric logs of the selected gauges in Prometheus text format.
+// Timestamp of the sample is used if it is exposed, otherwise scrapeTime is used.
+func ParseMetricLogs(r io.Reader, selectors []*MetricSelector, scrapeTime time.Time) ([]*v1beta1.MetricLog, error) {
+	samples, err := parseSamples(r, selectors)
+	if err != nil {
+		return nil, err
+	}
+	mlogs := make([]*v1beta1.MetricLog, 0, len(samples))
+	for _, s := range samples {
+		mlogs = append(mlogs, s.toMetricLog(scrapeTime))
+	}
+	return mlogs, nil
+}
+
+// Scraper scrapes the metrics endpoint and returns the changed metric values.
+type Scraper struct {
+	url       string
+	client    *http.Client
+	selectors []*MetricSelector
+	// lastValues contains the last reported sample key for each metric name.
+	lastValues map[string]string
+}
+
+// NewScraper returns the scraper of the metrics endpoint.
+func NewScraper(url string, selectors []*MetricSelector, timeout time.Duration) *Scraper {
+	return &Scraper{
+		url:        url,
+		client:     &http.Client{Timeout: timeout},
+		selectors:  selectors,
+		lastValues: make(map[string]string),
+	}
+}
+
+// Scrape returns the metric logs whose values are changed since the previous scrape.
+// Sample with the exposed timestamp is reported also if only the timestamp is changed.
+func (s *Scraper) Scrape(ctx context.Context) ([]*v1beta1.MetricLog, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+	scrapeTime := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Scrape %v returned status %v", s.url, resp.Status)
+	}
+
+	samples, err := parseSamples(resp.Body, s.selectors)
+	if err != nil {
+		return nil, err
+	}
+	mlogs := make([]*v1beta1.MetricLog, 0, len(samples))
+	for _, sample := range samples {
+		if last, ok := s.lastValues[sample.name]; ok && last == sample.key() {
+			continue
+		}
+		s.lastValues[sample.name] = sample.key()
+		mlogs = append(mlogs, sample.toMetricLog(scrapeTime))
+	}
+	return mlogs, nil
+}
+
+// CollectObservationLog returns the observation log of the scraped metric logs.
+func CollectObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
+	// Metrics logs must contain at least one objective metric value
+	// Objective metric is located at first index
+	isObjectiveMetricReported := false
+	for _, mLog := range mlogs {
+		if mLog.Metric.Name == metrics[0] {
+			isObjectiveMetricReported = true
+			break
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB
+	if !isObjectiveMetricReported {
+		klog.Infof("Objective metric %v is not found in scraped metrics, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+		return &v1beta1.ObservationLog{
+			MetricLogs: []*v1beta1.MetricLog{
+				{
+					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+					Metric: &v1beta1.Metric{
+						Name:  metrics[0],
+						Value: consts.UnavailableMetricValue,
+					},
+				},
+			},
+		}
+	}
+	return &v1beta1.ObservationLog{MetricLogs: mlogs}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const testMetrics = `# HELP accuracy Model accuracy.
+# TYPE accuracy gauge
+accuracy{phase="train"} 0.9
+accuracy{phase="validation"} 0.75
+# TYPE loss gauge
+loss 0.25 1609459200000
+# TYPE steps_total counter
+steps_total 100
+`
+
+func newMetricLog(timestamp, name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestParseMetricSelector(t *testing.T) {
+	testCases := []struct {
+		name             string
+		expectedSelector *MetricSelector
+		expectedErr      bool
+		testDesc         string
+	}{
+		{
+			name: "accuracy",
+			expectedSelector: &MetricSelector{
+				Name:   "accuracy",
+				Metric: "accuracy",
+				Labels: map[string]string{},
+			},
+			testDesc: "Metric without label selectors",
+		},
+		{
+			name: `accuracy{phase="validation", model="a,b"}`,
+			expectedSelector: &MetricSelector{
+				Name:   `accuracy{phase="validation", model="a,b"}`,
+				Metric: "accuracy",
+				Labels: map[string]string{
+					"phase": "validation",
+					"model": "a,b",
+				},
+			},
+			testDesc: "Metric with label selectors",
+		},
+		{
+			name:        "train-loss",
+			expectedErr: true,
+			testDesc:    "Invalid metric name",
+		},
+		{
+			name:        `accuracy{phase=validation}`,
+			expectedErr: true,
+			testDesc:    "Label value is not quoted",
+		},
+		{
+			name:        `accuracy{phase="validation"`,
+			expectedErr: true,
+			testDesc:    "Label selectors are not closed",
+		},
+	}
+
+	for _, tc := range testCases {
+		selector, err := ParseMetricSelector(tc.name)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("Case: %v. Expected error, got nil", tc.testDesc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Case: %v. Unexpected error: %v", tc.testDesc, err)
+		} else if !reflect.DeepEqual(selector, tc.expectedSelector) {
+			t.Errorf("Case: %v. Expected selector %v, got %v", tc.testDesc, tc.expectedSelector, selector)
+		}
+	}
+}
+
+func TestParseMetricLogs(t *testing.T) {
+	scrapeTime := time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)
+	testCases := []struct {
+		metrics       []string
+		expectedMlogs []*v1beta1.MetricLog
+		testDesc      string
+	}{
+		{
+			metrics: []string{`accuracy{phase="validation"}`, "loss"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2021-01-01T00:01:00Z", `accuracy{phase="validation"}`, "0.75"),
+				newMetricLog("2021-01-01T00:00:00Z", "loss", "0.25"),
+			},
+			testDesc: "Gauges with label selectors and timestamp",
+		},
+		{
+			metrics:       []string{"steps_total", "f1"},
+			expectedMlogs: []*v1beta1.MetricLog{},
+			testDesc:      "Counter and missing metrics are not reported",
+		},
+	}
+
+	for _, tc := range testCases {
+		selectors, err := ParseMetricSelectors(tc.metrics)
+		if err != nil {
+			t.Fatalf("Case: %v. Failed to parse metric selectors: %v", tc.testDesc, err)
+		}
+		mlogs, err := ParseMetricLogs(strings.NewReader(testMetrics), selectors, scrapeTime)
+		if err != nil {
+			t.Errorf("Case: %v. Failed to parse metric logs: %v", tc.testDesc, err)
+		} else if !reflect.DeepEqual(mlogs, tc.expectedMlogs) {
+			t.Errorf("Case: %v. Expected metric logs %v, got %v", tc.testDesc, tc.expectedMlogs, mlogs)
+		}
+	}
+}
+
+func TestScrape(t *testing.T) {
+	accuracy := "0.5"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE accuracy gauge\naccuracy %v\n", accuracy)
+	}))
+	defer server.Close()
+
+	selectors, err := ParseMetricSelectors([]string{"accuracy"})
+	if err != nil {
+		t.Fatalf("Failed to parse metric selectors: %v", err)
+	}
+	scraper := NewScraper(server.URL, selectors, DefaultScrapeTimeout)
+
+	// Only changed values are reported.
+	expectedValues := [][]string{{"0.5"}, {}, {"0.75"}}
+	for i, expected := range expectedValues {
+		if i == 2 {
+			accuracy = "0.75"
+		}
+		mlogs, err := scraper.Scrape(context.Background())
+		if err != nil {
+			t.Fatalf("Scrape %v failed: %v", i, err)
+		}
+		values := []string{}
+		for _, mlog := range mlogs {
+			values = append(values, mlog.Metric.Value)
+		}
+		if !reflect.DeepEqual(values, expected) {
+			t.Errorf("Scrape %v. Expected values %v, got %v", i, expected, values)
+		}
+	}
+}
+
+func TestCollectObservationLog(t *testing.T) {
+	mlogs := []*v1beta1.MetricLog{
+		newMetricLog("2021-01-01T00:00:00Z", "loss", "0.25"),
+	}
+	olog := CollectObservationLog(mlogs, []string{"accuracy", "loss"})
+	expectedMlogs := []*v1beta1.MetricLog{
+		newMetricLog(time.Time{}.UTC().Format(time.RFC3339), "accuracy", consts.UnavailableMetricValue),
+	}
+	if !reflect.DeepEqual(olog.MetricLogs, expectedMlogs) {
+		t.Errorf("Expected metric logs %v, got %v", expectedMlogs, olog.MetricLogs)
+	}
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the TensorFlow protos, which are required to decode scalar summaries.
+// See tensorflow/core/util/event.proto, tensorflow/core/framework/summary.proto
+// and tensorflow/core/framework/tensor.proto.
+const (
+	eventWallTimeField = 1
+	eventStepField     = 2
+	eventSummaryField  = 5
+
+	summaryValueField = 1
+
+	valueTagField         = 1
+	valueSimpleValueField = 2
+	valueTensorField      = 8
+	valueMetadataField    = 9
+
+	metadataPluginDataField = 1
+	pluginDataNameField     = 1
+
+	tensorDtypeField     = 1
+	tensorContentField   = 4
+	tensorFloatValField  = 5
+	tensorDoubleValField = 6
+	tensorIntValField    = 7
+	tensorInt64ValField  = 10
+)
+
+// TensorFlow data types of the scalar tensors.
+const (
+	dtFloat  = 1
+	dtDouble = 2
+	dtInt32  = 3
+	dtInt64  = 9
+)
+
+// scalarsPluginName is the TensorBoard plugin name of the scalar summaries in TensorFlow 2.
+const scalarsPluginName = "scalars"
+
+// scalarValue is the scalar summary value of the event.
+type scalarValue struct {
+	tag   string
+	value string
+}
+
+// event is the TensorFlow event with the scalar summary values.
+type event struct {
+	wallTime float64
+	step     int64
+	values   []scalarValue
+}
+
+// fieldFunc is called for each field of the proto message.
+type fieldFunc func(num protowire.Number, typ protowire.Type, b []byte) (int, error)
+
+// parseMessage calls the function for each field of the proto message.
+// The function returns the number of consumed bytes, if it is negative the field is skipped.
+func parseMessage(b []byte, f fieldFunc) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n, err := f(num, typ, b)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// consumeBytes returns the bytes of the length-delimited field.
+func consumeBytes(typ protowire.Type, b []byte) ([]byte, int, error) {
+	if typ != protowire.BytesType {
+		return nil, 0, fmt.Errorf("Unexpected wire type %v of the length-delimited field", typ)
+	}
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return nil, 0, protowire.ParseError(n)
+	}
+	return v, n, nil
+}
+
+// parseEvent decodes the serialized Event proto.
+func parseEvent(b []byte) (*event, error) {
+	e := &event{}
+	err := parseMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == eventWallTimeField && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			e.wallTime = math.Float64frombits(v)
+			return n, nil
+		case num == eventStepField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			e.step = int64(v)
+			return n, nil
+		case num == eventSummaryField:
+			summary, n, err := consumeBytes(typ, b)
+			if err != nil {
+				return 0, err
+			}
+			return n, parseSummary(summary, e)
+		}
+		return -1, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// parseSummary adds the scalar values of the serialized Summary proto to the event.
+func parseSummary(b []byte, e *event) error {
+	return parseMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if num != summaryValueField {
+			return -1, nil
+		}
+		value, n, err := consumeBytes(typ, b)
+		if err != nil {
+			return 0, err
+		}
+		v, ok, err := parseSummaryValue(value)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			e.values = append(e.values, v)
+		}
+		return n, nil
+	})
+}
+
+// parseSummaryValue decodes the serialized Summary.Value proto.
+// It returns false if the value is not a scalar.
+func parseSummaryValue(b []byte) (scalarValue, bool, error) {
+	v := scalarValue{}
+	isScalar := false
+	pluginName := ""
+	var tensor []byte
+	err := parseMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == valueTagField:
+			tag, n, err := consumeBytes(typ, b)
+			v.tag = string(tag)
+			return n, err
+		case num == valueSimpleValueField && typ == protowire.Fixed32Type:
+			simpleValue, n := protowire.ConsumeFixed32(b)
+			v.value = formatFloat32(simpleValue)
+			isScalar = true
+			return n, nil
+		case num == valueTensorField:
+			t, n, err := consumeBytes(typ, b)
+			tensor = t
+			return n, err
+		case num == valueMetadataField:
+			metadata, n, err := consumeBytes(typ, b)
+			if err != nil {
+				return 0, err
+			}
+			pluginName, err = parsePluginName(metadata)
+			return n, err
+		}
+		return -1, nil
+	})
+	if err != nil || isScalar {
+		return v, isScalar, err
+	}
+	// TensorFlow 2 writes scalars as the tensors with one element.
+	// Metadata with the plugin name can be reported only with the first value of the tag.
+	if tensor == nil || (pluginName != "" && pluginName != scalarsPluginName) {
+		return v, false, nil
+	}
+	value, ok, err := parseScalarTensor(tensor)
+	if err != nil || !ok {
+		return v, false, err
+	}
+	v.value = value
+	return v, true, nil
+}
+
+// parsePluginName returns the plugin name of the serialized SummaryMetadata proto.
+func parsePluginName(b []byte) (string, error) {
+	pluginName := ""
+	err := parseMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		if num != metadataPluginDataField {
+			return -1, nil
+		}
+		pluginData, n, err := consumeBytes(typ, b)
+		if err != nil {
+			return 0, err
+		}
+		return n, parseMessage(pluginData, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+			if num != pluginDataNameField {
+				return -1, nil
+			}
+			name, n, err := consumeBytes(typ, b)
+			pluginName = string(name)
+			return n, err
+		})
+	})
+	return pluginName, err
+}
+
+// parseScalarTensor returns the value of the serialized TensorProto proto.
+// It returns false if the tensor doesn't have exactly one numeric element.
+func parseScalarTensor(b []byte) (string, bool, error) {
+	var dtype uint64
+	var content []byte
+	values := []string{}
+	err := parseMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case tensorDtypeField:
+			v, n := protowire.ConsumeVarint(b)
+			dtype = v
+			return n, nil
+		case tensorContentField:
+			c, n, err := consumeBytes(typ, b)
+			content = c
+			return n, err
+		case tensorFloatValField, tensorDoubleValField, tensorIntValField, tensorInt64ValField:
+			return consumeRepeatedValues(num, typ, b, &values)
+		}
+		return -1, nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	if content != nil {
+		switch {
+		case dtype == dtFloat && len(content) == 4:
+			return formatFloat32(binary.LittleEndian.Uint32(content)), true, nil
+		case dtype == dtDouble && len(content) == 8:
+			return strconv.FormatFloat(math.Float64frombits(binary.LittleEndian.Uint64(content)), 'g', -1, 64), true, nil
+		case dtype == dtInt32 && len(content) == 4:
+			return strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(content))), 10), true, nil
+		case dtype == dtInt64 && len(content) == 8:
+			return strconv.FormatInt(int64(binary.LittleEndian.Uint64(content)), 10), true, nil
+		}
+		return "", false, nil
+	}
+	if len(values) != 1 {
+		return "", false, nil
+	}
+	return values[0], true, nil
+}
+
+// consumeRepeatedValues adds the packed or unpacked numeric values of the TensorProto field to the values.
+func consumeRepeatedValues(num protowire.Number, typ protowire.Type, b []byte, values *[]string) (int, error) {
+	consume := func(b []byte) int {
+		switch num {
+		case tensorFloatValField:
+			v, n := protowire.ConsumeFixed32(b)
+			if n >= 0 {
+				*values = append(*values, formatFloat32(v))
+			}
+			return n
+		case tensorDoubleValField:
+			v, n := protowire.ConsumeFixed64(b)
+			if n >= 0 {
+				*values = append(*values, strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64))
+			}
+			return n
+		default:
+			v, n := protowire.ConsumeVarint(b)
+			// Negative int32 values are sign-extended to 64 bits.
+			if n >= 0 {
+				*values = append(*values, strconv.FormatInt(int64(v), 10))
+			}
+			return n
+		}
+	}
+
+	if typ != protowire.BytesType {
+		n := consume(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		return n, nil
+	}
+	packed, n, err := consumeBytes(typ, b)
+	if err != nil {
+		return 0, err
+	}
+	for len(packed) > 0 {
+		m := consume(packed)
+		if m < 0 {
+			return 0, protowire.ParseError(m)
+		}
+		packed = packed[m:]
+	}
+	return n, nil
+}
+
+func formatFloat32(bits uint32) string {
+	return strconv.FormatFloat(float64(math.Float32frombits(bits)), 'g', -1, 32)
+}
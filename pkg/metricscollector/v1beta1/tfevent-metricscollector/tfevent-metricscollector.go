@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package tfeventmetricscollector parses TensorFlow event files and returns an ObservationLog of the metrics.
+When the event file is under a directory (e.g. test dir), please specify "{{dirname}}/{{metrics name}}".
+For example, in the kubeflow tf-operator tutorial for mnist with summary
+(https://github.com/kubeflow/tf-operator/blob/master/examples/v1/mnist_with_summaries/mnist_with_summaries.py),
+the "accuracy" metric is saved under "train" and "test" directories.
+So in the Metrics Collector specification, please specify name of "train" or "test" directory.
+*/
+package tfeventmetricscollector
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// CollectObservationLog returns the metric logs of all event files in the directory.
+func CollectObservationLog(dir string, metrics []string) (*v1beta1.ObservationLog, error) {
+	mlogs := []*v1beta1.MetricLog{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip marker files of the completed processes.
+		if info.IsDir() || strings.HasSuffix(path, ".pid") {
+			return nil
+		}
+		klog.Infof("%v will be parsed", path)
+		fileMlogs, err := ParseEventFile(path, metrics)
+		if err != nil {
+			klog.Warningf("Failed to parse event file %v: %v", path, err)
+		}
+		// Metric logs before the invalid record are reported.
+		mlogs = append(mlogs, fileMlogs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Metrics logs must contain at least one objective metric value
+	// Objective metric is located at first index
+	isObjectiveMetricReported := false
+	for _, mLog := range mlogs {
+		if mLog.Metric.Name == metrics[0] {
+			isObjectiveMetricReported = true
+			break
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB
+	if !isObjectiveMetricReported {
+		mlogs = []*v1beta1.MetricLog{
+			{
+				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+				Metric: &v1beta1.Metric{
+					Name:  metrics[0],
+					Value: consts.UnavailableMetricValue,
+				},
+			},
+		}
+		klog.Infof("Objective metric %v is not found in event files, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+	}
+	return &v1beta1.ObservationLog{MetricLogs: mlogs}, nil
+}
+
+// ParseEventFile returns the metric logs of the scalar summaries in the event file.
+// If the file has invalid record, metric logs of the previous records are returned with the error.
+func ParseEventFile(path string, metrics []string) ([]*v1beta1.MetricLog, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dirName := filepath.Base(filepath.Dir(path))
+	mlogs := []*v1beta1.MetricLog{}
+	reader := NewTFRecordReader(file)
+	for {
+		record, err := reader.ReadRecord()
+		if err == io.EOF {
+			return mlogs, nil
+		}
+		if err != nil {
+			return mlogs, err
+		}
+		e, err := parseEvent(record)
+		if err != nil {
+			return mlogs, err
+		}
+		timestamp := wallTimeToTimestamp(e.wallTime)
+		for _, v := range e.values {
+			for _, m := range metrics {
+				tag := v.tag
+				// Metric with "/" contains the directory name of the event file.
+				if strings.Contains(m, "/") {
+					tag = dirName + "/" + v.tag
+				}
+				if !strings.HasPrefix(tag, m) {
+					continue
+				}
+				mlogs = append(mlogs, &v1beta1.MetricLog{
+					TimeStamp: timestamp,
+					Metric: &v1beta1.Metric{
+						Name:  m,
+						Value: v.value,
+					},
+				})
+			}
+		}
+	}
+}
+
+// wallTimeToTimestamp returns RFC3339 timestamp of the event wall time in seconds.
+func wallTimeToTimestamp(wallTime float64) string {
+	sec := int64(wallTime)
+	nsec := int64((wallTime - float64(sec)) * float64(time.Second))
+	return time.Unix(sec, nsec).UTC().Format(time.RFC3339Nano)
+}
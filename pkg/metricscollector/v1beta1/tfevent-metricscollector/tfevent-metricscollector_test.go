@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// writeRecord writes data as the TFRecord record.
+func writeRecord(w io.Writer, data []byte) {
+	header := make([]byte, 12)
+	binary.LittleEndian.PutUint64(header[0:8], uint64(len(data)))
+	binary.LittleEndian.PutUint32(header[8:12], maskedCRC(header[0:8]))
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, maskedCRC(data))
+	w.Write(header)
+	w.Write(data)
+	w.Write(footer)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// newEvent returns the serialized Event proto with the summary values.
+func newEvent(wallTime float64, step int64, values ...[]byte) []byte {
+	summary := []byte{}
+	for _, v := range values {
+		summary = appendBytesField(summary, summaryValueField, v)
+	}
+	b := protowire.AppendTag(nil, eventWallTimeField, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, math.Float64bits(wallTime))
+	b = protowire.AppendTag(b, eventStepField, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(step))
+	return appendBytesField(b, eventSummaryField, summary)
+}
+
+// newSimpleValue returns the serialized Summary.Value proto with the simple value.
+func newSimpleValue(tag string, value float32) []byte {
+	b := appendBytesField(nil, valueTagField, []byte(tag))
+	b = protowire.AppendTag(b, valueSimpleValueField, protowire.Fixed32Type)
+	return protowire.AppendFixed32(b, math.Float32bits(value))
+}
+
+// newTensorValue returns the serialized Summary.Value proto with the tensor and the plugin name.
+func newTensorValue(tag string, pluginName string, tensor []byte) []byte {
+	b := appendBytesField(nil, valueTagField, []byte(tag))
+	if pluginName != "" {
+		pluginData := appendBytesField(nil, pluginDataNameField, []byte(pluginName))
+		b = appendBytesField(b, valueMetadataField, appendBytesField(nil, metadataPluginDataField, pluginData))
+	}
+	return appendBytesField(b, valueTensorField, tensor)
+}
+
+// newFloatTensor returns the serialized TensorProto proto with the float value in tensor content.
+func newFloatTensor(value float32) []byte {
+	content := make([]byte, 4)
+	binary.LittleEndian.PutUint32(content, math.Float32bits(value))
+	b := protowire.AppendTag(nil, tensorDtypeField, protowire.VarintType)
+	b = protowire.AppendVarint(b, dtFloat)
+	return appendBytesField(b, tensorContentField, content)
+}
+
+// newDoubleTensor returns the serialized TensorProto proto with the packed double values.
+func newDoubleTensor(values ...float64) []byte {
+	packed := []byte{}
+	for _, v := range values {
+		packed = protowire.AppendFixed64(packed, math.Float64bits(v))
+	}
+	b := protowire.AppendTag(nil, tensorDtypeField, protowire.VarintType)
+	b = protowire.AppendVarint(b, dtDouble)
+	return appendBytesField(b, tensorDoubleValField, packed)
+}
+
+func newMetricLog(timestamp, name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func writeEventFile(t *testing.T, path string, events ...[]byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	for _, e := range events {
+		writeRecord(buf, e)
+	}
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write event file: %v", err)
+	}
+}
+
+func TestTFRecordReader(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writeRecord(buf, []byte("first"))
+	writeRecord(buf, []byte("second"))
+	valid := buf.Bytes()
+
+	corrupted := make([]byte, len(valid))
+	copy(corrupted, valid)
+	// Change the last byte of the second record data.
+	corrupted[len(corrupted)-5] ^= 0xff
+
+	testCases := []struct {
+		data            []byte
+		expectedRecords []string
+		expectedErr     bool
+		testDesc        string
+	}{
+		{
+			data:            valid,
+			expectedRecords: []string{"first", "second"},
+			testDesc:        "Valid records",
+		},
+		{
+			data:            corrupted,
+			expectedRecords: []string{"first"},
+			expectedErr:     true,
+			testDesc:        "Invalid CRC of the record data",
+		},
+		{
+			data:            valid[:len(valid)-3],
+			expectedRecords: []string{"first"},
+			expectedErr:     true,
+			testDesc:        "Incomplete record",
+		},
+	}
+
+	for _, tc := range testCases {
+		reader := NewTFRecordReader(bytes.NewReader(tc.data))
+		records := []string{}
+		var err error
+		for {
+			var record []byte
+			record, err = reader.ReadRecord()
+			if err != nil {
+				break
+			}
+			records = append(records, string(record))
+		}
+		if !reflect.DeepEqual(records, tc.expectedRecords) {
+			t.Errorf("Case: %v. Expected records %v, got %v", tc.testDesc, tc.expectedRecords, records)
+		}
+		if isErr := err != io.EOF; isErr != tc.expectedErr {
+			t.Errorf("Case: %v. Expected error %v, got %v", tc.testDesc, tc.expectedErr, err)
+		}
+	}
+}
+
+func TestCollectObservationLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "katib-tfevent")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// TensorFlow 1 writes scalars as simple values.
+	writeEventFile(t, filepath.Join(dir, "tf1", "train", "events.out.tfevents.1"),
+		newEvent(1609459200, 1, newSimpleValue("accuracy", 0.5), newSimpleValue("loss", 0.25)),
+		newEvent(1609459201.5, 2, newSimpleValue("accuracy", 0.75)),
+	)
+	// TensorFlow 2 writes scalars as tensors, plugin name is reported only for the first value.
+	writeEventFile(t, filepath.Join(dir, "tf2", "test", "events.out.tfevents.2"),
+		newEvent(1609459202, 1,
+			newTensorValue("accuracy", scalarsPluginName, newFloatTensor(0.5)),
+			newTensorValue("accuracy_image", "images", newFloatTensor(1)),
+		),
+		newEvent(1609459203, 2,
+			newTensorValue("accuracy", "", newDoubleTensor(0.875)),
+			newTensorValue("accuracy", "", newDoubleTensor(1, 2)),
+		),
+	)
+
+	testCases := []struct {
+		dir           string
+		metrics       []string
+		expectedMlogs []*v1beta1.MetricLog
+		testDesc      string
+	}{
+		{
+			dir:     filepath.Join(dir, "tf1"),
+			metrics: []string{"accuracy", "train/loss"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2021-01-01T00:00:00Z", "accuracy", "0.5"),
+				newMetricLog("2021-01-01T00:00:00Z", "train/loss", "0.25"),
+				newMetricLog("2021-01-01T00:00:01.5Z", "accuracy", "0.75"),
+			},
+			testDesc: "Simple values of TensorFlow 1",
+		},
+		{
+			dir:     filepath.Join(dir, "tf2"),
+			metrics: []string{"test/accuracy"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2021-01-01T00:00:02Z", "test/accuracy", "0.5"),
+				newMetricLog("2021-01-01T00:00:03Z", "test/accuracy", "0.875"),
+			},
+			testDesc: "Scalar tensors of TensorFlow 2",
+		},
+		{
+			dir:     dir,
+			metrics: []string{"f1", "accuracy"},
+			expectedMlogs: []*v1beta1.MetricLog{
+				newMetricLog(time.Time{}.UTC().Format(time.RFC3339), "f1", consts.UnavailableMetricValue),
+			},
+			testDesc: "Objective metric is not reported",
+		},
+	}
+
+	for _, tc := range testCases {
+		olog, err := CollectObservationLog(tc.dir, tc.metrics)
+		if err != nil {
+			t.Errorf("Case: %v. Failed to collect observation log: %v", tc.testDesc, err)
+			continue
+		}
+		if !reflect.DeepEqual(olog.MetricLogs, tc.expectedMlogs) {
+			t.Errorf("Case: %v. Expected metric logs %v, got %v", tc.testDesc, tc.expectedMlogs, olog.MetricLogs)
+		}
+	}
+}
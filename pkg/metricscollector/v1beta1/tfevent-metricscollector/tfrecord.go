@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// maskDelta is added to the rotated CRC32C checksum of the TFRecord.
+const maskDelta = 0xa282ead8
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// maskedCRC returns the masked CRC32C checksum which is stored in the TFRecord files.
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, crc32cTable)
+	return ((crc >> 15) | (crc << 17)) + maskDelta
+}
+
+// TFRecordReader reads records of the TFRecord file.
+// Each record has the following format:
+//
+//	uint64 length
+//	uint32 masked crc of length
+//	byte   data[length]
+//	uint32 masked crc of data
+type TFRecordReader struct {
+	r io.Reader
+}
+
+// NewTFRecordReader returns the reader of the TFRecord records.
+func NewTFRecordReader(r io.Reader) *TFRecordReader {
+	return &TFRecordReader{r: r}
+}
+
+// ReadRecord returns data of the next record.
+// It returns io.EOF if there are no more records and io.ErrUnexpectedEOF if the last record is incomplete.
+func (t *TFRecordReader) ReadRecord() ([]byte, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(t.r, header); err != nil {
+		return nil, err
+	}
+	length := binary.LittleEndian.Uint64(header[0:8])
+	if crc := binary.LittleEndian.Uint32(header[8:12]); crc != maskedCRC(header[0:8]) {
+		return nil, fmt.Errorf("Invalid CRC of the record length")
+	}
+
+	data := make([]byte, length+4)
+	if _, err := io.ReadFull(t.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if crc := binary.LittleEndian.Uint32(data[length:]); crc != maskedCRC(data[:length]) {
+		return nil, fmt.Errorf("Invalid CRC of the record data")
+	}
+	return data[:length], nil
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	gomock "github.com/golang/mock/gomock"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	reflect "reflect"
 )
 
@@ -60,18 +61,19 @@ func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0 interface{
 }
 
 // GetObservationLog mocks base method.
-func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
+func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string, arg4 *common.ObservationLogOptions) (*api_v1_beta1.ObservationLog, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*api_v1_beta1.ObservationLog)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetObservationLog indicates an expected call of GetObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3, arg4)
 }
 
 // RegisterObservationLog mocks base method.
@@ -9,6 +9,7 @@ import (
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	v1beta10 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	v1beta11 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	types "k8s.io/apimachinery/pkg/types"
 	reflect "reflect"
 )
 
@@ -35,18 +36,30 @@ func (m *MockSuggestionClient) EXPECT() *MockSuggestionClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteEmbeddedState mocks base method.
+func (m *MockSuggestionClient) DeleteEmbeddedState(arg0 types.NamespacedName) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteEmbeddedState", arg0)
+}
+
+// DeleteEmbeddedState indicates an expected call of DeleteEmbeddedState.
+func (mr *MockSuggestionClientMockRecorder) DeleteEmbeddedState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmbeddedState", reflect.TypeOf((*MockSuggestionClient)(nil).DeleteEmbeddedState), arg0)
+}
+
 // SyncAssignments mocks base method.
-func (m *MockSuggestionClient) SyncAssignments(arg0 *v1beta10.Suggestion, arg1 *v1beta1.Experiment, arg2 []v1beta11.Trial) error {
+func (m *MockSuggestionClient) SyncAssignments(arg0 *v1beta10.Suggestion, arg1 *v1beta1.Experiment, arg2, arg3 []v1beta11.Trial) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncAssignments", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SyncAssignments", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SyncAssignments indicates an expected call of SyncAssignments.
-func (mr *MockSuggestionClientMockRecorder) SyncAssignments(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockSuggestionClientMockRecorder) SyncAssignments(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAssignments", reflect.TypeOf((*MockSuggestionClient)(nil).SyncAssignments), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAssignments", reflect.TypeOf((*MockSuggestionClient)(nil).SyncAssignments), arg0, arg1, arg2, arg3)
 }
 
 // ValidateAlgorithmSettings mocks base method.
@@ -62,3 +75,17 @@ func (mr *MockSuggestionClientMockRecorder) ValidateAlgorithmSettings(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAlgorithmSettings", reflect.TypeOf((*MockSuggestionClient)(nil).ValidateAlgorithmSettings), arg0, arg1)
 }
+
+// ValidateEarlyStoppingSettings mocks base method.
+func (m *MockSuggestionClient) ValidateEarlyStoppingSettings(arg0 *v1beta10.Suggestion, arg1 *v1beta1.Experiment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEarlyStoppingSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateEarlyStoppingSettings indicates an expected call of ValidateEarlyStoppingSettings.
+func (mr *MockSuggestionClientMockRecorder) ValidateEarlyStoppingSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEarlyStoppingSettings", reflect.TypeOf((*MockSuggestionClient)(nil).ValidateEarlyStoppingSettings), arg0, arg1)
+}
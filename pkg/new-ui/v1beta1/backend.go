@@ -27,6 +27,7 @@ import (
 
 	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 )
 
@@ -57,7 +58,12 @@ func (k *KatibUIHandler) ServeIndex(buildDir string) func(w http.ResponseWriter,
 }
 
 func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient) {
-	conn, err := grpc.Dial(k.dbManagerAddr, grpc.WithInsecure())
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		log.Printf("Load gRPC TLS configuration failed: %v", err)
+		return nil, nil
+	}
+	conn, err := grpc.Dial(k.dbManagerAddr, tlsOpt)
 	if err != nil {
 		log.Printf("Dial to GRPC failed: %v", err)
 		return nil, nil
@@ -24,6 +24,9 @@ import (
 
 const maxMsgSize = 1<<31 - 1
 
+// observationLogPageSize is the number of metric logs the UI gets from the DB manager at one time.
+const observationLogPageSize = 10000
+
 var (
 	// namespace      = "default"
 	allowedHeaders = "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, X-CSRF-Token"
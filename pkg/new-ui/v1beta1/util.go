@@ -17,13 +17,16 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"sort"
 	"strconv"
 	"strings"
 
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 
 	gographviz "github.com/awalterschulze/gographviz"
@@ -301,3 +304,53 @@ func generateNNImage(architecture string, decoder string) string {
 	s := graph.String()
 	return s
 }
+
+// getObservationLog gets the observation log page by page, so that a long log
+// does not have to fit into one gRPC message.
+func getObservationLog(c api_pb_v1beta1.DBManagerClient, request *api_pb_v1beta1.GetObservationLogRequest) (*api_pb_v1beta1.ObservationLog, error) {
+	request.PageSize = observationLogPageSize
+	observationLog := &api_pb_v1beta1.ObservationLog{
+		MetricLogs: []*api_pb_v1beta1.MetricLog{},
+	}
+	for {
+		reply, err := c.GetObservationLog(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		observationLog.MetricLogs = append(observationLog.MetricLogs, reply.ObservationLog.MetricLogs...)
+		if reply.NextPageToken == "" {
+			return observationLog, nil
+		}
+		request.PageToken = reply.NextPageToken
+	}
+}
+
+// getDownsampleSpec parses the downsample query parameter, which is one of
+// everyNth, min, max or mean, with its everyNth or bucketDuration parameter.
+// It returns nil if downsampling is not requested.
+func getDownsampleSpec(r *http.Request) (*api_pb_v1beta1.DownsampleSpec, error) {
+	query := r.URL.Query()
+	spec := &api_pb_v1beta1.DownsampleSpec{
+		BucketDuration: query.Get("bucketDuration"),
+	}
+	switch query.Get("downsample") {
+	case "":
+		return nil, nil
+	case "everyNth":
+		spec.Type = api_pb_v1beta1.DownsampleType_EVERY_NTH
+		everyNth, err := strconv.Atoi(query.Get("everyNth"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid everyNth %v: %v", query.Get("everyNth"), err)
+		}
+		spec.EveryNth = int32(everyNth)
+	case "min":
+		spec.Type = api_pb_v1beta1.DownsampleType_BUCKET_MIN
+	case "max":
+		spec.Type = api_pb_v1beta1.DownsampleType_BUCKET_MAX
+	case "mean":
+		spec.Type = api_pb_v1beta1.DownsampleType_BUCKET_MEAN
+	default:
+		return nil, fmt.Errorf("Unknown downsample %v", query.Get("downsample"))
+	}
+	return spec, nil
+}
@@ -35,6 +35,9 @@ class ChocolateService(api_pb2_grpc.SuggestionServicer, HealthServicer):
 
     def ValidateAlgorithmSettings(self, request, context):
         algorithm_name = request.experiment.spec.algorithm.algorithm_name
+        message = HyperParameterSearchSpace.validate_unsupported(request.experiment)
+        if message is not None:
+            return self._set_validate_context_error(context, message)
         if algorithm_name == "grid":
             search_space = HyperParameterSearchSpace.convert(
                 request.experiment)
@@ -17,7 +17,10 @@ limitations under the License.
 package suggestion_goptuna_v1beta1
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
 	"strconv"
 	"time"
 
@@ -34,9 +37,69 @@ func toGoptunaDirection(t api_v1_beta1.ObjectiveType) goptuna.StudyDirection {
 	return goptuna.StudyDirectionMaximize
 }
 
-func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, goptuna.RelativeSampler, error) {
+// toGoptunaDirections returns directions of all objectives, or the direction of the single objective.
+func toGoptunaDirections(objective *api_v1_beta1.ObjectiveSpec) []goptuna.StudyDirection {
+	if len(objective.GetObjectives()) == 0 {
+		return []goptuna.StudyDirection{toGoptunaDirection(objective.GetType())}
+	}
+	directions := make([]goptuna.StudyDirection, 0, len(objective.GetObjectives()))
+	for _, o := range objective.GetObjectives() {
+		directions = append(directions, toGoptunaDirection(o.GetType()))
+	}
+	return directions
+}
+
+func toGoptunaSampler(
+	algorithm *api_v1_beta1.AlgorithmSpec,
+	objective *api_v1_beta1.ObjectiveSpec,
+	searchSpace map[string]interface{},
+) (goptuna.Sampler, goptuna.RelativeSampler, error) {
 	name := algorithm.GetAlgorithmName()
-	if name == AlgorithmCMAES {
+	if name == AlgorithmGrid {
+		sampler, err := newGridSampler(searchSpace)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Grid sampler returns all parameters, independent sampler is not used.
+		return goptuna.NewRandomSearchSampler(), sampler, nil
+	} else if name == AlgorithmNSGA2 {
+		// Parameters, which are not inherited from parents, are sampled by random search.
+		randomOpts := make([]goptuna.RandomSearchSamplerOption, 0, 1)
+		sampler := newNSGA2Sampler(toGoptunaDirections(objective))
+		for _, s := range algorithm.GetAlgorithmSettings() {
+			if s.Name == "random_state" {
+				seed, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				randomOpts = append(randomOpts, goptuna.RandomSearchSamplerOptionSeed(int64(seed)))
+				sampler.rng = rand.New(rand.NewSource(int64(seed)))
+			} else if s.Name == "population_size" {
+				n, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				if n < 2 {
+					return nil, nil, fmt.Errorf("population_size must be greater than 1, got %v", n)
+				}
+				sampler.populationSize = n
+			} else if s.Name == "crossover_prob" || s.Name == "mutation_prob" {
+				prob, err := strconv.ParseFloat(s.Value, 64)
+				if err != nil {
+					return nil, nil, err
+				}
+				if prob < 0 || prob > 1 {
+					return nil, nil, fmt.Errorf("%s must be in [0, 1], got %v", s.Name, prob)
+				}
+				if s.Name == "crossover_prob" {
+					sampler.crossoverProb = prob
+				} else {
+					sampler.mutationProb = prob
+				}
+			}
+		}
+		return goptuna.NewRandomSearchSampler(randomOpts...), sampler, nil
+	} else if name == AlgorithmCMAES {
 		opts := make([]cmaes.SamplerOption, 0, len(algorithm.GetAlgorithmSettings())+1)
 		opts = append(opts, cmaes.SamplerOptionNStartupTrials(0))
 		for _, s := range algorithm.GetAlgorithmSettings() {
@@ -97,6 +160,8 @@ func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, g
 func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]interface{}, error) {
 	searchSpace := make(map[string]interface{}, len(parameters))
 	for _, p := range parameters {
+		isLogUniform := p.GetFeasibleSpace().GetDistribution() == api_v1_beta1.Distribution_LOG_UNIFORM
+
 		if p.ParameterType == api_v1_beta1.ParameterType_DOUBLE {
 			high, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMax(), 64)
 			if err != nil {
@@ -108,7 +173,20 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 			}
 
 			stepstr := p.GetFeasibleSpace().GetStep()
-			if stepstr == "" {
+			if p.GetFeasibleSpace().GetDistribution() == api_v1_beta1.Distribution_NORMAL {
+				// Goptuna doesn't have the normal distribution, the quantile of the value is sampled.
+				n, err := toNormalParam(p)
+				if err != nil {
+					return nil, err
+				}
+				searchSpace[p.Name] = n.distribution()
+			} else if isLogUniform {
+				// Step of the quantized log-uniform parameter is applied by quantizedParam.
+				searchSpace[p.Name] = goptuna.LogUniformDistribution{
+					High: high,
+					Low:  low,
+				}
+			} else if stepstr == "" {
 				searchSpace[p.Name] = goptuna.UniformDistribution{
 					High: high,
 					Low:  low,
@@ -134,7 +212,12 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 				return nil, err
 			}
 			stepstr := p.GetFeasibleSpace().GetStep()
-			if stepstr == "" {
+			if isLogUniform {
+				searchSpace[p.Name] = goptuna.LogUniformDistribution{
+					High: float64(high),
+					Low:  float64(low),
+				}
+			} else if stepstr == "" {
 				searchSpace[p.Name] = goptuna.IntUniformDistribution{
 					High: high,
 					Low:  low,
@@ -170,6 +253,142 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 	return searchSpace, nil
 }
 
+// toParameterConditions returns conditions of the conditional parameters.
+func toParameterConditions(parameters []*api_v1_beta1.ParameterSpec) map[string]*api_v1_beta1.ParameterCondition {
+	conditions := make(map[string]*api_v1_beta1.ParameterCondition)
+	for _, p := range parameters {
+		if p.GetCondition() != nil {
+			conditions[p.Name] = p.GetCondition()
+		}
+	}
+	return conditions
+}
+
+// quantizedParam is the log-uniform parameter, whose sampled values are rounded to the multiple of the step.
+type quantizedParam struct {
+	step  float64
+	isInt bool
+}
+
+// toQuantizedParams returns the quantized log-uniform parameters.
+// Int log-uniform parameters are quantized with the step 1 by default.
+func toQuantizedParams(parameters []*api_v1_beta1.ParameterSpec) (map[string]quantizedParam, error) {
+	quantizedParams := make(map[string]quantizedParam)
+	for _, p := range parameters {
+		if p.GetFeasibleSpace().GetDistribution() != api_v1_beta1.Distribution_LOG_UNIFORM {
+			continue
+		}
+		isInt := p.ParameterType == api_v1_beta1.ParameterType_INT
+		stepstr := p.GetFeasibleSpace().GetStep()
+		if stepstr == "" {
+			if isInt {
+				quantizedParams[p.Name] = quantizedParam{step: 1, isInt: true}
+			}
+			continue
+		}
+		step, err := strconv.ParseFloat(stepstr, 64)
+		if err != nil {
+			return nil, err
+		}
+		if step <= 0 {
+			return nil, fmt.Errorf("Step of parameter %s must be positive, got %v", p.Name, step)
+		}
+		quantizedParams[p.Name] = quantizedParam{step: step, isInt: isInt}
+	}
+	return quantizedParams, nil
+}
+
+// quantize rounds the value to the nearest multiple of the step within the distribution bounds.
+func (q quantizedParam) quantize(value float64, d goptuna.LogUniformDistribution) float64 {
+	v := math.Round(value/q.step) * q.step
+	if v < d.Low {
+		v = math.Ceil(d.Low/q.step) * q.step
+	}
+	if v > d.High {
+		v = math.Floor(d.High/q.step) * q.step
+	}
+	return math.Min(math.Max(v, d.Low), d.High)
+}
+
+// format returns the Katib representation of the quantized value.
+func (q quantizedParam) format(value float64) string {
+	if q.isInt {
+		return strconv.Itoa(int(value))
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// normalParam is the double parameter with the normal distribution truncated to min and max.
+// Goptuna doesn't have the normal distribution, so the sampler samples the quantile of the value
+// from the uniform distribution between the quantiles of min and max, which is converted to the value.
+type normalParam struct {
+	mean float64
+	std  float64
+	min  float64
+	max  float64
+}
+
+// toNormalParams returns the parameters with the normal distribution.
+func toNormalParams(parameters []*api_v1_beta1.ParameterSpec) (map[string]normalParam, error) {
+	normalParams := make(map[string]normalParam)
+	for _, p := range parameters {
+		if p.GetFeasibleSpace().GetDistribution() != api_v1_beta1.Distribution_NORMAL {
+			continue
+		}
+		n, err := toNormalParam(p)
+		if err != nil {
+			return nil, err
+		}
+		normalParams[p.Name] = n
+	}
+	return normalParams, nil
+}
+
+func toNormalParam(p *api_v1_beta1.ParameterSpec) (normalParam, error) {
+	if p.ParameterType != api_v1_beta1.ParameterType_DOUBLE {
+		return normalParam{}, fmt.Errorf("Normal distribution of parameter %s is supported only for double parameter", p.Name)
+	}
+	if p.GetFeasibleSpace().GetStep() != "" {
+		return normalParam{}, fmt.Errorf("Step of parameter %s is not supported for normal distribution", p.Name)
+	}
+	values := make([]float64, 0, 4)
+	for _, v := range []string{p.GetFeasibleSpace().GetMean(), p.GetFeasibleSpace().GetStd(),
+		p.GetFeasibleSpace().GetMin(), p.GetFeasibleSpace().GetMax()} {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return normalParam{}, err
+		}
+		values = append(values, f)
+	}
+	n := normalParam{mean: values[0], std: values[1], min: values[2], max: values[3]}
+	if n.std <= 0 {
+		return normalParam{}, fmt.Errorf("Std of parameter %s must be positive, got %v", p.Name, n.std)
+	}
+	if n.min >= n.max {
+		return normalParam{}, fmt.Errorf("Min of parameter %s must be less than max", p.Name)
+	}
+	return n, nil
+}
+
+// distribution returns the uniform distribution of the quantiles between min and max.
+func (n normalParam) distribution() goptuna.UniformDistribution {
+	return goptuna.UniformDistribution{
+		High: n.quantile(n.max),
+		Low:  n.quantile(n.min),
+	}
+}
+
+// quantile returns the value of the normal cumulative distribution function.
+func (n normalParam) quantile(value float64) float64 {
+	return 0.5 * math.Erfc(-(value-n.mean)/(n.std*math.Sqrt2))
+}
+
+// value returns the value of the quantile within min and max.
+func (n normalParam) value(quantile float64) float64 {
+	v := n.mean + n.std*math.Sqrt2*math.Erfinv(2*quantile-1)
+	return math.Min(math.Max(v, n.min), n.max)
+}
+
 func toGoptunaState(condition api_v1_beta1.TrialStatus_TrialConditionType) (goptuna.TrialState, error) {
 	if condition == api_v1_beta1.TrialStatus_CREATED {
 		return goptuna.TrialStateRunning, nil
@@ -202,9 +421,10 @@ func getFinalMetric(objectMetricName string, trial *api_v1_beta1.Trial) (float64
 
 func toGoptunaTrials(
 	ktrials []*api_v1_beta1.Trial,
-	objectMetricName string,
+	objective *api_v1_beta1.ObjectiveSpec,
 	study *goptuna.Study,
 	searchSpace map[string]interface{},
+	normalParams map[string]normalParam,
 ) (map[string]goptuna.FrozenTrial, error) {
 	gtrials := make(map[string]goptuna.FrozenTrial, len(ktrials))
 	for i, kt := range ktrials {
@@ -228,15 +448,32 @@ func toGoptunaTrials(
 		}
 
 		var finalValue float64
+		var systemAttrs map[string]string
 		if state == goptuna.TrialStateComplete {
-			finalValue, err = getFinalMetric(objectMetricName, kt)
+			finalValue, err = getFinalMetric(objective.GetObjectiveMetricName(), kt)
 			if err != nil {
 				return nil, err
 			}
+			// Goptuna trial has a single value, values of all objectives are stored in the system attribute.
+			if len(objective.GetObjectives()) > 0 {
+				values := make([]float64, 0, len(objective.GetObjectives()))
+				for _, o := range objective.GetObjectives() {
+					v, err := getFinalMetric(o.GetObjectiveMetricName(), kt)
+					if err != nil {
+						return nil, err
+					}
+					values = append(values, v)
+				}
+				b, err := json.Marshal(values)
+				if err != nil {
+					return nil, err
+				}
+				systemAttrs = map[string]string{objectiveValuesAttrKey: string(b)}
+			}
 		}
 
 		assignments := kt.GetSpec().GetParameterAssignments().GetAssignments()
-		internalParams, externalParams, err := toGoptunaParams(assignments, searchSpace)
+		internalParams, externalParams, err := toGoptunaParams(assignments, searchSpace, normalParams)
 		if err != nil {
 			return nil, err
 		}
@@ -254,7 +491,7 @@ func toGoptunaTrials(
 			Params:             externalParams,
 			Distributions:      searchSpace,
 			UserAttrs:          nil,
-			SystemAttrs:        nil,
+			SystemAttrs:        systemAttrs,
 		}
 		gtrials[kt.GetName()] = gt
 	}
@@ -264,6 +501,7 @@ func toGoptunaTrials(
 func toGoptunaParams(
 	assignments []*api_v1_beta1.ParameterAssignment,
 	searchSpace map[string]interface{},
+	normalParams map[string]normalParam,
 ) (
 	internalParams map[string]float64,
 	externalParams map[string]interface{},
@@ -282,6 +520,9 @@ func toGoptunaParams(
 			if err != nil {
 				return nil, nil, err
 			}
+			if n, ok := normalParams[name]; ok {
+				p = n.quantile(p)
+			}
 			internalParams[name] = p
 			externalParams[name] = d.ToExternalRepr(p)
 		case goptuna.DiscreteUniformDistribution:
@@ -291,6 +532,13 @@ func toGoptunaParams(
 			}
 			internalParams[name] = p
 			externalParams[name] = d.ToExternalRepr(p)
+		case goptuna.LogUniformDistribution:
+			p, err := strconv.ParseFloat(valueStr, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			internalParams[name] = p
+			externalParams[name] = d.ToExternalRepr(p)
 		case goptuna.IntUniformDistribution:
 			p, err := strconv.ParseInt(valueStr, 10, 64)
 			if err != nil {
@@ -329,21 +577,28 @@ func toGoptunaParams(
 	return internalParams, externalParams, nil
 }
 
+// createStudyAndSearchSpace creates the study in the storage, or loads it if the study exists.
+// If storage is nil, the study is created in memory.
 func createStudyAndSearchSpace(
 	experiment *api_v1_beta1.Experiment,
+	storage goptuna.Storage,
 ) (*goptuna.Study, map[string]interface{}, error) {
 	direction := toGoptunaDirection(experiment.GetSpec().GetObjective().GetType())
-	independentSampler, relativeSampler, err := toGoptunaSampler(experiment.GetSpec().GetAlgorithm())
+	searchSpace, err := toGoptunaSearchSpace(experiment.GetSpec().GetParameterSpecs().GetParameters())
 	if err != nil {
 		return nil, nil, err
 	}
-	searchSpace, err := toGoptunaSearchSpace(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	independentSampler, relativeSampler, err := toGoptunaSampler(experiment.GetSpec().GetAlgorithm(), experiment.GetSpec().GetObjective(), searchSpace)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	studyOpts := make([]goptuna.StudyOption, 0, 5)
+	studyOpts := make([]goptuna.StudyOption, 0, 7)
 	studyOpts = append(studyOpts, goptuna.StudyOptionSetDirection(direction))
+	if storage != nil {
+		studyOpts = append(studyOpts, goptuna.StudyOptionStorage(storage))
+		studyOpts = append(studyOpts, goptuna.StudyOptionLoadIfExists(true))
+	}
 	studyOpts = append(studyOpts, goptuna.StudyOptionDefineSearchSpace(searchSpace))
 	studyOpts = append(studyOpts, goptuna.StudyOptionLogger(nil))
 	if independentSampler != nil {
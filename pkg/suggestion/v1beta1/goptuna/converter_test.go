@@ -17,6 +17,7 @@ limitations under the License.
 package suggestion_goptuna_v1beta1
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -141,6 +142,90 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Log-uniform double parameter type",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0.0001",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"param-double": goptuna.LogUniformDistribution{
+					High: 0.1,
+					Low:  0.0001,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Quantized log-uniform int parameter type",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-int",
+					ParameterType: api_v1_beta1.ParameterType_INT,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "512",
+						Min:          "16",
+						Step:         "16",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"param-int": goptuna.LogUniformDistribution{
+					High: 512,
+					Low:  16,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Normal double parameter type",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.7",
+						Min:          "0.5",
+						Mean:         "0.5",
+						Std:          "0.1",
+						Distribution: api_v1_beta1.Distribution_NORMAL,
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"param-double": goptuna.UniformDistribution{
+					High: 0.5 * math.Erfc(-(0.7-0.5)/(0.1*math.Sqrt2)),
+					Low:  0.5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Normal distribution with not positive std",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "1",
+						Min:          "0",
+						Mean:         "0.5",
+						Std:          "0",
+						Distribution: api_v1_beta1.Distribution_NORMAL,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Discrete parameter type",
 			parameters: []*api_v1_beta1.ParameterSpec{
@@ -191,3 +276,129 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 		})
 	}
 }
+
+func Test_toQuantizedParams(t *testing.T) {
+	parameters := []*api_v1_beta1.ParameterSpec{
+		{
+			Name:          "lr",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max:          "0.1",
+				Min:          "0.0001",
+				Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+			},
+		},
+		{
+			Name:          "weight-decay",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max:          "0.1",
+				Min:          "0.001",
+				Step:         "0.001",
+				Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+			},
+		},
+		{
+			Name:          "batch-size",
+			ParameterType: api_v1_beta1.ParameterType_INT,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max:          "512",
+				Min:          "16",
+				Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+			},
+		},
+		{
+			Name:          "num-layers",
+			ParameterType: api_v1_beta1.ParameterType_INT,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max: "5",
+				Min: "1",
+			},
+		},
+	}
+	want := map[string]quantizedParam{
+		"weight-decay": {step: 0.001},
+		"batch-size":   {step: 1, isInt: true},
+	}
+
+	got, err := toQuantizedParams(parameters)
+	if err != nil {
+		t.Fatalf("toQuantizedParams() returns error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toQuantizedParams() got = %v, want %v", got, want)
+	}
+}
+
+func Test_quantizedParam_quantize(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		q        quantizedParam
+		value    float64
+		expected string
+	}{
+		{
+			name:     "Value is rounded to the step",
+			q:        quantizedParam{step: 16, isInt: true},
+			value:    41.5,
+			expected: "48",
+		},
+		{
+			name:     "Value is rounded inside the lower bound",
+			q:        quantizedParam{step: 16, isInt: true},
+			value:    20,
+			expected: "32",
+		},
+		{
+			name:     "Value is rounded inside the upper bound",
+			q:        quantizedParam{step: 0.5},
+			value:    255.8,
+			expected: "255.5",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := goptuna.LogUniformDistribution{Low: 30, High: 255.7}
+			got := tt.q.format(tt.q.quantize(tt.value, d))
+			if got != tt.expected {
+				t.Errorf("quantize() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_normalParam(t *testing.T) {
+	n := normalParam{mean: 0.5, std: 0.1, min: 0.2, max: 0.9}
+	for _, tt := range []struct {
+		name     string
+		quantile float64
+		expected float64
+	}{
+		{
+			name:     "Median is the mean",
+			quantile: 0.5,
+			expected: 0.5,
+		},
+		{
+			name:     "Value is converted from the quantile",
+			quantile: n.quantile(0.63),
+			expected: 0.63,
+		},
+		{
+			name:     "Value is not less than min",
+			quantile: 0,
+			expected: 0.2,
+		},
+		{
+			name:     "Value is not greater than max",
+			quantile: 1,
+			expected: 0.9,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := n.value(tt.quantile)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("value() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
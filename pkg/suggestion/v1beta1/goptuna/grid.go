@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/c-bata/goptuna"
+)
+
+// maxGridSize is the maximum number of assignments in the grid search space.
+const maxGridSize = 100000
+
+var errGridExhausted = errors.New("search space exhausted")
+
+// gridSampler is a relative sampler which enumerates the Cartesian product of the parameter values.
+// Assignments are suggested in the same order, assignments of the trials in the study are skipped.
+type gridSampler struct {
+	// names are sorted parameter names.
+	names []string
+	// values contains internal representations of the parameter values in the order of names.
+	values [][]float64
+	size   int
+}
+
+func newGridSampler(searchSpace map[string]interface{}) (*gridSampler, error) {
+	names := make([]string, 0, len(searchSpace))
+	for name := range searchSpace {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s := &gridSampler{
+		names:  names,
+		values: make([][]float64, 0, len(names)),
+		size:   1,
+	}
+	for _, name := range names {
+		values, err := gridValues(name, searchSpace[name])
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > maxGridSize/s.size {
+			return nil, fmt.Errorf("Grid search space is too large, it must contain at most %d assignments", maxGridSize)
+		}
+		s.size *= len(values)
+		s.values = append(s.values, values)
+	}
+	return s, nil
+}
+
+// gridValues returns internal representations of all values of the distribution.
+func gridValues(name string, distribution interface{}) ([]float64, error) {
+	values := []float64{}
+	switch d := distribution.(type) {
+	case goptuna.UniformDistribution:
+		return nil, fmt.Errorf("Parameter %s is unbounded for grid search, step must be set for double parameter", name)
+	case goptuna.DiscreteUniformDistribution:
+		if d.Q <= 0 {
+			return nil, fmt.Errorf("Step of parameter %s must be positive, got %v", name, d.Q)
+		}
+		n := int(math.Floor((d.High-d.Low)/d.Q+1e-8)) + 1
+		if n > maxGridSize {
+			return nil, fmt.Errorf("Parameter %s has more than %d values", name, maxGridSize)
+		}
+		for i := 0; i < n; i++ {
+			values = append(values, d.Low+float64(i)*d.Q)
+		}
+	case goptuna.IntUniformDistribution:
+		if d.High-d.Low >= maxGridSize {
+			return nil, fmt.Errorf("Parameter %s has more than %d values", name, maxGridSize)
+		}
+		for v := d.Low; v <= d.High; v++ {
+			values = append(values, float64(v))
+		}
+	case goptuna.StepIntUniformDistribution:
+		if d.Step <= 0 {
+			return nil, fmt.Errorf("Step of parameter %s must be positive, got %v", name, d.Step)
+		}
+		if (d.High-d.Low)/d.Step >= maxGridSize {
+			return nil, fmt.Errorf("Parameter %s has more than %d values", name, maxGridSize)
+		}
+		for v := d.Low; v <= d.High; v += d.Step {
+			values = append(values, float64(v))
+		}
+	case goptuna.CategoricalDistribution:
+		for i := range d.Choices {
+			values = append(values, float64(i))
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported distribution of parameter %s for grid search", name)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("Parameter %s has no values", name)
+	}
+	return values, nil
+}
+
+// assignment returns internal parameters of the assignment with the index in the grid.
+func (s *gridSampler) assignment(index int) map[string]float64 {
+	params := make(map[string]float64, len(s.names))
+	for i := len(s.names) - 1; i >= 0; i-- {
+		n := len(s.values[i])
+		params[s.names[i]] = s.values[i][index%n]
+		index /= n
+	}
+	return params
+}
+
+// key returns the string which identifies the internal parameters in the grid.
+func (s *gridSampler) key(params map[string]float64) string {
+	values := make([]string, 0, len(s.names))
+	for _, name := range s.names {
+		v, ok := params[name]
+		if !ok {
+			return ""
+		}
+		values = append(values, strconv.FormatFloat(v, 'g', -1, 64))
+	}
+	return strings.Join(values, ",")
+}
+
+// usedKeys returns keys of the assignments of all trials in the study, excluding the trial with the ID.
+func (s *gridSampler) usedKeys(study *goptuna.Study, excludeID int) (map[string]bool, error) {
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool, len(trials))
+	for _, t := range trials {
+		if t.ID == excludeID {
+			continue
+		}
+		if k := s.key(t.InternalParams); k != "" {
+			used[k] = true
+		}
+	}
+	return used, nil
+}
+
+// remaining returns the number of assignments which are not suggested yet.
+func (s *gridSampler) remaining(study *goptuna.Study) (int, error) {
+	used, err := s.usedKeys(study, -1)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for i := 0; i < s.size; i++ {
+		if !used[s.key(s.assignment(i))] {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// SampleRelative returns the first assignment of the grid which is not suggested yet.
+func (s *gridSampler) SampleRelative(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	searchSpace map[string]interface{},
+) (map[string]float64, error) {
+	used, err := s.usedKeys(study, trial.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < s.size; i++ {
+		params := s.assignment(i)
+		if !used[s.key(params)] {
+			return params, nil
+		}
+	}
+	return nil, errGridExhausted
+}
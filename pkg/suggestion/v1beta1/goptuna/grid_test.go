@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestNewGridSampler(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		searchSpace  map[string]interface{}
+		expectedSize int
+		expectedErr  bool
+	}{
+		{
+			name: "Int, stepped double and categorical parameters",
+			searchSpace: map[string]interface{}{
+				"int":    goptuna.IntUniformDistribution{Low: 1, High: 3},
+				"step":   goptuna.StepIntUniformDistribution{Low: 0, High: 10, Step: 5},
+				"double": goptuna.DiscreteUniformDistribution{Low: 0.1, High: 0.3, Q: 0.1},
+				"cat":    goptuna.CategoricalDistribution{Choices: []string{"a", "b"}},
+			},
+			expectedSize: 3 * 3 * 3 * 2,
+		},
+		{
+			name: "Double parameter without step",
+			searchSpace: map[string]interface{}{
+				"double": goptuna.UniformDistribution{Low: 0, High: 1},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Too large search space",
+			searchSpace: map[string]interface{}{
+				"x": goptuna.IntUniformDistribution{Low: 1, High: 1000},
+				"y": goptuna.IntUniformDistribution{Low: 1, High: 1000},
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler, err := newGridSampler(tt.searchSpace)
+			if tt.expectedErr {
+				if err == nil {
+					t.Errorf("newGridSampler() should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newGridSampler() returns error: %v", err)
+			}
+			if sampler.size != tt.expectedSize {
+				t.Errorf("Grid size got = %d, want %d", sampler.size, tt.expectedSize)
+			}
+		})
+	}
+}
+
+func TestGridSearchSpaceExhausted(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmGrid,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "metric-1",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0.01", Max: "0.03", Step: "0.01"},
+					},
+					{
+						Name:          "optimizer",
+						ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{List: []string{"sgd", "adam"}},
+					},
+				},
+			},
+		},
+	}
+
+	s := NewSuggestionService()
+	suggested := []string{}
+	trials := []*api_v1_beta1.Trial{}
+	for _, tt := range []struct {
+		requestNumber     int32
+		expectedNumber    int
+		expectedExhausted bool
+	}{
+		{requestNumber: 4, expectedNumber: 4, expectedExhausted: false},
+		{requestNumber: 4, expectedNumber: 2, expectedExhausted: true},
+		{requestNumber: 1, expectedNumber: 0, expectedExhausted: true},
+	} {
+		reply, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:    experiment,
+			Trials:        trials,
+			RequestNumber: tt.requestNumber,
+		})
+		if err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+		if len(reply.ParameterAssignments) != tt.expectedNumber || reply.SearchSpaceExhausted != tt.expectedExhausted {
+			t.Errorf("GetSuggestions() got %d assignments, exhausted %v, want %d assignments, exhausted %v",
+				len(reply.ParameterAssignments), reply.SearchSpaceExhausted, tt.expectedNumber, tt.expectedExhausted)
+		}
+		for _, pa := range reply.ParameterAssignments {
+			values := []string{}
+			for _, a := range pa.Assignments {
+				values = append(values, a.Name+"="+a.Value)
+			}
+			sort.Strings(values)
+			suggested = append(suggested, strings.Join(values, ","))
+			trials = append(trials, &api_v1_beta1.Trial{
+				Name: pa.TrialName,
+				Spec: &api_v1_beta1.TrialSpec{
+					ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+						Assignments: pa.Assignments,
+					},
+				},
+				Status: &api_v1_beta1.TrialStatus{
+					Condition: api_v1_beta1.TrialStatus_RUNNING,
+				},
+			})
+		}
+	}
+
+	sort.Strings(suggested)
+	expected := []string{
+		"lr=0.01,optimizer=adam",
+		"lr=0.01,optimizer=sgd",
+		"lr=0.02,optimizer=adam",
+		"lr=0.02,optimizer=sgd",
+		"lr=0.03,optimizer=adam",
+		"lr=0.03,optimizer=sgd",
+	}
+	if !reflect.DeepEqual(suggested, expected) {
+		t.Errorf("Suggested assignments got = %v, want %v", suggested, expected)
+	}
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/c-bata/goptuna"
+)
+
+const (
+	// Trial system attribute with JSON list of objective values, in the order of objectives.
+	objectiveValuesAttrKey = "katib:objectiveValues"
+	// Trial system attribute with NSGA-II generation of the trial.
+	nsga2GenerationAttrKey = "katib:nsga2:generation"
+
+	defaultNSGA2PopulationSize = 50
+	defaultNSGA2CrossoverProb  = 0.9
+)
+
+// nsga2Sampler is a relative sampler which implements the genetic algorithm of NSGA-II.
+// The first generation is sampled by the independent random sampler.
+// Next generations are children of the elite trials, which are selected by the non-dominated sort and
+// the crowding distance. Mutated parameters are not returned, so they are sampled by the independent sampler.
+type nsga2Sampler struct {
+	mu             sync.Mutex
+	rng            *rand.Rand
+	populationSize int
+	crossoverProb  float64
+	// Probability to mutate each parameter. Negative value means 1 / number of parameters.
+	mutationProb float64
+	directions   []goptuna.StudyDirection
+}
+
+type nsga2Individual struct {
+	trial    goptuna.FrozenTrial
+	values   []float64
+	rank     int
+	distance float64
+}
+
+func newNSGA2Sampler(directions []goptuna.StudyDirection) *nsga2Sampler {
+	return &nsga2Sampler{
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		populationSize: defaultNSGA2PopulationSize,
+		crossoverProb:  defaultNSGA2CrossoverProb,
+		mutationProb:   -1,
+		directions:     directions,
+	}
+}
+
+// SampleRelative samples the parameters of the child of two elite trials.
+func (s *nsga2Sampler) SampleRelative(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	searchSpace map[string]interface{},
+) (map[string]float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	generation := s.currentGeneration(trials)
+	err = study.Storage.SetTrialSystemAttr(trial.ID, nsga2GenerationAttrKey, strconv.Itoa(generation))
+	if err != nil {
+		return nil, err
+	}
+	if generation == 0 {
+		return nil, nil
+	}
+
+	elites := s.selectElites(trials, generation)
+	if len(elites) < 2 {
+		return nil, nil
+	}
+	parent1 := s.tournament(elites)
+	parent2 := parent1
+	if s.rng.Float64() < s.crossoverProb {
+		parent2 = s.tournament(elites)
+	}
+
+	mutationProb := s.mutationProb
+	if mutationProb < 0 {
+		mutationProb = 1.0 / math.Max(1.0, float64(len(searchSpace)))
+	}
+	params := make(map[string]float64, len(searchSpace))
+	for name := range searchSpace {
+		if s.rng.Float64() < mutationProb {
+			continue
+		}
+		parent := parent1
+		if s.rng.Float64() < 0.5 {
+			parent = parent2
+		}
+		if v, ok := parent.trial.InternalParams[name]; ok {
+			params[name] = v
+		}
+	}
+	return params, nil
+}
+
+// currentGeneration returns the latest generation, or the next one if the latest generation is complete.
+func (s *nsga2Sampler) currentGeneration(trials []goptuna.FrozenTrial) int {
+	generation := 0
+	completed := 0
+	for _, t := range trials {
+		g, ok := trialGeneration(t)
+		if !ok {
+			continue
+		}
+		if g > generation {
+			generation = g
+			completed = 0
+		}
+		if g == generation && t.State == goptuna.TrialStateComplete {
+			completed++
+		}
+	}
+	if completed >= s.populationSize {
+		return generation + 1
+	}
+	return generation
+}
+
+// selectElites returns the best completed trials of the previous generations, at most population size.
+func (s *nsga2Sampler) selectElites(trials []goptuna.FrozenTrial, generation int) []*nsga2Individual {
+	population := make([]*nsga2Individual, 0, len(trials))
+	for _, t := range trials {
+		if t.State != goptuna.TrialStateComplete {
+			continue
+		}
+		if g, ok := trialGeneration(t); !ok || g >= generation {
+			continue
+		}
+		values, ok := s.minimizedValues(t)
+		if !ok {
+			continue
+		}
+		population = append(population, &nsga2Individual{trial: t, values: values})
+	}
+
+	for rank, front := range nonDominatedSort(population) {
+		for _, ind := range front {
+			ind.rank = rank
+		}
+		setCrowdingDistance(front)
+	}
+	sort.SliceStable(population, func(i, j int) bool {
+		return population[i].isBetter(population[j])
+	})
+	if len(population) > s.populationSize {
+		population = population[:s.populationSize]
+	}
+	return population
+}
+
+// tournament returns the better of two random individuals.
+func (s *nsga2Sampler) tournament(population []*nsga2Individual) *nsga2Individual {
+	a := population[s.rng.Intn(len(population))]
+	b := population[s.rng.Intn(len(population))]
+	if b.isBetter(a) {
+		return b
+	}
+	return a
+}
+
+// minimizedValues returns objective values of the trial, negated for maximize objectives.
+func (s *nsga2Sampler) minimizedValues(trial goptuna.FrozenTrial) ([]float64, bool) {
+	values := []float64{trial.Value}
+	if v, ok := trial.SystemAttrs[objectiveValuesAttrKey]; ok {
+		if err := json.Unmarshal([]byte(v), &values); err != nil {
+			return nil, false
+		}
+	}
+	if len(values) != len(s.directions) {
+		return nil, false
+	}
+	minimized := make([]float64, len(values))
+	for i := range values {
+		minimized[i] = values[i]
+		if s.directions[i] == goptuna.StudyDirectionMaximize {
+			minimized[i] = -values[i]
+		}
+	}
+	return minimized, true
+}
+
+func (i *nsga2Individual) isBetter(other *nsga2Individual) bool {
+	if i.rank != other.rank {
+		return i.rank < other.rank
+	}
+	return i.distance > other.distance
+}
+
+func trialGeneration(trial goptuna.FrozenTrial) (int, bool) {
+	v, ok := trial.SystemAttrs[nsga2GenerationAttrKey]
+	if !ok {
+		return 0, false
+	}
+	g, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return g, true
+}
+
+// dominates returns true, if values a are not worse than values b in all objectives and better in one of them.
+func dominates(a, b []float64) bool {
+	isBetter := false
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+		if a[i] < b[i] {
+			isBetter = true
+		}
+	}
+	return isBetter
+}
+
+// nonDominatedSort splits the population to Pareto fronts, the first front is not dominated by any individual.
+func nonDominatedSort(population []*nsga2Individual) [][]*nsga2Individual {
+	dominatedBy := make([]int, len(population))
+	dominating := make([][]int, len(population))
+	for i := range population {
+		for j := range population {
+			if dominates(population[i].values, population[j].values) {
+				dominating[i] = append(dominating[i], j)
+			} else if dominates(population[j].values, population[i].values) {
+				dominatedBy[i]++
+			}
+		}
+	}
+
+	fronts := [][]*nsga2Individual{}
+	current := []int{}
+	for i := range population {
+		if dominatedBy[i] == 0 {
+			current = append(current, i)
+		}
+	}
+	for len(current) > 0 {
+		front := make([]*nsga2Individual, 0, len(current))
+		next := []int{}
+		for _, i := range current {
+			front = append(front, population[i])
+			for _, j := range dominating[i] {
+				dominatedBy[j]--
+				if dominatedBy[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		fronts = append(fronts, front)
+		current = next
+	}
+	return fronts
+}
+
+// setCrowdingDistance sets the distance of each individual to its neighbours in the front.
+// Boundary individuals have infinite distance, so that they are always preferred.
+func setCrowdingDistance(front []*nsga2Individual) {
+	if len(front) == 0 {
+		return
+	}
+	for _, ind := range front {
+		ind.distance = 0
+	}
+	sorted := make([]*nsga2Individual, len(front))
+	copy(sorted, front)
+	for m := range front[0].values {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].values[m] < sorted[j].values[m]
+		})
+		sorted[0].distance = math.Inf(1)
+		sorted[len(sorted)-1].distance = math.Inf(1)
+		valueRange := sorted[len(sorted)-1].values[m] - sorted[0].values[m]
+		if valueRange == 0 {
+			continue
+		}
+		for i := 1; i < len(sorted)-1; i++ {
+			sorted[i].distance += (sorted[i+1].values[m] - sorted[i-1].values[m]) / valueRange
+		}
+	}
+}
+
+var _ goptuna.RelativeSampler = &nsga2Sampler{}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func Test_nonDominatedSort(t *testing.T) {
+	population := []*nsga2Individual{
+		{values: []float64{1, 5}},
+		{values: []float64{2, 2}},
+		{values: []float64{3, 3}},
+		{values: []float64{5, 1}},
+		{values: []float64{4, 4}},
+	}
+	fronts := nonDominatedSort(population)
+	got := make([][][]float64, 0, len(fronts))
+	for _, front := range fronts {
+		values := [][]float64{}
+		for _, ind := range front {
+			values = append(values, ind.values)
+		}
+		got = append(got, values)
+	}
+	want := [][][]float64{
+		{{1, 5}, {2, 2}, {5, 1}},
+		{{3, 3}},
+		{{4, 4}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("nonDominatedSort() got = %v, want %v", got, want)
+	}
+
+	setCrowdingDistance(fronts[0])
+	if !math.IsInf(population[0].distance, 1) || !math.IsInf(population[3].distance, 1) {
+		t.Errorf("Boundary individuals must have infinite distance, got %v and %v", population[0].distance, population[3].distance)
+	}
+	if population[1].distance != 2 {
+		t.Errorf("Crowding distance got = %v, want 2", population[1].distance)
+	}
+}
+
+func Test_nsga2Sampler(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmNSGA2,
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{Name: "random_state", Value: "1"},
+					{Name: "population_size", Value: "4"},
+				},
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+				Objectives: []*api_v1_beta1.Objective{
+					{Type: api_v1_beta1.ObjectiveType_MINIMIZE, ObjectiveMetricName: "loss"},
+					{Type: api_v1_beta1.ObjectiveType_MAXIMIZE, ObjectiveMetricName: "accuracy"},
+				},
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "x",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0", Max: "1"},
+					},
+					{
+						Name:          "y",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0", Max: "1"},
+					},
+				},
+			},
+		},
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment, nil)
+	if err != nil {
+		t.Fatalf("Failed to create study: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		trialID, _, err := sampleNextParam(study, searchSpace, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("Failed to sample next param: %v", err)
+		}
+		trial, err := study.Storage.GetTrial(trialID)
+		if err != nil {
+			t.Fatalf("Failed to get trial: %v", err)
+		}
+		x := trial.Params["x"].(float64)
+		y := trial.Params["y"].(float64)
+		values, _ := json.Marshal([]float64{x, y})
+		if err = study.Storage.SetTrialValue(trialID, x); err != nil {
+			t.Fatalf("Failed to set trial value: %v", err)
+		}
+		if err = study.Storage.SetTrialSystemAttr(trialID, objectiveValuesAttrKey, string(values)); err != nil {
+			t.Fatalf("Failed to set trial values: %v", err)
+		}
+		if err = study.Storage.SetTrialState(trialID, goptuna.TrialStateComplete); err != nil {
+			t.Fatalf("Failed to set trial state: %v", err)
+		}
+	}
+
+	trials, err := study.GetTrials()
+	if err != nil {
+		t.Fatalf("Failed to get trials: %v", err)
+	}
+	generations := make([]int, 0, len(trials))
+	for _, trial := range trials {
+		g, ok := trialGeneration(trial)
+		if !ok {
+			t.Fatalf("Trial %v has no generation", trial.ID)
+		}
+		generations = append(generations, g)
+	}
+	want := []int{0, 0, 0, 0, 1, 1, 1, 1, 2, 2}
+	if !reflect.DeepEqual(generations, want) {
+		t.Errorf("Trial generations got = %v, want %v", generations, want)
+	}
+}
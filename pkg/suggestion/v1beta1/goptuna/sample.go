@@ -19,13 +19,20 @@ package suggestion_goptuna_v1beta1
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/c-bata/goptuna"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
-func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (int, []*api_v1_beta1.ParameterAssignment, error) {
+func sampleNextParam(
+	study *goptuna.Study,
+	searchSpace map[string]interface{},
+	quantizedParams map[string]quantizedParam,
+	normalParams map[string]normalParam,
+	conditions map[string]*api_v1_beta1.ParameterCondition,
+) (int, []*api_v1_beta1.ParameterAssignment, error) {
 	nextTrialID, err := study.Storage.CreateNewTrial(study.ID)
 	if err != nil {
 		return -1, nil, err
@@ -41,60 +48,122 @@ func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (
 		return nextTrialID, nil, err
 	}
 
-	assignments := make([]*api_v1_beta1.ParameterAssignment, 0, len(searchSpace))
+	// Parameters are sampled in the order of names, parent parameters are sampled before conditional ones.
+	names := make([]string, 0, len(searchSpace))
 	for name := range searchSpace {
-		switch distribution := searchSpace[name].(type) {
-		case goptuna.UniformDistribution:
-			p, err := trial.SuggestFloat(name, distribution.Low, distribution.High)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.FormatFloat(p, 'f', -1, 64),
-			})
-		case goptuna.DiscreteUniformDistribution:
-			p, err := trial.SuggestDiscreteFloat(name, distribution.Low, distribution.High, distribution.Q)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.FormatFloat(p, 'f', -1, 64),
-			})
-		case goptuna.IntUniformDistribution:
-			p, err := trial.SuggestInt(name, distribution.Low, distribution.High)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.Itoa(p),
-			})
-		case goptuna.StepIntUniformDistribution:
-			p, err := trial.SuggestStepInt(name, distribution.Low, distribution.High, distribution.Step)
-			if err != nil {
-				return nextTrialID, nil, err
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	assignments := make([]*api_v1_beta1.ParameterAssignment, 0, len(searchSpace))
+	values := make(map[string]string, len(searchSpace))
+	visited := make(map[string]bool, len(searchSpace))
+	var sample func(name string) error
+	sample = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+		if condition, ok := conditions[name]; ok {
+			if err := sample(condition.GetParameter()); err != nil {
+				return err
 			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.Itoa(p),
-			})
-		case goptuna.CategoricalDistribution:
-			p, err := trial.SuggestCategorical(name, distribution.Choices)
-			if err != nil {
-				return nextTrialID, nil, err
+			// Inactive parameter is not sampled, so it is not used by the sampler for the next trials.
+			if !isActive(condition, values) {
+				return nil
 			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: p,
-			})
+		}
+		value, err := suggestParam(&trial, name, searchSpace[name], quantizedParams, normalParams)
+		if err != nil {
+			return err
+		}
+		values[name] = value
+		assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
+			Name:  name,
+			Value: value,
+		})
+		return nil
+	}
+	for _, name := range names {
+		if err := sample(name); err != nil {
+			return nextTrialID, nil, err
 		}
 	}
 	return nextTrialID, assignments, nil
 }
 
-func findGoptunaTrialIDByParam(study *goptuna.Study, trialMapping map[string]int, ktrial goptuna.FrozenTrial) (int, error) {
+// isActive returns true if the parent parameter of the condition has one of the condition values.
+func isActive(condition *api_v1_beta1.ParameterCondition, values map[string]string) bool {
+	value, ok := values[condition.GetParameter()]
+	if !ok {
+		return false
+	}
+	for _, v := range condition.GetValues() {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestParam samples the parameter value from the distribution and returns the Katib representation of the value.
+func suggestParam(
+	trial *goptuna.Trial,
+	name string,
+	distribution interface{},
+	quantizedParams map[string]quantizedParam,
+	normalParams map[string]normalParam,
+) (string, error) {
+	switch d := distribution.(type) {
+	case goptuna.UniformDistribution:
+		p, err := trial.SuggestFloat(name, d.Low, d.High)
+		if err != nil {
+			return "", err
+		}
+		if n, ok := normalParams[name]; ok {
+			return strconv.FormatFloat(n.value(p), 'f', -1, 64), nil
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.LogUniformDistribution:
+		p, err := trial.SuggestLogFloat(name, d.Low, d.High)
+		if err != nil {
+			return "", err
+		}
+		if q, ok := quantizedParams[name]; ok {
+			return q.format(q.quantize(p, d)), nil
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.DiscreteUniformDistribution:
+		p, err := trial.SuggestDiscreteFloat(name, d.Low, d.High, d.Q)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.IntUniformDistribution:
+		p, err := trial.SuggestInt(name, d.Low, d.High)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(p), nil
+	case goptuna.StepIntUniformDistribution:
+		p, err := trial.SuggestStepInt(name, d.Low, d.High, d.Step)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(p), nil
+	case goptuna.CategoricalDistribution:
+		return trial.SuggestCategorical(name, d.Choices)
+	}
+	return "", fmt.Errorf("Unsupported distribution of parameter %s: %v", name, distribution)
+}
+
+func findGoptunaTrialIDByParam(
+	study *goptuna.Study,
+	trialMapping map[string]int,
+	ktrial goptuna.FrozenTrial,
+	quantizedParams map[string]quantizedParam,
+	normalParams map[string]normalParam,
+) (int, error) {
 	trials, err := study.GetTrials()
 	if err != nil {
 		return -1, err
@@ -119,9 +188,37 @@ func findGoptunaTrialIDByParam(study *goptuna.Study, trialMapping map[string]int
 			continue
 		}
 
-		if reflect.DeepEqual(ktrial.Params, trials[i].Params) {
+		if reflect.DeepEqual(ktrial.Params, katibParams(trials[i], quantizedParams, normalParams)) {
 			return trials[i].ID, nil
 		}
 	}
 	return -1, fmt.Errorf("Same parameter is not found for Trial: %v", ktrial)
 }
+
+// katibParams returns external parameters of the Goptuna trial with quantized log-uniform values
+// and quantiles of the normal values, which are equal to the parameters of the Katib trial.
+func katibParams(
+	trial goptuna.FrozenTrial,
+	quantizedParams map[string]quantizedParam,
+	normalParams map[string]normalParam,
+) map[string]interface{} {
+	if len(quantizedParams) == 0 && len(normalParams) == 0 {
+		return trial.Params
+	}
+	params := make(map[string]interface{}, len(trial.Params))
+	for name, p := range trial.Params {
+		q, ok := quantizedParams[name]
+		d, isLogUniform := trial.Distributions[name].(goptuna.LogUniformDistribution)
+		n, isNormal := normalParams[name]
+		v, isFloat := p.(float64)
+		if ok && isLogUniform && isFloat {
+			params[name] = q.quantize(v, d)
+		} else if isNormal && isFloat {
+			// Katib trial has the value, which is converted from the sampled quantile.
+			params[name] = n.quantile(n.value(v))
+		} else {
+			params[name] = p
+		}
+	}
+	return params
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func Test_sampleNextParamWithConditions(t *testing.T) {
+	parameters := []*api_v1_beta1.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{List: []string{"sgd", "adam"}},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0.5", Max: "0.9"},
+			Condition:     &api_v1_beta1.ParameterCondition{Parameter: "optimizer", Values: []string{"sgd"}},
+		},
+		{
+			Name:          "nesterov",
+			ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{List: []string{"true", "false"}},
+			Condition:     &api_v1_beta1.ParameterCondition{Parameter: "optimizer", Values: []string{"sgd"}},
+		},
+		{
+			Name:          "dampening",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0", Max: "0.1"},
+			Condition:     &api_v1_beta1.ParameterCondition{Parameter: "nesterov", Values: []string{"false"}},
+		},
+		{
+			Name:          "beta1",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0.8", Max: "0.99"},
+			Condition:     &api_v1_beta1.ParameterCondition{Parameter: "optimizer", Values: []string{"adam"}},
+		},
+	}
+	experiment := &api_v1_beta1.Experiment{
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmTPE,
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{Name: "random_state", Value: "1"},
+					{Name: "startup_trials", Value: "5"},
+				},
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: parameters,
+			},
+		},
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment, nil)
+	if err != nil {
+		t.Fatalf("Failed to create study: %v", err)
+	}
+	conditions := toParameterConditions(parameters)
+
+	for i := 0; i < 20; i++ {
+		trialID, assignments, err := sampleNextParam(study, searchSpace, nil, nil, conditions)
+		if err != nil {
+			t.Fatalf("Failed to sample next param: %v", err)
+		}
+		values := make(map[string]string, len(assignments))
+		for _, a := range assignments {
+			values[a.Name] = a.Value
+		}
+		for _, p := range parameters {
+			_, assigned := values[p.Name]
+			expected := p.Condition == nil || isActive(p.Condition, values)
+			if assigned != expected {
+				t.Errorf("Parameter %s is assigned %v, want %v: %v", p.Name, assigned, expected, values)
+			}
+		}
+
+		// Complete the trial, so the TPE sampler uses it for the next trials.
+		if err = study.Storage.SetTrialValue(trialID, float64(i%3)); err != nil {
+			t.Fatalf("Failed to set trial value: %v", err)
+		}
+		if err = study.Storage.SetTrialState(trialID, goptuna.TrialStateComplete); err != nil {
+			t.Fatalf("Failed to set trial state: %v", err)
+		}
+	}
+}
+
+func Test_sampleNextParamWithNormalDistribution(t *testing.T) {
+	parameters := []*api_v1_beta1.ParameterSpec{
+		{
+			Name:          "dropout",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Min:          "0.1",
+				Max:          "0.6",
+				Mean:         "0.3",
+				Std:          "0.1",
+				Distribution: api_v1_beta1.Distribution_NORMAL,
+			},
+		},
+	}
+	experiment := &api_v1_beta1.Experiment{
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmRandom,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: parameters,
+			},
+		},
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment, nil)
+	if err != nil {
+		t.Fatalf("Failed to create study: %v", err)
+	}
+	normalParams, err := toNormalParams(parameters)
+	if err != nil {
+		t.Fatalf("Failed to convert normal parameters: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		trialID, assignments, err := sampleNextParam(study, searchSpace, nil, normalParams, nil)
+		if err != nil {
+			t.Fatalf("Failed to sample next param: %v", err)
+		}
+		value, err := strconv.ParseFloat(assignments[0].Value, 64)
+		if err != nil || value < 0.1 || value > 0.6 {
+			t.Errorf("Value of parameter dropout must be between min and max, got %v", assignments[0].Value)
+		}
+
+		// Katib trial with the sampled value is found by the parameters.
+		ktrials, err := toGoptunaTrials([]*api_v1_beta1.Trial{
+			{
+				Name: "trial",
+				Spec: &api_v1_beta1.TrialSpec{
+					ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+						Assignments: assignments,
+					},
+				},
+				Status: &api_v1_beta1.TrialStatus{
+					Condition: api_v1_beta1.TrialStatus_RUNNING,
+				},
+			},
+		}, experiment.Spec.Objective, study, searchSpace, normalParams)
+		if err != nil {
+			t.Fatalf("Failed to convert Katib trial: %v", err)
+		}
+		gtrialID, err := findGoptunaTrialIDByParam(study, map[string]int{}, ktrials["trial"], nil, normalParams)
+		if err != nil || gtrialID != trialID {
+			t.Errorf("Goptuna trial of the value %v got = %v, want %v: %v", value, gtrialID, trialID, err)
+		}
+		if err = study.Storage.SetTrialState(trialID, goptuna.TrialStateFail); err != nil {
+			t.Fatalf("Failed to set trial state: %v", err)
+		}
+	}
+}
+
+func TestGetSuggestionsWithInfeasibleTrials(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmRandom,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "metric-1",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "batch_size",
+						ParameterType: api_v1_beta1.ParameterType_INT,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "16", Max: "512"},
+					},
+				},
+			},
+		},
+	}
+
+	s := NewSuggestionService()
+	reply, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		RequestNumber: 1,
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	infeasible := reply.ParameterAssignments[0]
+
+	_, err = s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		RequestNumber: 1,
+		InfeasibleTrials: []*api_v1_beta1.Trial{
+			{
+				Name: infeasible.TrialName,
+				Spec: &api_v1_beta1.TrialSpec{
+					ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+						Assignments: infeasible.Assignments,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+
+	gtrial, err := s.study.Storage.GetTrial(s.trialMapping[infeasible.TrialName])
+	if err != nil {
+		t.Fatalf("Failed to get Goptuna trial: %v", err)
+	}
+	if gtrial.State != goptuna.TrialStateFail {
+		t.Errorf("Infeasible trial state got = %v, want %v", gtrial.State, goptuna.TrialStateFail)
+	}
+}
+
+func TestGetSuggestionsWithWarmStartTrials(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmTPE,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0.01", Max: "0.1"},
+					},
+				},
+			},
+		},
+	}
+	warmStartTrial := &api_v1_beta1.Trial{
+		Name: "previous-trial",
+		Spec: &api_v1_beta1.TrialSpec{
+			Objective: experiment.Spec.Objective,
+			ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+				Assignments: []*api_v1_beta1.ParameterAssignment{
+					{Name: "lr", Value: "0.05"},
+				},
+			},
+		},
+		Status: &api_v1_beta1.TrialStatus{
+			Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+			Observation: &api_v1_beta1.Observation{
+				Metrics: []*api_v1_beta1.Metric{
+					{Name: "loss", Value: "0.3"},
+				},
+			},
+		},
+	}
+
+	s := NewSuggestionService()
+	for i := 0; i < 2; i++ {
+		// Warm start trial is imported once and it is synced in the next requests.
+		_, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:    experiment,
+			Trials:        []*api_v1_beta1.Trial{warmStartTrial},
+			RequestNumber: 1,
+		})
+		if err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+	}
+
+	gtrialID, ok := s.trialMapping[warmStartTrial.Name]
+	if !ok {
+		t.Fatalf("Warm start trial must be mapped to the Goptuna trial")
+	}
+	gtrial, err := s.study.Storage.GetTrial(gtrialID)
+	if err != nil {
+		t.Fatalf("Failed to get Goptuna trial: %v", err)
+	}
+	if gtrial.State != goptuna.TrialStateComplete || gtrial.Value != 0.3 || gtrial.Params["lr"] != 0.05 {
+		t.Errorf("Imported trial got = %v, want completed trial with value 0.3 and lr 0.05", gtrial)
+	}
+	gtrials, err := s.study.GetTrials()
+	if err != nil {
+		t.Fatalf("Failed to get trials: %v", err)
+	}
+	if len(gtrials) != 3 {
+		t.Errorf("Study must have 3 trials, got %v", len(gtrials))
+	}
+}
+
+func TestGetSuggestionsWithEnqueuedTrials(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmTPE,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0.01", Max: "0.1"},
+					},
+				},
+			},
+		},
+	}
+	enqueuedTrial := &api_v1_beta1.Trial{
+		Name: "test-enqueued-0",
+		Spec: &api_v1_beta1.TrialSpec{
+			Objective: experiment.Spec.Objective,
+			ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+				Assignments: []*api_v1_beta1.ParameterAssignment{
+					{Name: "lr", Value: "0.05"},
+				},
+			},
+		},
+		Status: &api_v1_beta1.TrialStatus{
+			Condition: api_v1_beta1.TrialStatus_RUNNING,
+		},
+	}
+
+	s := NewSuggestionService()
+	// Running enqueued trial is imported even though it is not sampled by the study.
+	_, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		Trials:        []*api_v1_beta1.Trial{enqueuedTrial},
+		RequestNumber: 1,
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	gtrialID, ok := s.trialMapping[enqueuedTrial.Name]
+	if !ok {
+		t.Fatalf("Enqueued trial must be mapped to the Goptuna trial")
+	}
+
+	// Succeeded enqueued trial is synced with the imported trial.
+	enqueuedTrial.Status = &api_v1_beta1.TrialStatus{
+		Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+		Observation: &api_v1_beta1.Observation{
+			Metrics: []*api_v1_beta1.Metric{
+				{Name: "loss", Value: "0.3"},
+			},
+		},
+	}
+	_, err = s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		Trials:        []*api_v1_beta1.Trial{enqueuedTrial},
+		RequestNumber: 1,
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	gtrial, err := s.study.Storage.GetTrial(gtrialID)
+	if err != nil {
+		t.Fatalf("Failed to get Goptuna trial: %v", err)
+	}
+	if gtrial.State != goptuna.TrialStateComplete || gtrial.Value != 0.3 || gtrial.Params["lr"] != 0.05 {
+		t.Errorf("Enqueued trial got = %v, want completed trial with value 0.3 and lr 0.05", gtrial)
+	}
+}
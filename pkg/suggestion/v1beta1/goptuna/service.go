@@ -18,12 +18,14 @@ package suggestion_goptuna_v1beta1
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/c-bata/goptuna"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/klog"
 )
 
@@ -31,6 +33,8 @@ const (
 	AlgorithmCMAES  = "cmaes"
 	AlgorithmTPE    = "tpe"
 	AlgorithmRandom = "random"
+	AlgorithmNSGA2  = "nsga2"
+	AlgorithmGrid   = "grid"
 
 	defaultStudyName = "Katib"
 )
@@ -43,11 +47,23 @@ func NewSuggestionService() *SuggestionService {
 	}
 }
 
+// NewPersistentSuggestionService returns the service which stores the Goptuna study under the storage directory,
+// so the history of trials is kept when the suggestion service is restarted.
+func NewPersistentSuggestionService(storageDir string) *SuggestionService {
+	s := NewSuggestionService()
+	s.storageDir = storageDir
+	return s
+}
+
 type SuggestionService struct {
-	mu           sync.RWMutex
-	searchSpace  map[string]interface{}
-	study        *goptuna.Study
-	trialMapping map[string]int // Katib trial name -> Goptuna trial id
+	mu              sync.RWMutex
+	searchSpace     map[string]interface{}
+	quantizedParams map[string]quantizedParam                   // Log-uniform parameters, which values are rounded to the step
+	normalParams    map[string]normalParam                      // Normal parameters, which quantiles are sampled
+	conditions      map[string]*api_v1_beta1.ParameterCondition // Conditions of the conditional parameters
+	study           *goptuna.Study
+	trialMapping    map[string]int // Katib trial name -> Goptuna trial id
+	storageDir      string         // Directory of the persistent study, empty for the in-memory study
 }
 
 func (s *SuggestionService) GetSuggestions(
@@ -59,8 +75,8 @@ func (s *SuggestionService) GetSuggestions(
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
 
-	objectMetricName := req.GetExperiment().GetSpec().GetObjective().GetObjectiveMetricName()
-	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, s.study, s.searchSpace)
+	objective := req.GetExperiment().GetSpec().GetObjective()
+	trials, err := toGoptunaTrials(req.GetTrials(), objective, s.study, s.searchSpace, s.normalParams)
 	if err != nil {
 		klog.Errorf("Failed to convert to Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -70,27 +86,101 @@ func (s *SuggestionService) GetSuggestions(
 		klog.Errorf("Failed to sync Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	err = s.syncInfeasibleTrials(req.GetInfeasibleTrials())
+	if err != nil {
+		klog.Errorf("Failed to sync infeasible Goptuna trials: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	requestNumber := int(req.GetRequestNumber())
+	searchSpaceExhausted := false
+	if grid, ok := s.study.RelativeSampler.(*gridSampler); ok {
+		remaining, err := grid.remaining(s.study)
+		if err != nil {
+			klog.Errorf("Failed to count remaining grid assignments: %s", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		// Katib trials are synced with the study, so assignments of the request trials are not suggested again.
+		if remaining <= requestNumber {
+			klog.Infof("Grid search space is exhausted: %d assignments are requested, %d are remaining", requestNumber, remaining)
+			requestNumber = remaining
+			searchSpaceExhausted = true
+		}
+	}
 	parameterAssignments := make([]*api_v1_beta1.GetSuggestionsReply_ParameterAssignments, requestNumber)
 	for i := 0; i < requestNumber; i++ {
-		trialID, assignments, err := sampleNextParam(s.study, s.searchSpace)
+		trialID, assignments, err := sampleNextParam(s.study, s.searchSpace, s.quantizedParams, s.normalParams, s.conditions)
 		if err != nil {
 			klog.Errorf("Failed to sample next param: trialID=%d, err=%s", trialID, err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		klog.Infof("Success to sample new trial: trialID=%d, assignments=%v", trialID, assignments)
+		// Katib trial name is assigned here, so the trial can be found without matching the parameters.
+		trialName := fmt.Sprintf("%s-%s", req.GetExperiment().GetName(), utilrand.String(8))
+		err = s.setTrialMapping(trialName, trialID)
+		if err != nil {
+			klog.Errorf("Failed to store trial mapping: trialName=%s, trialID=%d, err=%s", trialName, trialID, err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		klog.Infof("Success to sample new trial: trialName=%s, trialID=%d, assignments=%v", trialName, trialID, assignments)
 		parameterAssignments[i] = &api_v1_beta1.GetSuggestionsReply_ParameterAssignments{
 			Assignments: assignments,
+			TrialName:   trialName,
 		}
 	}
 
 	return &api_v1_beta1.GetSuggestionsReply{
 		ParameterAssignments: parameterAssignments,
+		SearchSpaceExhausted: searchSpaceExhausted,
 	}, nil
 }
 
+// Import the Katib trial to the study. It must be called with the lock.
+func (s *SuggestionService) importTrial(katibTrialName string, ktrial goptuna.FrozenTrial) error {
+	systemAttrs := make(map[string]string, len(ktrial.SystemAttrs)+1)
+	for k, v := range ktrial.SystemAttrs {
+		systemAttrs[k] = v
+	}
+	systemAttrs[katibTrialNameAttrKey] = katibTrialName
+	ktrial.SystemAttrs = systemAttrs
+
+	gtrialID, err := s.study.Storage.CloneTrial(s.study.ID, ktrial)
+	if err != nil {
+		return err
+	}
+	s.trialMapping[katibTrialName] = gtrialID
+	klog.Infof("Import trial: trialName=%s -> trialID=%d, State %s, Evaluation %f",
+		katibTrialName, gtrialID, ktrial.State, ktrial.Value)
+	return nil
+}
+
+// Mark Goptuna trials failed if their assignments are rejected by the Experiment constraints.
+func (s *SuggestionService) syncInfeasibleTrials(ktrials []*api_v1_beta1.Trial) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ktrial := range ktrials {
+		gtrialID, found := s.trialMapping[ktrial.GetName()]
+		if !found {
+			continue
+		}
+		gtrial, err := s.study.Storage.GetTrial(gtrialID)
+		if err != nil {
+			return err
+		}
+		if gtrial.State.IsFinished() {
+			continue
+		}
+		err = s.study.Storage.SetTrialState(gtrialID, goptuna.TrialStateFail)
+		if err != nil {
+			return err
+		}
+		klog.Infof("Mark infeasible trial failed: trialName=%s, trialID=%d", ktrial.GetName(), gtrialID)
+	}
+	return nil
+}
+
 // Sync Goptuna trials with Katib trials.
 func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (err error) {
 	s.mu.Lock()
@@ -107,9 +197,20 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			// But suggestion service cannot know which Katib trial name corresponds to Goptuna trial ID.
 			// Because Katib's trial name is determined by Katib controller after finished this gRPC call.
 			// So `findGoptunaTrialIDByParam()` returns the goptuna trial ID from the parameter values.
-			gtrialID, err = findGoptunaTrialIDByParam(s.study, s.trialMapping, ktrial)
+			gtrialID, err = findGoptunaTrialIDByParam(s.study, s.trialMapping, ktrial, s.quantizedParams, s.normalParams)
+			if err != nil {
+				// Trials which are not suggested by the study, e.g. trials of the warm start experiments
+				// or trials enqueued by users, are imported with their current state.
+				// Running imported trials are updated by the next sync.
+				err = s.importTrial(katibTrialName, ktrial)
+				if err != nil {
+					klog.Errorf("Failed to import trial: trialName=%s, err=%s", katibTrialName, err)
+					return err
+				}
+				continue
+			}
+			err = s.study.Storage.SetTrialSystemAttr(gtrialID, katibTrialNameAttrKey, katibTrialName)
 			if err != nil {
-				klog.Errorf("Failed to find Goptuna Trial ID: trialName=%s, err=%s", katibTrialName, err)
 				return err
 			}
 			s.trialMapping[katibTrialName] = gtrialID
@@ -135,6 +236,12 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			if err != nil {
 				return err
 			}
+			if values, ok := ktrial.SystemAttrs[objectiveValuesAttrKey]; ok {
+				err = s.study.Storage.SetTrialSystemAttr(gtrialID, objectiveValuesAttrKey, values)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		err = s.study.Storage.SetTrialState(gtrialID, ktrial.State)
@@ -159,13 +266,55 @@ func (s *SuggestionService) initStudyAndSearchSpaceAtFirstRun(
 		return nil
 	}
 
-	study, searchSpace, err := createStudyAndSearchSpace(experiment)
+	var storage goptuna.Storage
+	if s.storageDir != "" {
+		var err error
+		storage, err = newFileStorage(s.storageDir)
+		if err != nil {
+			return err
+		}
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment, storage)
+	if err != nil {
+		return err
+	}
+	quantizedParams, err := toQuantizedParams(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	if err != nil {
+		return err
+	}
+	normalParams, err := toNormalParams(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	if err != nil {
+		return err
+	}
+
+	// Restore the trial mapping of the loaded study.
+	trialMapping, err := loadTrialMapping(study)
 	if err != nil {
 		return err
 	}
+	if len(trialMapping) > 0 {
+		klog.Infof("Loaded Goptuna study with %d Katib trials from %s", len(trialMapping), s.storageDir)
+	}
 
 	s.study = study
 	s.searchSpace = searchSpace
+	s.quantizedParams = quantizedParams
+	s.normalParams = normalParams
+	s.conditions = toParameterConditions(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	s.trialMapping = trialMapping
+	return nil
+}
+
+// setTrialMapping stores the Katib trial name of the Goptuna trial in the study and in the trial mapping.
+func (s *SuggestionService) setTrialMapping(katibTrialName string, gtrialID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.study.Storage.SetTrialSystemAttr(gtrialID, katibTrialNameAttrKey, katibTrialName)
+	if err != nil {
+		return err
+	}
+	s.trialMapping[katibTrialName] = gtrialID
 	return nil
 }
 
@@ -178,9 +327,13 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 	}
 
 	algorithmName := req.GetExperiment().GetSpec().GetAlgorithm().GetAlgorithmName()
-	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE {
+	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE &&
+		algorithmName != AlgorithmNSGA2 && algorithmName != AlgorithmGrid {
 		return nil, status.Error(codes.InvalidArgument, "unsupported algorithm")
 	}
+	if len(req.GetExperiment().GetSpec().GetObjective().GetObjectives()) > 1 && (algorithmName == AlgorithmCMAES || algorithmName == AlgorithmTPE) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support multi-objective optimization, use %s or %s.", algorithmName, AlgorithmNSGA2, AlgorithmRandom)
+	}
 
 	params := req.GetExperiment().GetSpec().GetParameterSpecs().GetParameters()
 	if algorithmName == AlgorithmCMAES {
@@ -202,7 +355,31 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 		}
 		paramSet[p.Name] = nil
 	}
-	_, _, err := createStudyAndSearchSpace(req.GetExperiment())
+	// CMA-ES and grid search sample all parameters of the search space together.
+	if len(toParameterConditions(params)) > 0 && (algorithmName == AlgorithmCMAES || algorithmName == AlgorithmGrid) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support conditional parameters", algorithmName)
+	}
+	if _, err := toQuantizedParams(params); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid log-uniform parameter: %s", err.Error())
+	}
+	normalParams, err := toNormalParams(params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid normal parameter: %s", err.Error())
+	}
+	// Grid search enumerates the values, so the normal parameters are not supported.
+	if len(normalParams) > 0 && algorithmName == AlgorithmGrid {
+		return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support normal distribution", algorithmName)
+	}
+	if algorithmName == AlgorithmGrid {
+		searchSpace, err := toGoptunaSearchSpace(params)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to create search space: %s", err.Error())
+		}
+		if _, err = newGridSampler(searchSpace); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	_, _, err = createStudyAndSearchSpace(req.GetExperiment(), nil)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
@@ -125,6 +125,46 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 				RequestNumber: 2,
 			},
 		},
+		{
+			name: "NSGA-II multi-objective request",
+			req: &api_v1_beta1.GetSuggestionsRequest{
+				Experiment: &api_v1_beta1.Experiment{
+					Name: "test",
+					Spec: &api_v1_beta1.ExperimentSpec{
+						Algorithm: &api_v1_beta1.AlgorithmSpec{
+							AlgorithmName: "nsga2",
+							AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+								{
+									Name:  "random_state",
+									Value: "10",
+								},
+								{
+									Name:  "population_size",
+									Value: "2",
+								},
+							},
+						},
+						Objective: &api_v1_beta1.ObjectiveSpec{
+							Type:                  api_v1_beta1.ObjectiveType_MINIMIZE,
+							ObjectiveMetricName:   "metric-1",
+							AdditionalMetricNames: []string{"metric-2"},
+							Objectives: []*api_v1_beta1.Objective{
+								{
+									Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+									ObjectiveMetricName: "metric-1",
+								},
+								{
+									Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+									ObjectiveMetricName: "metric-2",
+								},
+							},
+						},
+						ParameterSpecs: parameterSpecs,
+					},
+				},
+				RequestNumber: 2,
+			},
+		},
 		{
 			name: "Random request",
 			req: &api_v1_beta1.GetSuggestionsRequest{
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog"
+)
+
+// NewSharedSuggestionService returns the service which serves many experiments with a separate Goptuna study for each experiment.
+// Studies which are not used for the idle timeout are evicted. If the number of studies reaches maxStudies,
+// the least recently used study is evicted. Evicted study is created again from the trials of the next request.
+func NewSharedSuggestionService(maxStudies int, idleTimeout time.Duration) *SharedSuggestionService {
+	return &SharedSuggestionService{
+		studies:     make(map[string]*sharedStudy),
+		maxStudies:  maxStudies,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+	}
+}
+
+type SharedSuggestionService struct {
+	mu          sync.Mutex
+	studies     map[string]*sharedStudy // Experiment id -> study of the experiment
+	maxStudies  int
+	idleTimeout time.Duration
+	now         func() time.Time
+}
+
+type sharedStudy struct {
+	service  *SuggestionService
+	lastUsed time.Time
+}
+
+func (s *SharedSuggestionService) GetSuggestions(
+	ctx context.Context,
+	req *api_v1_beta1.GetSuggestionsRequest,
+) (*api_v1_beta1.GetSuggestionsReply, error) {
+	experimentID := req.GetExperimentId()
+	if experimentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "experiment id is required by the shared suggestion service")
+	}
+	return s.getService(experimentID).GetSuggestions(ctx, req)
+}
+
+// ValidateAlgorithmSettings doesn't depend on the study, so the settings are validated without the experiment id.
+func (s *SharedSuggestionService) ValidateAlgorithmSettings(
+	ctx context.Context,
+	req *api_v1_beta1.ValidateAlgorithmSettingsRequest,
+) (*api_v1_beta1.ValidateAlgorithmSettingsReply, error) {
+	return NewSuggestionService().ValidateAlgorithmSettings(ctx, req)
+}
+
+// getService returns the suggestion service with the study of the experiment and creates it at the first run.
+func (s *SharedSuggestionService) getService(experimentID string) *SuggestionService {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.evictStudies(now)
+	study, ok := s.studies[experimentID]
+	if !ok {
+		if s.maxStudies > 0 && len(s.studies) >= s.maxStudies {
+			s.evictLeastRecentlyUsedStudy()
+		}
+		study = &sharedStudy{service: NewSuggestionService()}
+		s.studies[experimentID] = study
+		klog.Infof("Create Goptuna study for experiment %s, studies: %d", experimentID, len(s.studies))
+	}
+	study.lastUsed = now
+	return study.service
+}
+
+// evictStudies deletes studies which are idle longer than the idle timeout. It must be called with the lock.
+func (s *SharedSuggestionService) evictStudies(now time.Time) {
+	if s.idleTimeout <= 0 {
+		return
+	}
+	for experimentID, study := range s.studies {
+		if now.Sub(study.lastUsed) > s.idleTimeout {
+			delete(s.studies, experimentID)
+			klog.Infof("Evict idle Goptuna study for experiment %s", experimentID)
+		}
+	}
+}
+
+// evictLeastRecentlyUsedStudy deletes the least recently used study. It must be called with the lock.
+func (s *SharedSuggestionService) evictLeastRecentlyUsedStudy() {
+	lruExperimentID := ""
+	var lruTime time.Time
+	for experimentID, study := range s.studies {
+		if lruExperimentID == "" || study.lastUsed.Before(lruTime) {
+			lruExperimentID = experimentID
+			lruTime = study.lastUsed
+		}
+	}
+	if lruExperimentID != "" {
+		delete(s.studies, lruExperimentID)
+		klog.Infof("Evict least recently used Goptuna study for experiment %s", lruExperimentID)
+	}
+}
+
+// This is a compile-time assertion to ensure that SharedSuggestionService
+// implements an api_v1_beta1.SuggestionServer interface.
+var _ api_v1_beta1.SuggestionServer = &SharedSuggestionService{}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSharedSuggestionService(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmRandom,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "metric-1",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "batch_size",
+						ParameterType: api_v1_beta1.ParameterType_INT,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "16", Max: "512"},
+					},
+				},
+			},
+		},
+	}
+
+	now := time.Now()
+	s := NewSharedSuggestionService(2, time.Hour)
+	s.now = func() time.Time { return now }
+
+	getSuggestions := func(experimentID string) error {
+		_, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:    experiment,
+			RequestNumber: 1,
+			ExperimentId:  experimentID,
+		})
+		return err
+	}
+	trialCount := func(experimentID string) int {
+		study, ok := s.studies[experimentID]
+		if !ok {
+			return 0
+		}
+		return len(study.service.trialMapping)
+	}
+
+	if err := getSuggestions(""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error without experiment id, got %v", err)
+	}
+
+	// Each experiment has a separate study.
+	for _, experimentID := range []string{"ns/exp-1/uid-1", "ns/exp-1/uid-1", "ns/exp-2/uid-2"} {
+		if err := getSuggestions(experimentID); err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+		now = now.Add(time.Minute)
+	}
+	if got := trialCount("ns/exp-1/uid-1"); got != 2 {
+		t.Errorf("Expected 2 trials in the study of exp-1, got %v", got)
+	}
+	if got := trialCount("ns/exp-2/uid-2"); got != 1 {
+		t.Errorf("Expected 1 trial in the study of exp-2, got %v", got)
+	}
+
+	// The least recently used study is evicted if the number of studies reaches the limit.
+	if err := getSuggestions("ns/exp-3/uid-3"); err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	if _, ok := s.studies["ns/exp-1/uid-1"]; ok || len(s.studies) != 2 {
+		t.Errorf("Expected evicted study of exp-1, got %v studies", len(s.studies))
+	}
+
+	// Idle studies are evicted.
+	now = now.Add(2 * time.Hour)
+	if err := getSuggestions("ns/exp-1/uid-1"); err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	if len(s.studies) != 1 || trialCount("ns/exp-1/uid-1") != 1 {
+		t.Errorf("Expected only new study of exp-1, got %v studies", len(s.studies))
+	}
+}
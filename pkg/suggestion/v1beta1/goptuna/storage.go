@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/c-bata/goptuna"
+	"github.com/c-bata/goptuna/rdb"
+	"github.com/jinzhu/gorm"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	// Trial system attribute with the name of the Katib trial.
+	katibTrialNameAttrKey = "katib:trialName"
+
+	storageFileName = "goptuna.db"
+	sqliteDriver    = "sqlite"
+	// modernc.org/sqlite is compatible with the sqlite3 dialect of gorm.
+	gormSQLiteDialect = "sqlite3"
+)
+
+// newFileStorage returns the Goptuna RDB storage, which is stored in the SQLite file under the directory.
+func newFileStorage(dir string) (goptuna.Storage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("Failed to create storage directory %s: %v", dir, err)
+	}
+	sqlDB, err := sql.Open(sqliteDriver, filepath.Join(dir, storageFileName))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open storage: %v", err)
+	}
+	// SQLite doesn't support concurrent writes.
+	sqlDB.SetMaxOpenConns(1)
+	db, err := gorm.Open(gormSQLiteDialect, sqlDB)
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("Failed to open storage: %v", err)
+	}
+	rdb.RunAutoMigrate(db)
+	return rdb.NewStorage(db), nil
+}
+
+// loadTrialMapping returns the Katib trial name to Goptuna trial ID mapping, which is stored in the study.
+func loadTrialMapping(study *goptuna.Study) (map[string]int, error) {
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	trialMapping := make(map[string]int, len(trials))
+	for _, t := range trials {
+		if name, ok := t.SystemAttrs[katibTrialNameAttrKey]; ok {
+			trialMapping[name] = t.ID
+		}
+	}
+	return trialMapping, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestPersistentSuggestionService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goptuna")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmCMAES,
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{Name: "random_state", Value: "1"},
+				},
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "x",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0", Max: "1"},
+					},
+					{
+						Name:          "y",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{Min: "0", Max: "1"},
+					},
+				},
+			},
+		},
+	}
+
+	s := NewPersistentSuggestionService(dir)
+	reply, err := s.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		RequestNumber: 2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get suggestions: %v", err)
+	}
+
+	trials := make([]*api_v1_beta1.Trial, 0, len(reply.ParameterAssignments))
+	for _, pa := range reply.ParameterAssignments {
+		if pa.TrialName == "" {
+			t.Fatalf("Trial name must be set in the reply")
+		}
+		trials = append(trials, &api_v1_beta1.Trial{
+			Name: pa.TrialName,
+			Spec: &api_v1_beta1.TrialSpec{
+				Objective: experiment.Spec.Objective,
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: pa.Assignments,
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{
+						{Name: "loss", Value: "0.5"},
+					},
+				},
+			},
+		})
+	}
+
+	// Suggestion service is restarted with the same storage.
+	restarted := NewPersistentSuggestionService(dir)
+	_, err = restarted.GetSuggestions(context.TODO(), &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:    experiment,
+		Trials:        trials,
+		RequestNumber: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get suggestions after restart: %v", err)
+	}
+
+	for name, id := range s.trialMapping {
+		if restarted.trialMapping[name] != id {
+			t.Errorf("Trial %v must be mapped to %v after restart, got %v", name, id, restarted.trialMapping[name])
+		}
+		gtrial, err := restarted.study.Storage.GetTrial(id)
+		if err != nil {
+			t.Fatalf("Failed to get trial: %v", err)
+		}
+		if gtrial.State != goptuna.TrialStateComplete {
+			t.Errorf("Trial %v must be completed, got %v", name, gtrial.State)
+		}
+		if _, ok := gtrial.SystemAttrs["goptuna:cmaes:generationId"]; !ok {
+			t.Errorf("CMA-ES generation of trial %v must be kept after restart", name)
+		}
+	}
+
+	gtrials, err := restarted.study.GetTrials()
+	if err != nil {
+		t.Fatalf("Failed to get trials: %v", err)
+	}
+	if len(gtrials) != 3 {
+		t.Errorf("Study must have 3 trials after restart, got %v", len(gtrials))
+	}
+	mapping, err := loadTrialMapping(restarted.study)
+	if err != nil {
+		t.Fatalf("Failed to load trial mapping: %v", err)
+	}
+	if !reflect.DeepEqual(mapping, restarted.trialMapping) {
+		t.Errorf("Stored trial mapping %v must be equal to %v", mapping, restarted.trialMapping)
+	}
+}
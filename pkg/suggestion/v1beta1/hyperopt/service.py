@@ -57,6 +57,9 @@ class HyperoptService(api_pb2_grpc.SuggestionServicer, HealthServicer):
     def ValidateAlgorithmSettings(self, request, context):
         is_valid, message = OptimizerConfiguration.validate_algorithm_spec(
             request.experiment.spec.algorithm)
+        if is_valid:
+            message = HyperParameterSearchSpace.validate_unsupported(request.experiment)
+            is_valid = message is None
         if not is_valid:
             context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
             context.set_details(message)
@@ -39,6 +39,20 @@ class HyperParameterSearchSpace(object):
                 HyperParameterSearchSpace.convertParameter(p))
         return search_space
 
+    @staticmethod
+    def validate_unsupported(experiment):
+        """
+        Returns the error message if the parameters have the distribution or the condition,
+        which are not supported by the Python suggestion services.
+        """
+        for p in experiment.spec.parameter_specs.parameters:
+            if p.feasible_space.distribution != api.UNIFORM:
+                return "distribution {} of parameter {} is not supported".format(
+                    api.Distribution.Name(p.feasible_space.distribution), p.name)
+            if p.HasField("condition"):
+                return "condition of parameter {} is not supported".format(p.name)
+        return None
+
     def __str__(self):
         return "HyperParameterSearchSpace(goal: {}, ".format(self.goal) + \
             "params: {})".format(", ".join([element.__str__() for element in self.params]))
@@ -28,6 +28,7 @@ import (
 
 	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 )
 
@@ -44,7 +45,12 @@ func NewKatibUIHandler(dbManagerAddr string) *KatibUIHandler {
 }
 
 func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient) {
-	conn, err := grpc.Dial(k.dbManagerAddr, grpc.WithInsecure())
+	tlsOpt, err := grpctls.DialOption()
+	if err != nil {
+		log.Printf("Load gRPC TLS configuration failed: %v", err)
+		return nil, nil
+	}
+	conn, err := grpc.Dial(k.dbManagerAddr, tlsOpt)
 	if err != nil {
 		log.Printf("Dial to GRPC failed: %v", err)
 		return nil, nil
@@ -17,7 +17,6 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
 	"encoding/json"
 	"log"
 	"net/http"
@@ -80,8 +79,8 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 		trialResText := make([]string, len(metricsList)+len(paramList))
 
 		if t.IsSucceeded() || t.IsEarlyStopped() {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
+			obsLog, err := getObservationLog(
+				c,
 				&api_pb_v1beta1.GetObservationLogRequest{
 					TrialName: t.Name,
 					StartTime: "",
@@ -94,7 +93,7 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 				return
 			}
 
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range obsLog.MetricLogs {
 				if trialResText[metricsList[m.Metric.Name]] == "" {
 					trialResText[metricsList[m.Metric.Name]] = m.Metric.Value
 				} else {
@@ -143,12 +142,19 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	// resultArray - array of arrays, where [i][0] - metricName, [i][1] - metricTime, [i][2] - metricValue
 	var resultArray [][]string
 	resultArray = append(resultArray, strings.Split("metricName,time,value", ","))
-	obsLogResp, err := c.GetObservationLog(
-		context.Background(),
+	downsample, err := getDownsampleSpec(r)
+	if err != nil {
+		log.Printf("Parse downsample failed: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	obsLog, err := getObservationLog(
+		c,
 		&api_pb_v1beta1.GetObservationLogRequest{
-			TrialName: trialName,
-			StartTime: "",
-			EndTime:   "",
+			TrialName:  trialName,
+			StartTime:  "",
+			EndTime:    "",
+			Downsample: downsample,
 		},
 	)
 	if err != nil {
@@ -160,7 +166,7 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	// prevMetricTimeValue is the dict, where key = metric name,
 	// value = array, where [0] - Last metric time, [1] - Best metric value for this time
 	prevMetricTimeValue := make(map[string][]string)
-	for _, m := range obsLogResp.ObservationLog.MetricLogs {
+	for _, m := range obsLog.MetricLogs {
 		parsedCurrentTime, _ := time.Parse(time.RFC3339Nano, m.TimeStamp)
 		formatCurrentTime := parsedCurrentTime.Format("2006-01-02T15:04:05")
 		if _, found := prevMetricTimeValue[m.Metric.Name]; !found {
@@ -17,7 +17,6 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
 	"encoding/json"
 	"log"
 	"net/http"
@@ -56,8 +55,8 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 			}
 		}
 		if succeeded {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
+			obsLog, err := getObservationLog(
+				c,
 				&api_pb_v1beta1.GetObservationLogRequest{
 					TrialName: t.Name,
 					StartTime: "",
@@ -71,7 +70,7 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 			}
 			metricsName := make([]string, 0)
 			metricsValue := make([]string, 0)
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range obsLog.MetricLogs {
 				metricsName = append(metricsName, m.Metric.Name)
 				metricsValue = append(metricsValue, m.Metric.Value)
 
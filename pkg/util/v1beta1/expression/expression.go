@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package expression implements expressions over the Experiment parameters, which are used
+in the parameter constraints and the derived Trial parameters, e.g.
+
+	batch_size * grad_accum <= 512
+	${optimizer} == "sgd" || ${lr} < 0.01
+	round(0.1 * total_steps)
+
+Parameters are referenced by the name, names with characters other than letters, digits and
+underscore are referenced as ${name}. Parameter values are numbers if they can be parsed
+as numbers, otherwise they are strings. Operators == and != compare the number and the string
+as numbers if the string can be parsed as a number, otherwise as strings, e.g. ${num-layers} == "3"
+is true for the discrete parameter with value 3.
+
+Expressions support arithmetic operators + - * / %, comparison operators < <= > >= == !=,
+logical operators && || !, string literals in quotes, true and false, and functions
+min, max, abs, floor, ceil, round, sqrt, log, exp and pow.
+*/
+package expression
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Expression is the parsed expression.
+type Expression struct {
+	source     string
+	root       node
+	parameters []string
+}
+
+// Parse parses the expression.
+func Parse(source string) (*Expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid expression %q: %v", source, err)
+	}
+	p := &parser{tokens: tokens, parameters: map[string]bool{}}
+	root, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Invalid expression %q: %v", source, err)
+	}
+
+	parameters := make([]string, 0, len(p.parameters))
+	for name := range p.parameters {
+		parameters = append(parameters, name)
+	}
+	sort.Strings(parameters)
+	return &Expression{source: source, root: root, parameters: parameters}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Parameters returns sorted names of the parameters referenced by the expression.
+func (e *Expression) Parameters() []string {
+	return e.parameters
+}
+
+// EvaluateBool evaluates the expression, which result must be true or false.
+func (e *Expression) EvaluateBool(parameters map[string]string) (bool, error) {
+	v, err := e.evaluate(parameters)
+	if err != nil {
+		return false, err
+	}
+	if v.kind != kindBool {
+		return false, fmt.Errorf("Expression %q returns %v, expected true or false", e.source, v)
+	}
+	return v.b, nil
+}
+
+// EvaluateString evaluates the expression and returns the result as a string.
+// Numbers are formatted without exponent, e.g. 100 or 0.001.
+func (e *Expression) EvaluateString(parameters map[string]string) (string, error) {
+	v, err := e.evaluate(parameters)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+func (e *Expression) evaluate(parameters map[string]string) (value, error) {
+	v, err := e.root.eval(parameters)
+	if err != nil {
+		return value{}, fmt.Errorf("Unable to evaluate expression %q: %v", e.source, err)
+	}
+	return v, nil
+}
+
+type valueKind int
+
+const (
+	kindNumber valueKind = iota
+	kindString
+	kindBool
+)
+
+type value struct {
+	kind valueKind
+	num  float64
+	str  string
+	b    bool
+}
+
+func numberValue(num float64) value { return value{kind: kindNumber, num: num} }
+func stringValue(str string) value  { return value{kind: kindString, str: str} }
+func boolValue(b bool) value        { return value{kind: kindBool, b: b} }
+
+// parameterValue converts the parameter assignment to the number if it is possible.
+func parameterValue(str string) value {
+	if num, err := strconv.ParseFloat(str, 64); err == nil {
+		return numberValue(num)
+	}
+	return stringValue(str)
+}
+
+// coerce converts the string to the number if it is possible.
+func coerce(v value) value {
+	if v.kind == kindString {
+		return parameterValue(v.str)
+	}
+	return v
+}
+
+func (v value) String() string {
+	switch v.kind {
+	case kindNumber:
+		return strconv.FormatFloat(v.num, 'f', -1, 64)
+	case kindBool:
+		return strconv.FormatBool(v.b)
+	default:
+		return v.str
+	}
+}
+
+// functions are the functions which can be called in the expressions.
+// Number of arguments is -1 for the functions with the variable number of arguments.
+var functions = map[string]struct {
+	args int
+	call func(args []float64) float64
+}{
+	"min":   {-1, func(a []float64) float64 { return reduce(a, math.Min) }},
+	"max":   {-1, func(a []float64) float64 { return reduce(a, math.Max) }},
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"floor": {1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"round": {1, func(a []float64) float64 { return math.Round(a[0]) }},
+	"sqrt":  {1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"log":   {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"exp":   {1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"pow":   {2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+}
+
+func reduce(args []float64, f func(x, y float64) float64) float64 {
+	result := args[0]
+	for _, a := range args[1:] {
+		result = f(result, a)
+	}
+	return result
+}
+
+type node interface {
+	eval(parameters map[string]string) (value, error)
+}
+
+type literalNode struct {
+	v value
+}
+
+func (n literalNode) eval(map[string]string) (value, error) {
+	return n.v, nil
+}
+
+type parameterNode struct {
+	name string
+}
+
+func (n parameterNode) eval(parameters map[string]string) (value, error) {
+	str, ok := parameters[n.name]
+	if !ok {
+		return value{}, fmt.Errorf("parameter %v is not assigned", n.name)
+	}
+	return parameterValue(str), nil
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+func (n unaryNode) eval(parameters map[string]string) (value, error) {
+	x, err := n.x.eval(parameters)
+	if err != nil {
+		return value{}, err
+	}
+	switch {
+	case n.op == "!" && x.kind == kindBool:
+		return boolValue(!x.b), nil
+	case n.op == "-" && x.kind == kindNumber:
+		return numberValue(-x.num), nil
+	case n.op == "+" && x.kind == kindNumber:
+		return x, nil
+	}
+	return value{}, fmt.Errorf("operator %v is not supported for %q", n.op, x)
+}
+
+type binaryNode struct {
+	op   string
+	x, y node
+}
+
+func (n binaryNode) eval(parameters map[string]string) (value, error) {
+	x, err := n.x.eval(parameters)
+	if err != nil {
+		return value{}, err
+	}
+
+	// Logical operators are evaluated lazily.
+	if n.op == "&&" || n.op == "||" {
+		if x.kind != kindBool {
+			return value{}, fmt.Errorf("operator %v is not supported for %q", n.op, x)
+		}
+		if (n.op == "&&") != x.b {
+			return x, nil
+		}
+		y, err := n.y.eval(parameters)
+		if err != nil {
+			return value{}, err
+		}
+		if y.kind != kindBool {
+			return value{}, fmt.Errorf("operator %v is not supported for %q", n.op, y)
+		}
+		return y, nil
+	}
+
+	y, err := n.y.eval(parameters)
+	if err != nil {
+		return value{}, err
+	}
+	if n.op == "==" || n.op == "!=" {
+		if x.kind != y.kind {
+			x, y = coerce(x), coerce(y)
+		}
+		if x.kind != y.kind && x.kind != kindBool && y.kind != kindBool {
+			x, y = stringValue(x.String()), stringValue(y.String())
+		}
+		if x.kind != y.kind {
+			return value{}, fmt.Errorf("unable to compare %q and %q", x, y)
+		}
+		return boolValue((x == y) == (n.op == "==")), nil
+	}
+	if x.kind != kindNumber || y.kind != kindNumber {
+		return value{}, fmt.Errorf("operator %v is not supported for %q and %q", n.op, x, y)
+	}
+
+	switch n.op {
+	case "+":
+		return numberValue(x.num + y.num), nil
+	case "-":
+		return numberValue(x.num - y.num), nil
+	case "*":
+		return numberValue(x.num * y.num), nil
+	case "/", "%":
+		if y.num == 0 {
+			return value{}, fmt.Errorf("division by zero")
+		}
+		if n.op == "/" {
+			return numberValue(x.num / y.num), nil
+		}
+		return numberValue(math.Mod(x.num, y.num)), nil
+	case "<":
+		return boolValue(x.num < y.num), nil
+	case "<=":
+		return boolValue(x.num <= y.num), nil
+	case ">":
+		return boolValue(x.num > y.num), nil
+	case ">=":
+		return boolValue(x.num >= y.num), nil
+	}
+	return value{}, fmt.Errorf("unknown operator %v", n.op)
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+func (n callNode) eval(parameters map[string]string) (value, error) {
+	args := make([]float64, 0, len(n.args))
+	for _, a := range n.args {
+		v, err := a.eval(parameters)
+		if err != nil {
+			return value{}, err
+		}
+		if v.kind != kindNumber {
+			return value{}, fmt.Errorf("function %v is not supported for %q", n.name, v)
+		}
+		args = append(args, v.num)
+	}
+	result := functions[n.name].call(args)
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return value{}, fmt.Errorf("function %v returns %v for %v", n.name, result, args)
+	}
+	return numberValue(result), nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expression
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		expression         string
+		expectedParameters []string
+		expectedErr        bool
+		testDesc           string
+	}{
+		{
+			expression:         "batch_size * grad_accum <= 512",
+			expectedParameters: []string{"batch_size", "grad_accum"},
+			testDesc:           "Constraint with parameter names",
+		},
+		{
+			expression:         `${optimizer} == "sgd" || ${learning-rate} < 1e-2 && !(${num-layers} % 2 == 0)`,
+			expectedParameters: []string{"learning-rate", "num-layers", "optimizer"},
+			testDesc:           "Constraint with parameter references",
+		},
+		{
+			expression:         "max(round(0.1 * total_steps), 10)",
+			expectedParameters: []string{"total_steps"},
+			testDesc:           "Derived parameter with functions",
+		},
+		{
+			expression:  "batch_size * ",
+			expectedErr: true,
+			testDesc:    "Incomplete expression",
+		},
+		{
+			expression:  "(batch_size <= 512",
+			expectedErr: true,
+			testDesc:    "Parenthesis is not closed",
+		},
+		{
+			expression:  "1 < batch_size < 512",
+			expectedErr: true,
+			testDesc:    "Chained comparison",
+		},
+		{
+			expression:  "mean(batch_size)",
+			expectedErr: true,
+			testDesc:    "Unknown function",
+		},
+		{
+			expression:  "pow(batch_size)",
+			expectedErr: true,
+			testDesc:    "Invalid number of function arguments",
+		},
+		{
+			expression:  "${num-layers > 2",
+			expectedErr: true,
+			testDesc:    "Parameter reference is not closed",
+		},
+		{
+			expression:  `${optimizer} == "sgd`,
+			expectedErr: true,
+			testDesc:    "String is not closed",
+		},
+	}
+
+	for _, tc := range testCases {
+		e, err := Parse(tc.expression)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("Case: %v. Expected error, got nil", tc.testDesc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Case: %v. Unexpected error: %v", tc.testDesc, err)
+		} else if !reflect.DeepEqual(e.Parameters(), tc.expectedParameters) {
+			t.Errorf("Case: %v. Expected parameters %v, got %v", tc.testDesc, tc.expectedParameters, e.Parameters())
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	parameters := map[string]string{
+		"batch_size":    "64",
+		"grad_accum":    "16",
+		"total_steps":   "1005",
+		"optimizer":     "adam",
+		"learning-rate": "0.005",
+		"num-layers":    "3",
+	}
+
+	testCases := []struct {
+		expression     string
+		expectedResult string
+		expectedErr    bool
+		testDesc       string
+	}{
+		{
+			expression:     "batch_size * grad_accum <= 512",
+			expectedResult: "false",
+			testDesc:       "Constraint is violated",
+		},
+		{
+			expression:     `${optimizer} != "sgd" && ${learning-rate} < 1e-2`,
+			expectedResult: "true",
+			testDesc:       "Constraint with string parameter is satisfied",
+		},
+		{
+			expression:     "round(0.1 * total_steps)",
+			expectedResult: "101",
+			testDesc:       "Derived integer parameter",
+		},
+		{
+			expression:     "-batch_size / 128 + 2 * 3 % 4",
+			expectedResult: "1.5",
+			testDesc:       "Operator precedence",
+		},
+		{
+			expression:     `${optimizer} == "sgd" && batch_size / 0 > 1`,
+			expectedResult: "false",
+			testDesc:       "Logical operators are evaluated lazily",
+		},
+		{
+			expression:     `${num-layers} == "3" && ${learning-rate} != "5e-3"`,
+			expectedResult: "false",
+			testDesc:       "Number parameter is compared with numeric string",
+		},
+		{
+			expression:     `${num-layers} != "three"`,
+			expectedResult: "true",
+			testDesc:       "Number parameter is compared with string",
+		},
+		{
+			expression:  `${num-layers} == true`,
+			expectedErr: true,
+			testDesc:    "Number parameter is compared with bool",
+		},
+		{
+			expression:  "batch_size / (grad_accum - 16)",
+			expectedErr: true,
+			testDesc:    "Division by zero",
+		},
+		{
+			expression:  "optimizer * 2",
+			expectedErr: true,
+			testDesc:    "Arithmetic operator for string parameter",
+		},
+		{
+			expression:  "momentum > 0.5",
+			expectedErr: true,
+			testDesc:    "Parameter is not assigned",
+		},
+		{
+			expression:  "log(grad_accum - 16)",
+			expectedErr: true,
+			testDesc:    "Function returns infinity",
+		},
+	}
+
+	for _, tc := range testCases {
+		e, err := Parse(tc.expression)
+		if err != nil {
+			t.Fatalf("Case: %v. Failed to parse expression: %v", tc.testDesc, err)
+		}
+		result, err := e.EvaluateString(parameters)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("Case: %v. Expected error, got result %v", tc.testDesc, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Case: %v. Unexpected error: %v", tc.testDesc, err)
+		} else if result != tc.expectedResult {
+			t.Errorf("Case: %v. Expected result %v, got %v", tc.testDesc, tc.expectedResult, result)
+		}
+	}
+}
+
+func TestEvaluateBool(t *testing.T) {
+	e, err := Parse("batch_size + 1")
+	if err != nil {
+		t.Fatalf("Failed to parse expression: %v", err)
+	}
+	if _, err = e.EvaluateBool(map[string]string{"batch_size": "1"}); err == nil {
+		t.Errorf("Expected error for the number result, got nil")
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenParameter
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// operators are sorted by length, so the longest operator is matched first.
+var operators = []string{"<=", ">=", "==", "!=", "&&", "||", "+", "-", "*", "/", "%", "<", ">", "!", "(", ")", ","}
+
+func isIdentChar(r rune, first bool) bool {
+	return r == '_' || unicode.IsLetter(r) || (!first && unicode.IsDigit(r))
+}
+
+func tokenize(source string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// Exponent, e.g. 1e-3.
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for i = j; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+					}
+				}
+			}
+			text := string(runes[start:i])
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, pos: start})
+		case isIdentChar(r, true):
+			start := i
+			for i < len(runes) && isIdentChar(runes[i], false) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case r == '$':
+			start := i
+			if i+1 >= len(runes) || runes[i+1] != '{' {
+				return nil, fmt.Errorf("expected ${name} at position %d", start)
+			}
+			end := i + 2
+			for end < len(runes) && runes[end] != '}' {
+				end++
+			}
+			if end >= len(runes) || end == i+2 {
+				return nil, fmt.Errorf("parameter reference at position %d is not closed or empty", start)
+			}
+			tokens = append(tokens, token{kind: tokenParameter, text: string(runes[i+2 : end]), pos: start})
+			i = end + 1
+		case r == '"' || r == '\'':
+			start := i
+			i++
+			for i < len(runes) && runes[i] != r {
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("string at position %d is not closed", start)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start+1 : i]), pos: start})
+			i++
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(runes)}), nil
+}
+
+// parser is the recursive descent parser of the expression.
+// Operators from the lowest precedence are: ||, &&, comparison, + -, * / %, unary ! - +.
+type parser struct {
+	tokens     []token
+	pos        int
+	parameters map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// acceptOperator consumes the next token if it is one of the operators.
+func (p *parser) acceptOperator(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expectOperator(op string) error {
+	if _, ok := p.acceptOperator(op); !ok {
+		return fmt.Errorf("expected %q at position %d, got %q", op, p.peek().pos, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) parseBinary(parseOperand func() (node, error), ops ...string) (node, error) {
+	x, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.acceptOperator(ops...)
+		if !ok {
+			return x, nil
+		}
+		y, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		x = binaryNode{op: op, x: x, y: y}
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseComparison, "&&")
+}
+
+// parseComparison parses the comparison, comparisons can't be chained.
+func (p *parser) parseComparison() (node, error) {
+	x, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.acceptOperator("<=", ">=", "==", "!=", "<", ">")
+	if !ok {
+		return x, nil
+	}
+	y, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode{op: op, x: x, y: y}, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if op, ok := p.acceptOperator("!", "-", "+"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: op, x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		num, _ := strconv.ParseFloat(t.text, 64)
+		return literalNode{v: numberValue(num)}, nil
+	case tokenString:
+		return literalNode{v: stringValue(t.text)}, nil
+	case tokenParameter:
+		p.parameters[t.text] = true
+		return parameterNode{name: t.text}, nil
+	case tokenIdent:
+		if t.text == "true" || t.text == "false" {
+			return literalNode{v: boolValue(t.text == "true")}, nil
+		}
+		if _, ok := p.acceptOperator("("); ok {
+			return p.parseCall(t)
+		}
+		p.parameters[t.text] = true
+		return parameterNode{name: t.text}, nil
+	case tokenOperator:
+		if t.text == "(" {
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expectOperator(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+// parseCall parses arguments of the function call after the opening parenthesis.
+func (p *parser) parseCall(name token) (node, error) {
+	f, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %v at position %d", name.text, name.pos)
+	}
+	args := []node{}
+	if _, ok := p.acceptOperator(")"); !ok {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.acceptOperator(","); !ok {
+				break
+			}
+		}
+		if err := p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+	}
+	if (f.args == -1 && len(args) == 0) || (f.args != -1 && len(args) != f.args) {
+		return nil, fmt.Errorf("invalid number of arguments for function %v at position %d", name.text, name.pos)
+	}
+	return callNode{name: name.text, args: args}, nil
+}
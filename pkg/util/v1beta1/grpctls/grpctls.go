@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// Config is the TLS configuration for Katib gRPC servers and clients.
+// Server uses TLS if CertFile and KeyFile are set and requires client certificates (mTLS) if CAFile is set.
+// Client uses TLS if CAFile is set and presents client certificate if CertFile and KeyFile are set.
+type Config struct {
+	CertFile   string
+	KeyFile    string
+	CAFile     string
+	ServerName string
+}
+
+// NewConfigFromEnv returns the TLS configuration from the KATIB_GRPC_TLS_* env variables.
+func NewConfigFromEnv() Config {
+	return Config{
+		CertFile:   os.Getenv(consts.GRPCTLSCertFileEnvName),
+		KeyFile:    os.Getenv(consts.GRPCTLSKeyFileEnvName),
+		CAFile:     os.Getenv(consts.GRPCTLSCAFileEnvName),
+		ServerName: os.Getenv(consts.GRPCTLSServerNameEnvName),
+	}
+}
+
+// ServerEnabled returns true if gRPC server must use TLS.
+func (c Config) ServerEnabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// ClientEnabled returns true if gRPC client must use TLS.
+func (c Config) ClientEnabled() bool {
+	return c.CAFile != ""
+}
+
+// ServerOptions returns gRPC server options for the TLS configuration.
+// Empty options are returned if TLS is disabled.
+func (c Config) ServerOptions() ([]grpc.ServerOption, error) {
+	if !c.ServerEnabled() {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load server certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.CAFile != "" {
+		certPool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = certPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// DialOption returns gRPC dial option for the TLS configuration.
+// Insecure option is returned if TLS is disabled.
+func (c Config) DialOption() (grpc.DialOption, error) {
+	if !c.ClientEnabled() {
+		return grpc.WithInsecure(), nil
+	}
+	certPool, err := loadCertPool(c.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    certPool,
+		ServerName: c.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if c.CertFile != "" && c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// ServerOptions returns gRPC server options for the TLS configuration from the env.
+func ServerOptions() ([]grpc.ServerOption, error) {
+	return NewConfigFromEnv().ServerOptions()
+}
+
+// DialOption returns gRPC dial option for the TLS configuration from the env.
+func DialOption() (grpc.DialOption, error) {
+	return NewConfigFromEnv().DialOption()
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caCert, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read CA certificate: %v", err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("Failed to parse CA certificate from %v", caFile)
+	}
+	return certPool, nil
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2021 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
+)
+
+const testServerName = "katib-db-manager"
+
+type healthService struct {
+}
+
+func (s *healthService) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*health_pb.HealthCheckResponse, error) {
+	return &health_pb.HealthCheckResponse{
+		Status: health_pb.HealthCheckResponse_SERVING,
+	}, nil
+}
+
+func TestTLSConnection(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpctls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	caFile, caCert, caKey := writeCert(t, dir, "ca", nil, nil)
+	certFile, keyFile := writeLeafCert(t, dir, "server", caCert, caKey)
+	clientCertFile, clientKeyFile := writeLeafCert(t, dir, "client", caCert, caKey)
+	otherCAFile, otherCACert, otherCAKey := writeCert(t, dir, "other-ca", nil, nil)
+	otherCertFile, otherKeyFile := writeLeafCert(t, dir, "other-client", otherCACert, otherCAKey)
+
+	serverConfig := Config{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		CAFile:   caFile,
+	}
+
+	tcs := []struct {
+		clientConfig Config
+		err          bool
+		testDesc     string
+	}{
+		{
+			clientConfig: Config{
+				CertFile:   clientCertFile,
+				KeyFile:    clientKeyFile,
+				CAFile:     caFile,
+				ServerName: testServerName,
+			},
+			err:      false,
+			testDesc: "Client with certificate signed by CA",
+		},
+		{
+			clientConfig: Config{
+				CAFile:     caFile,
+				ServerName: testServerName,
+			},
+			err:      true,
+			testDesc: "Client without certificate",
+		},
+		{
+			clientConfig: Config{
+				CertFile:   otherCertFile,
+				KeyFile:    otherKeyFile,
+				CAFile:     caFile,
+				ServerName: testServerName,
+			},
+			err:      true,
+			testDesc: "Client with certificate signed by another CA",
+		},
+		{
+			clientConfig: Config{
+				CertFile:   clientCertFile,
+				KeyFile:    clientKeyFile,
+				CAFile:     otherCAFile,
+				ServerName: testServerName,
+			},
+			err:      true,
+			testDesc: "Client doesn't trust server certificate",
+		},
+		{
+			clientConfig: Config{
+				CertFile:   clientCertFile,
+				KeyFile:    clientKeyFile,
+				CAFile:     caFile,
+				ServerName: "invalid-name",
+			},
+			err:      true,
+			testDesc: "Invalid server name",
+		},
+		{
+			clientConfig: Config{},
+			err:          true,
+			testDesc:     "Insecure client",
+		},
+	}
+
+	serverOpts, err := serverConfig.ServerOptions()
+	if err != nil {
+		t.Fatalf("Failed to get server options: %v", err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(serverOpts...)
+	health_pb.RegisterHealthServer(srv, &healthService{})
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	for _, tc := range tcs {
+		dialOpt, err := tc.clientConfig.DialOption()
+		if err != nil {
+			t.Errorf("Case: %v failed. Failed to get dial option: %v", tc.testDesc, err)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		conn, err := grpc.DialContext(ctx, l.Addr().String(), dialOpt)
+		if err != nil {
+			t.Errorf("Case: %v failed. Failed to dial: %v", tc.testDesc, err)
+			cancel()
+			continue
+		}
+		_, err = health_pb.NewHealthClient(conn).Check(ctx, &health_pb.HealthCheckRequest{})
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDesc, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDesc)
+		}
+		conn.Close()
+		cancel()
+	}
+}
+
+func TestConfig(t *testing.T) {
+	tcs := []struct {
+		config        Config
+		serverEnabled bool
+		clientEnabled bool
+		testDesc      string
+	}{
+		{
+			config:        Config{},
+			serverEnabled: false,
+			clientEnabled: false,
+			testDesc:      "TLS is disabled",
+		},
+		{
+			config: Config{
+				CertFile: "tls.crt",
+				KeyFile:  "tls.key",
+			},
+			serverEnabled: true,
+			clientEnabled: false,
+			testDesc:      "Server TLS without client verification",
+		},
+		{
+			config: Config{
+				CertFile: "tls.crt",
+				KeyFile:  "tls.key",
+				CAFile:   "ca.crt",
+			},
+			serverEnabled: true,
+			clientEnabled: true,
+			testDesc:      "Mutual TLS",
+		},
+	}
+	for _, tc := range tcs {
+		if tc.config.ServerEnabled() != tc.serverEnabled {
+			t.Errorf("Case: %v failed. Expected server enabled %v", tc.testDesc, tc.serverEnabled)
+		}
+		if tc.config.ClientEnabled() != tc.clientEnabled {
+			t.Errorf("Case: %v failed. Expected client enabled %v", tc.testDesc, tc.clientEnabled)
+		}
+	}
+
+	// Missing files must be reported.
+	missing := Config{
+		CertFile: "missing.crt",
+		KeyFile:  "missing.key",
+		CAFile:   "missing-ca.crt",
+	}
+	if _, err := missing.ServerOptions(); err == nil {
+		t.Errorf("Expected err for missing server certificate, got nil")
+	}
+	if _, err := missing.DialOption(); err == nil {
+		t.Errorf("Expected err for missing CA certificate, got nil")
+	}
+}
+
+func writeLeafCert(t *testing.T, dir, name string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) (string, string) {
+	certFile, _, key := writeCert(t, dir, name, caCert, caKey)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, name+".key")
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+// writeCert writes self-signed CA certificate if caCert is nil, otherwise leaf certificate signed by caCert.
+func writeCert(t *testing.T, dir, name string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) (string, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if caCert == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+		caCert, caKey = template, key
+	} else {
+		template.DNSNames = []string{testServerName}
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := filepath.Join(dir, name+".crt")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, cert, key
+}
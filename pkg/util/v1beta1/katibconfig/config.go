@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"path/filepath"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -40,6 +41,14 @@ type SuggestionConfig struct {
 	VolumeMountPath           string                           `json:"volumeMountPath,omitempty"`
 	PersistentVolumeClaimSpec corev1.PersistentVolumeClaimSpec `json:"persistentVolumeClaimSpec,omitempty"`
 	PersistentVolumeSpec      corev1.PersistentVolumeSpec      `json:"persistentVolumeSpec,omitempty"`
+	// Embedded indicates that the algorithm runs in the Katib controller without the suggestion Deployment.
+	Embedded bool `json:"embedded,omitempty"`
+	// SharedServiceEndpoint is the address of the long-lived suggestion service, which serves many Experiments.
+	// If it is set, the suggestion Deployment is not created for each Experiment.
+	SharedServiceEndpoint string `json:"sharedServiceEndpoint,omitempty"`
+	// GRPCTLS indicates that the suggestion service supports gRPC TLS.
+	// Katib uses TLS for the service only if it is set and gRPC TLS is configured.
+	GRPCTLS bool `json:"grpcTLS,omitempty"`
 }
 
 // MetricsCollectorConfig is the JSON metrics collector structure in Katib config.
@@ -54,6 +63,28 @@ type MetricsCollectorConfig struct {
 type EarlyStoppingConfig struct {
 	Image           string            `json:"image"`
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// GRPCTLS indicates that the early stopping service supports gRPC TLS.
+	// Katib uses TLS for the service only if it is set and gRPC TLS is configured.
+	GRPCTLS bool `json:"grpcTLS,omitempty"`
+}
+
+// GRPCTLSConfig is the JSON gRPC TLS structure in Katib config.
+// Secret must contain tls.crt, tls.key and ca.crt keys and exist in the Experiment namespace.
+type GRPCTLSConfig struct {
+	SecretName string `json:"secretName"`
+	MountPath  string `json:"mountPath,omitempty"`
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// embeddedAlgorithms contains the algorithms which can run in the Katib controller.
+var embeddedAlgorithms = map[string]bool{}
+
+// AddEmbeddedAlgorithms marks the algorithms as supported by the embedded mode.
+// It must be called before the config is read.
+func AddEmbeddedAlgorithms(algorithmNames ...string) {
+	for _, algorithmName := range algorithmNames {
+		embeddedAlgorithms[algorithmName] = true
+	}
 }
 
 // GetSuggestionConfigData gets the config data for the given suggestion algorithm name.
@@ -86,9 +117,14 @@ func GetSuggestionConfigData(algorithmName string, client client.Client) (Sugges
 		return SuggestionConfig{}, errors.New("Failed to find suggestion config for algorithm: " + algorithmName + " in ConfigMap: " + consts.KatibConfigMapName)
 	}
 
-	// Get image from config
+	// Embedded algorithm must have the in-process suggestion service.
+	if suggestionConfigData.Embedded && !embeddedAlgorithms[algorithmName] {
+		return SuggestionConfig{}, errors.New("Embedded mode is not supported for algorithm name: " + algorithmName)
+	}
+
+	// Get image from config. Embedded algorithm and shared suggestion service don't require the image.
 	image := suggestionConfigData.Image
-	if strings.TrimSpace(image) == "" {
+	if strings.TrimSpace(image) == "" && !suggestionConfigData.Embedded && suggestionConfigData.SharedServiceEndpoint == "" {
 		return SuggestionConfig{}, errors.New("Required value for image configuration of algorithm name: " + algorithmName)
 	}
 
@@ -274,6 +310,112 @@ func GetMetricsCollectorConfigData(cKind common.CollectorKind, client client.Cli
 	return metricsCollectorConfigData, nil
 }
 
+// GetGRPCTLSConfigData gets the gRPC TLS config data.
+// If TLS is not configured in Katib config, empty GRPCTLSConfig is returned.
+func GetGRPCTLSConfigData(client client.Client) (GRPCTLSConfig, error) {
+	configMap := &corev1.ConfigMap{}
+	err := client.Get(
+		context.TODO(),
+		apitypes.NamespacedName{Name: consts.KatibConfigMapName, Namespace: consts.DefaultKatibNamespace},
+		configMap)
+	if err != nil {
+		return GRPCTLSConfig{}, err
+	}
+
+	// gRPC TLS is optional
+	config, ok := configMap.Data[consts.LabelGRPCTLSTag]
+	if !ok {
+		return GRPCTLSConfig{}, nil
+	}
+
+	tlsConfigData := GRPCTLSConfig{}
+	if err := json.Unmarshal([]byte(config), &tlsConfigData); err != nil {
+		return GRPCTLSConfig{}, err
+	}
+	if strings.TrimSpace(tlsConfigData.SecretName) == "" {
+		return GRPCTLSConfig{}, errors.New("Required value for secretName of gRPC TLS configuration in ConfigMap: " + consts.KatibConfigMapName)
+	}
+
+	// Set default mount path
+	if tlsConfigData.MountPath == "" {
+		tlsConfigData.MountPath = consts.DefaultGRPCTLSMountPath
+	}
+
+	return tlsConfigData, nil
+}
+
+// Enabled returns true if gRPC TLS is configured.
+func (c GRPCTLSConfig) Enabled() bool {
+	return c.SecretName != ""
+}
+
+// EnabledFor returns true if the suggestion or early stopping service must serve gRPC with TLS.
+// TLS is used only for the services which opt in with grpcTLS, since not all services support it.
+func (c GRPCTLSConfig) EnabledFor(serviceGRPCTLS bool) bool {
+	return c.Enabled() && serviceGRPCTLS
+}
+
+// Volume returns the volume with the gRPC TLS Secret.
+func (c GRPCTLSConfig) Volume() corev1.Volume {
+	return corev1.Volume{
+		Name: consts.ContainerGRPCTLSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: c.SecretName,
+			},
+		},
+	}
+}
+
+// VolumeMount returns the read only volume mount for the gRPC TLS Secret.
+func (c GRPCTLSConfig) VolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      consts.ContainerGRPCTLSVolumeName,
+		MountPath: c.MountPath,
+		ReadOnly:  true,
+	}
+}
+
+// Env returns the env variables with the mounted gRPC TLS files.
+func (c GRPCTLSConfig) Env() []corev1.EnvVar {
+	envs := []corev1.EnvVar{
+		{
+			Name:  consts.GRPCTLSCertFileEnvName,
+			Value: c.CertFile(),
+		},
+		{
+			Name:  consts.GRPCTLSKeyFileEnvName,
+			Value: c.KeyFile(),
+		},
+		{
+			Name:  consts.GRPCTLSCAFileEnvName,
+			Value: c.CAFile(),
+		},
+	}
+	if c.ServerName != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  consts.GRPCTLSServerNameEnvName,
+			Value: c.ServerName,
+		})
+	}
+	return envs
+}
+
+// CertFile returns path to the mounted certificate.
+func (c GRPCTLSConfig) CertFile() string {
+	return filepath.Join(c.MountPath, consts.GRPCTLSCertFileName)
+}
+
+// KeyFile returns path to the mounted private key.
+func (c GRPCTLSConfig) KeyFile() string {
+	return filepath.Join(c.MountPath, consts.GRPCTLSKeyFileName)
+}
+
+// CAFile returns path to the mounted CA certificate.
+func (c GRPCTLSConfig) CAFile() string {
+	return filepath.Join(c.MountPath, consts.GRPCTLSCAFileName)
+}
+
 func setResourceRequirements(configResource corev1.ResourceRequirements) corev1.ResourceRequirements {
 
 	// If requests are empty create new map
@@ -36,15 +36,56 @@ import (
 
 	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
 	experimentutil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	util "github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	earlystopping "github.com/kubeflow/katib/pkg/earlystopping/v1beta1"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	prometheusmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/expression"
 )
 
 var log = logf.Log.WithName("experiment-validating-webhook")
 
+// distributionAlgorithms are the algorithms of the goptuna Suggestion service, which support
+// the log-uniform distribution of the parameters.
+var distributionAlgorithms = map[string]bool{
+	"tpe":    true,
+	"random": true,
+	"cmaes":  true,
+	"nsga2":  true,
+	"grid":   true,
+}
+
+// normalDistributionAlgorithms are the algorithms of the goptuna Suggestion service, which support
+// the normal distribution of the parameters. Grid search doesn't support it, since the values are sampled.
+var normalDistributionAlgorithms = map[string]bool{
+	"tpe":    true,
+	"random": true,
+	"cmaes":  true,
+	"nsga2":  true,
+}
+
+// conditionAlgorithms are the algorithms of the goptuna Suggestion service, which support
+// the conditional parameters.
+var conditionAlgorithms = map[string]bool{
+	"tpe":    true,
+	"random": true,
+	"nsga2":  true,
+}
+
+// sharedServiceAlgorithms are the algorithms of the goptuna Suggestion service, which support
+// the shared service for many Experiments.
+var sharedServiceAlgorithms = map[string]bool{
+	"tpe":    true,
+	"random": true,
+	"cmaes":  true,
+	"nsga2":  true,
+	"grid":   true,
+}
+
 type Validator interface {
 	ValidateExperiment(instance, oldInst *experimentsv1beta1.Experiment) error
 	InjectClient(c client.Client)
@@ -76,6 +117,20 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if instance.Spec.ParallelTrialCount != nil && *instance.Spec.ParallelTrialCount <= 0 {
 		return fmt.Errorf("spec.parallelTrialCount must be greater than 0")
 	}
+	if instance.Spec.MaxDuration != nil && instance.Spec.MaxDuration.Duration <= 0 {
+		return fmt.Errorf("spec.maxDuration must be greater than 0")
+	}
+	if instance.Spec.Suspended && instance.Spec.ResumePolicy != experimentsv1beta1.FromVolume {
+		return fmt.Errorf("spec.suspended can be set only for spec.resumePolicy = %v", experimentsv1beta1.FromVolume)
+	}
+	if policy := instance.Spec.StoppingPolicy; policy != nil {
+		if policy.Patience <= 0 {
+			return fmt.Errorf("spec.stoppingPolicy.patience must be greater than 0")
+		}
+		if policy.Tolerance < 0 {
+			return fmt.Errorf("spec.stoppingPolicy.tolerance should not be less than 0")
+		}
+	}
 	if oldInst != nil {
 		// We should validate restart only if appropriate fields are changed.
 		// Otherwise check below is triggered when experiment is deleted.
@@ -99,20 +154,37 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		oldInst.Spec.MaxFailedTrialCount = instance.Spec.MaxFailedTrialCount
 		oldInst.Spec.MaxTrialCount = instance.Spec.MaxTrialCount
 		oldInst.Spec.ParallelTrialCount = instance.Spec.ParallelTrialCount
+		oldInst.Spec.Suspended = instance.Spec.Suspended
+		oldInst.Spec.SuspendPolicy = instance.Spec.SuspendPolicy
+		// New Trials can only be appended to spec.enqueuedTrials.
+		if len(instance.Spec.EnqueuedTrials) >= len(oldInst.Spec.EnqueuedTrials) &&
+			equality.Semantic.DeepEqual(instance.Spec.EnqueuedTrials[:len(oldInst.Spec.EnqueuedTrials)], oldInst.Spec.EnqueuedTrials) {
+			oldInst.Spec.EnqueuedTrials = instance.Spec.EnqueuedTrials
+		}
 		if !equality.Semantic.DeepEqual(instance.Spec, oldInst.Spec) {
-			return fmt.Errorf("Only spec.parallelTrialCount, spec.maxTrialCount and spec.maxFailedTrialCount are editable")
+			return fmt.Errorf("Only spec.parallelTrialCount, spec.maxTrialCount, spec.maxFailedTrialCount, " +
+				"spec.suspended, spec.suspendPolicy and appending to spec.enqueuedTrials are editable")
 		}
-		return nil
+		if err := g.validateSuspendPolicy(instance.Spec.SuspendPolicy); err != nil {
+			return err
+		}
+		return g.validateEnqueuedTrials(instance)
 	}
 	if err := g.validateObjective(instance.Spec.Objective); err != nil {
 		return err
 	}
-	if err := g.validateAlgorithm(instance.Spec.Algorithm); err != nil {
+	if err := g.validateAlgorithm(instance); err != nil {
+		return err
+	}
+	if err := g.validateEarlyStopping(instance.Spec.EarlyStopping); err != nil {
 		return err
 	}
 	if err := g.validateResumePolicy(instance.Spec.ResumePolicy); err != nil {
 		return err
 	}
+	if err := g.validateSuspendPolicy(instance.Spec.SuspendPolicy); err != nil {
+		return err
+	}
 
 	if err := g.validateTrialTemplate(instance); err != nil {
 		return err
@@ -127,11 +199,23 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	}
 
 	if len(instance.Spec.Parameters) > 0 {
-		if err := g.validateParameters(instance.Spec.Parameters); err != nil {
+		if err := g.validateParameters(instance.Spec.Parameters, instance.Spec.Algorithm.AlgorithmName); err != nil {
 			return err
 		}
 	}
 
+	if err := g.validateExpressions(instance); err != nil {
+		return err
+	}
+
+	if err := g.validateWarmStart(instance); err != nil {
+		return err
+	}
+
+	if err := g.validateEnqueuedTrials(instance); err != nil {
+		return err
+	}
+
 	if err := g.validateMetricsCollector(instance); err != nil {
 		return err
 	}
@@ -148,10 +232,32 @@ func (g *DefaultValidator) validateObjective(obj *commonapiv1beta1.ObjectiveSpec
 	if obj.ObjectiveMetricName == "" {
 		return fmt.Errorf("No spec.objective.objectiveMetricName specified.")
 	}
+	if len(obj.Objectives) > 0 {
+		if obj.Goal != nil {
+			return fmt.Errorf("spec.objective.goal must be empty, when spec.objective.objectives is specified. Set goal for each objective.")
+		}
+		if obj.Objectives[0].ObjectiveMetricName != obj.ObjectiveMetricName || obj.Objectives[0].Type != obj.Type {
+			return fmt.Errorf("spec.objective.objectives[0] must have the same objectiveMetricName and type as spec.objective.")
+		}
+		metricNames := make(map[string]bool)
+		for i, objective := range obj.Objectives {
+			if objective.Type != commonapiv1beta1.ObjectiveTypeMinimize && objective.Type != commonapiv1beta1.ObjectiveTypeMaximize {
+				return fmt.Errorf("spec.objective.objectives[%v].type must be %s or %s.", i, commonapiv1beta1.ObjectiveTypeMinimize, commonapiv1beta1.ObjectiveTypeMaximize)
+			}
+			if objective.ObjectiveMetricName == "" {
+				return fmt.Errorf("No spec.objective.objectives[%v].objectiveMetricName specified.", i)
+			}
+			if metricNames[objective.ObjectiveMetricName] {
+				return fmt.Errorf("spec.objective.objectives[%v].objectiveMetricName %v is not unique.", i, objective.ObjectiveMetricName)
+			}
+			metricNames[objective.ObjectiveMetricName] = true
+		}
+	}
 	return nil
 }
 
-func (g *DefaultValidator) validateAlgorithm(ag *commonapiv1beta1.AlgorithmSpec) error {
+func (g *DefaultValidator) validateAlgorithm(instance *experimentsv1beta1.Experiment) error {
+	ag := instance.Spec.Algorithm
 	if ag == nil {
 		return fmt.Errorf("No spec.algorithm specified.")
 	}
@@ -159,10 +265,62 @@ func (g *DefaultValidator) validateAlgorithm(ag *commonapiv1beta1.AlgorithmSpec)
 		return fmt.Errorf("No spec.algorithm.name specified.")
 	}
 
-	if _, err := g.GetSuggestionConfigData(ag.AlgorithmName); err != nil {
+	suggestionConfigData, err := g.GetSuggestionConfigData(ag.AlgorithmName)
+	if err != nil {
 		return fmt.Errorf("Don't support algorithm %s: %v.", ag.AlgorithmName, err)
 	}
 
+	// Early stopping service runs in the Suggestion Deployment, which is not created for the embedded algorithm
+	// and the shared suggestion service.
+	isEarlyStopping := instance.Spec.EarlyStopping != nil && instance.Spec.EarlyStopping.AlgorithmName != ""
+	// Suspended experiment is resumed from the volume, which is not created for the embedded algorithm
+	// and the shared suggestion service.
+	isFromVolume := instance.Spec.ResumePolicy == experimentsv1beta1.FromVolume || instance.Spec.Suspended
+
+	if suggestionConfigData.Embedded {
+		if isEarlyStopping {
+			return fmt.Errorf("spec.earlyStopping can't be used with the embedded algorithm: %v", ag.AlgorithmName)
+		}
+		if isFromVolume {
+			return fmt.Errorf("spec.resumePolicy = %v and spec.suspended can't be used with the embedded algorithm: %v",
+				experimentsv1beta1.FromVolume, ag.AlgorithmName)
+		}
+	}
+
+	if suggestionConfigData.SharedServiceEndpoint != "" {
+		if !sharedServiceAlgorithms[ag.AlgorithmName] {
+			return fmt.Errorf("shared suggestion service is not supported for algorithm: %v", ag.AlgorithmName)
+		}
+		if isEarlyStopping {
+			return fmt.Errorf("spec.earlyStopping can't be used with the shared suggestion service of algorithm: %v", ag.AlgorithmName)
+		}
+		if isFromVolume {
+			return fmt.Errorf("spec.resumePolicy = %v and spec.suspended can't be used with the shared suggestion service of algorithm: %v",
+				experimentsv1beta1.FromVolume, ag.AlgorithmName)
+		}
+	}
+
+	return nil
+}
+
+func (g *DefaultValidator) validateEarlyStopping(es *commonapiv1beta1.EarlyStoppingSpec) error {
+	if es == nil {
+		return nil
+	}
+	settings := make([]*api_pb.EarlyStoppingSetting, 0, len(es.AlgorithmSettings))
+	for _, s := range es.AlgorithmSettings {
+		settings = append(settings, &api_pb.EarlyStoppingSetting{
+			Name:  s.Name,
+			Value: s.Value,
+		})
+	}
+	err := earlystopping.ValidateEarlyStoppingSettings(&api_pb.EarlyStoppingSpec{
+		AlgorithmName:     es.AlgorithmName,
+		AlgorithmSettings: settings,
+	})
+	if err != nil {
+		return fmt.Errorf("Invalid spec.earlyStopping.algorithmSettings for %s: %v", es.AlgorithmName, err)
+	}
 	return nil
 }
 
@@ -179,7 +337,62 @@ func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.Resume
 	return nil
 }
 
-func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec) error {
+func (g *DefaultValidator) validateSuspendPolicy(suspend experimentsv1beta1.SuspendPolicyType) error {
+	validTypes := map[experimentsv1beta1.SuspendPolicyType]string{
+		"":                              "",
+		experimentsv1beta1.DrainTrials:  "",
+		experimentsv1beta1.DeleteTrials: "",
+	}
+	if _, ok := validTypes[suspend]; !ok {
+		return fmt.Errorf("invalid SuspendPolicyType %s", suspend)
+	}
+	return nil
+}
+
+// validateWarmStart checks that the warm start experiments are specified for the hyperparameter search.
+func (g *DefaultValidator) validateWarmStart(instance *experimentsv1beta1.Experiment) error {
+	warmStart := instance.Spec.WarmStart
+	if warmStart == nil {
+		return nil
+	}
+	if len(instance.Spec.Parameters) == 0 {
+		return fmt.Errorf("spec.warmStart can be specified only with spec.parameters")
+	}
+	if len(warmStart.Experiments) == 0 {
+		return fmt.Errorf("spec.warmStart.experiments must be specified")
+	}
+	for i, ref := range warmStart.Experiments {
+		if ref.Name == "" {
+			return fmt.Errorf("spec.warmStart.experiments[%v].name must be specified", i)
+		}
+		// Trials of the other namespaces can't be read by the Experiment owner.
+		if ref.Namespace != "" && ref.Namespace != instance.Namespace {
+			return fmt.Errorf("spec.warmStart.experiments[%v].namespace must be the experiment namespace: %v", i, instance.Namespace)
+		}
+		if ref.Name == instance.Name {
+			return fmt.Errorf("spec.warmStart.experiments[%v] can't reference the experiment itself", i)
+		}
+	}
+	return nil
+}
+
+// validateEnqueuedTrials checks that the enqueued Trials are in the feasible space of the parameters.
+func (g *DefaultValidator) validateEnqueuedTrials(instance *experimentsv1beta1.Experiment) error {
+	if len(instance.Spec.EnqueuedTrials) == 0 {
+		return nil
+	}
+	if len(instance.Spec.Parameters) == 0 {
+		return fmt.Errorf("spec.enqueuedTrials can be specified only with spec.parameters")
+	}
+	for i, trial := range instance.Spec.EnqueuedTrials {
+		if err := util.ValidateParameterAssignments(instance.Spec.Parameters, trial.ParameterAssignments); err != nil {
+			return fmt.Errorf("spec.enqueuedTrials[%v] is invalid: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec, algorithmName string) error {
 	for i, param := range parameters {
 
 		if param.ParameterType != experimentsv1beta1.ParameterTypeInt &&
@@ -201,14 +414,157 @@ func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.Pa
 			if param.FeasibleSpace.Max == "" && param.FeasibleSpace.Min == "" {
 				return fmt.Errorf("feasibleSpace.max or feasibleSpace.min must be specified for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
 			}
+			if err := validateDistribution(param.ParameterType, param.FeasibleSpace); err != nil {
+				return fmt.Errorf("%v in spec.parameters[%v]: %v", err, i, param)
+			}
+			if param.FeasibleSpace.Distribution == experimentsv1beta1.DistributionNormal && !normalDistributionAlgorithms[algorithmName] ||
+				param.FeasibleSpace.Distribution == experimentsv1beta1.DistributionLogUniform && !distributionAlgorithms[algorithmName] {
+				return fmt.Errorf("feasibleSpace.distribution: %v is not supported for algorithm: %v in spec.parameters[%v]",
+					param.FeasibleSpace.Distribution, algorithmName, i)
+			}
 
 		} else if param.ParameterType == experimentsv1beta1.ParameterTypeCategorical || param.ParameterType == experimentsv1beta1.ParameterTypeDiscrete {
 			if param.FeasibleSpace.Max != "" || param.FeasibleSpace.Min != "" || param.FeasibleSpace.Step != "" {
 				return fmt.Errorf("feasibleSpace .max, .min and .step is not supported for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
 			}
+			if param.FeasibleSpace.Distribution != "" || param.FeasibleSpace.Mean != "" || param.FeasibleSpace.Std != "" {
+				return fmt.Errorf("feasibleSpace .distribution, .mean and .std is not supported for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
+			}
 		}
 	}
 
+	return validateParameterConditions(parameters, algorithmName)
+}
+
+// validateParameterConditions checks that conditional parameters depend on the existing
+// categorical or discrete parameters without dependency cycles.
+func validateParameterConditions(parameters []experimentsv1beta1.ParameterSpec, algorithmName string) error {
+	paramsMap := make(map[string]experimentsv1beta1.ParameterSpec, len(parameters))
+	for _, param := range parameters {
+		paramsMap[param.Name] = param
+	}
+
+	for i, param := range parameters {
+		condition := param.Condition
+		if condition == nil {
+			continue
+		}
+		if !conditionAlgorithms[algorithmName] {
+			return fmt.Errorf("condition is not supported for algorithm: %v in spec.parameters[%v]", algorithmName, i)
+		}
+		parent, ok := paramsMap[condition.Parameter]
+		if !ok {
+			return fmt.Errorf("condition.parameter: %v must be one of spec.parameters in spec.parameters[%v]", condition.Parameter, i)
+		}
+		if parent.ParameterType != experimentsv1beta1.ParameterTypeCategorical && parent.ParameterType != experimentsv1beta1.ParameterTypeDiscrete {
+			return fmt.Errorf("condition.parameter: %v must be categorical or discrete parameter in spec.parameters[%v]", condition.Parameter, i)
+		}
+		if len(condition.Values) == 0 {
+			return fmt.Errorf("condition.values must be specified in spec.parameters[%v]", i)
+		}
+		for _, value := range condition.Values {
+			if !contains(parent.FeasibleSpace.List, value) {
+				return fmt.Errorf("condition.values: %v is not in feasibleSpace.list of parameter %v in spec.parameters[%v]", value, condition.Parameter, i)
+			}
+		}
+
+		// Each parameter has at most one parent, so the cycle is found by following the parents.
+		visited := map[string]bool{param.Name: true}
+		for next := parent; next.Condition != nil; next = paramsMap[next.Condition.Parameter] {
+			if visited[next.Name] {
+				return fmt.Errorf("parameter %v has cyclic condition in spec.parameters[%v]", param.Name, i)
+			}
+			visited[next.Name] = true
+		}
+	}
+	return nil
+}
+
+// validateExpressions checks that the constraints and the derived Trial parameters can be parsed
+// and reference only parameters from spec.parameters.
+func (g *DefaultValidator) validateExpressions(instance *experimentsv1beta1.Experiment) error {
+	paramNames := make([]string, 0, len(instance.Spec.Parameters))
+	for _, param := range instance.Spec.Parameters {
+		paramNames = append(paramNames, param.Name)
+	}
+	validate := func(source, field string) error {
+		e, err := expression.Parse(source)
+		if err != nil {
+			return fmt.Errorf("%v is invalid: %v", field, err)
+		}
+		for _, name := range e.Parameters() {
+			if !contains(paramNames, name) {
+				return fmt.Errorf("parameter %v in %v must be one of spec.parameters", name, field)
+			}
+		}
+		return nil
+	}
+
+	for i, constraint := range instance.Spec.Constraints {
+		if constraint.Expression == "" {
+			return fmt.Errorf("spec.constraints[%v].expression must be specified", i)
+		}
+		if err := validate(constraint.Expression, fmt.Sprintf("spec.constraints[%v].expression", i)); err != nil {
+			return err
+		}
+	}
+	for i, param := range instance.Spec.TrialTemplate.TrialParameters {
+		if param.Expression == "" {
+			continue
+		}
+		if err := validate(param.Expression, fmt.Sprintf("spec.trialTemplate.trialParameters[%v].expression", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDistribution validates the distribution of the int or double parameter.
+func validateDistribution(parameterType experimentsv1beta1.ParameterType, fs experimentsv1beta1.FeasibleSpace) error {
+	switch fs.Distribution {
+	case "", experimentsv1beta1.DistributionUniform:
+	case experimentsv1beta1.DistributionLogUniform:
+		if fs.Max == "" || fs.Min == "" {
+			return fmt.Errorf("feasibleSpace.max and feasibleSpace.min must be specified for distribution: %v", fs.Distribution)
+		}
+		min, err := strconv.ParseFloat(fs.Min, 64)
+		if err != nil {
+			return fmt.Errorf("feasibleSpace.min must be a number: %v", err)
+		}
+		if min <= 0 {
+			return fmt.Errorf("feasibleSpace.min must be positive for distribution: %v", fs.Distribution)
+		}
+	case experimentsv1beta1.DistributionNormal:
+		if parameterType != experimentsv1beta1.ParameterTypeDouble {
+			return fmt.Errorf("feasibleSpace.distribution: %v is supported only for parameterType: %v", fs.Distribution, experimentsv1beta1.ParameterTypeDouble)
+		}
+		// Values of the normal parameter are sampled between min and max.
+		if fs.Max == "" || fs.Min == "" {
+			return fmt.Errorf("feasibleSpace.max and feasibleSpace.min must be specified for distribution: %v", fs.Distribution)
+		}
+		if fs.Mean == "" || fs.Std == "" {
+			return fmt.Errorf("feasibleSpace.mean and feasibleSpace.std must be specified for distribution: %v", fs.Distribution)
+		}
+		if _, err := strconv.ParseFloat(fs.Mean, 64); err != nil {
+			return fmt.Errorf("feasibleSpace.mean must be a number: %v", err)
+		}
+		std, err := strconv.ParseFloat(fs.Std, 64)
+		if err != nil {
+			return fmt.Errorf("feasibleSpace.std must be a number: %v", err)
+		}
+		if std <= 0 {
+			return fmt.Errorf("feasibleSpace.std must be positive")
+		}
+		if fs.Step != "" {
+			return fmt.Errorf("feasibleSpace.step is not supported for distribution: %v", fs.Distribution)
+		}
+	default:
+		return fmt.Errorf("feasibleSpace.distribution: %v is not supported", fs.Distribution)
+	}
+
+	if fs.Distribution != experimentsv1beta1.DistributionNormal && (fs.Mean != "" || fs.Std != "") {
+		return fmt.Errorf("feasibleSpace .mean and .std is supported only for distribution: %v", experimentsv1beta1.DistributionNormal)
+	}
 	return nil
 }
 
@@ -230,6 +586,16 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 		return fmt.Errorf("spec.trialTemplate.successCondition and spec.trialTemplate.failureCondition must be specified")
 	}
 
+	// Check if RetryPolicy is valid
+	if trialTemplate.RetryPolicy != nil && trialTemplate.RetryPolicy.MaxRetries <= 0 {
+		return fmt.Errorf("spec.trialTemplate.retryPolicy.maxRetries must be greater than 0")
+	}
+
+	// Check if MaxTrialDuration is valid
+	if trialTemplate.MaxTrialDuration != nil && trialTemplate.MaxTrialDuration.Duration <= 0 {
+		return fmt.Errorf("spec.trialTemplate.maxTrialDuration must be greater than 0")
+	}
+
 	// Check if trialParameters exists
 	if trialTemplate.TrialParameters == nil {
 		return fmt.Errorf("spec.trialTemplate.trialParameters must be specified")
@@ -263,8 +629,8 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 	trialParametersRefs := make(map[string]bool)
 
 	for _, parameter := range trialTemplate.TrialParameters {
-		// Check if all trialParameters contain name and reference. Or name contains invalid character
-		if parameter.Name == "" || parameter.Reference == "" ||
+		// Check if all trialParameters contain name and one of reference or expression. Or name contains invalid character
+		if parameter.Name == "" || (parameter.Reference == "") == (parameter.Expression == "") ||
 			strings.Index(parameter.Name, "{") != -1 || strings.Index(parameter.Name, "}") != -1 {
 			return fmt.Errorf("Invalid spec.trialTemplate.trialParameters: %v", parameter)
 		}
@@ -278,7 +644,9 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 			return fmt.Errorf("Parameter reference %v can't be duplicated in spec.trialTemplate.trialParameters: %v", parameter.Reference, trialTemplate.TrialParameters)
 		}
 		trialParametersNames[parameter.Name] = true
-		trialParametersRefs[parameter.Reference] = true
+		if parameter.Reference != "" {
+			trialParametersRefs[parameter.Reference] = true
+		}
 
 		// Check if trialParameters contains all substitution for Trial template
 		if strings.Index(trialTemplateStr, fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, parameter.Name)) == -1 {
@@ -413,6 +781,14 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.FileKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
 			return fmt.Errorf("File path where metrics file exists is required by .spec.metricsCollectorSpec.source.fileSystemPath.path")
 		}
+		fileFormat := mcSpec.Source.FileSystemPath.Format
+		if fileFormat != "" && fileFormat != commonapiv1beta1.TextFormat && fileFormat != commonapiv1beta1.JsonFormat {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath.format must be %v or %v for metrics collector kind: %v.",
+				commonapiv1beta1.TextFormat, commonapiv1beta1.JsonFormat, mcKind)
+		}
+		if fileFormat == commonapiv1beta1.JsonFormat && mcSpec.Source.Filter != nil && len(mcSpec.Source.Filter.MetricsFormat) > 0 {
+			return fmt.Errorf(".spec.metricsCollectorSpec.source.filter must be empty for %v file format.", fileFormat)
+		}
 	case commonapiv1beta1.TfEventCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
 			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.DirectoryKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
@@ -426,6 +802,10 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 		if !strings.HasPrefix(mcSpec.Source.HttpGet.Path, "/") {
 			return fmt.Errorf(".spec.metricsCollectorSpec.source.httpGet.path is invalid for metrics collector kind: %v.", mcKind)
 		}
+		metricNames := append([]string{inst.Spec.Objective.ObjectiveMetricName}, inst.Spec.Objective.AdditionalMetricNames...)
+		if _, err := prometheusmc.ParseMetricSelectors(metricNames); err != nil {
+			return fmt.Errorf("Invalid metric names for metrics collector kind: %v: %v", mcKind, err)
+		}
 	case commonapiv1beta1.CustomCollector:
 		if mcSpec.Collector.CustomCollector == nil {
 			return fmt.Errorf(".spec.metricsCollectorSpec.collector.customCollector is required for metrics collector kind: %v.", mcKind)
@@ -439,6 +819,10 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 	default:
 		return fmt.Errorf("Invalid metrics collector kind: %v.", mcKind)
 	}
+	if mcKind != commonapiv1beta1.FileCollector && mcSpec.Source != nil && mcSpec.Source.FileSystemPath != nil &&
+		mcSpec.Source.FileSystemPath.Format != "" {
+		return fmt.Errorf(".spec.metricsCollectorSpec.source.fileSystemPath.format is not supported for metrics collector kind: %v.", mcKind)
+	}
 	if mcSpec.Source != nil && mcSpec.Source.Filter != nil && len(mcSpec.Source.Filter.MetricsFormat) > 0 {
 		// the filter regular expression must have two top subexpressions, the first matched one will be taken as metric name, the second one as metric value
 		mustTwoBracket, _ := regexp.Compile(`.*\(.*\).*\(.*\).*`)
@@ -455,3 +839,12 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 
 	return nil
 }
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,7 @@ package validator
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	batchv1 "k8s.io/api/batch/v1"
@@ -96,6 +97,58 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "Objective metric name is empty",
 		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.Objectives = newFakeObjectives()
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid multi-objective Experiment",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Objectives = newFakeObjectives()
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Objective goal is set for multi-objective Experiment",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.Objectives = newFakeObjectives()
+				i.Spec.Objective.Objectives[0].Type = commonv1beta1.ObjectiveTypeMinimize
+				return i
+			}(),
+			Err:             true,
+			testDescription: "First objective is different from spec.objective",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.Objectives = newFakeObjectives()
+				i.Spec.Objective.Objectives[1].Type = commonv1beta1.ObjectiveTypeUnknown
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Objective type of second objective is unknown",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.Objectives = newFakeObjectives()
+				i.Spec.Objective.Objectives[1].ObjectiveMetricName = "testme"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Objective metric names are not unique",
+		},
 		//Algorithm
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -148,6 +201,33 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "Parallel trial count is negative",
 		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MaxDuration = &metav1.Duration{Duration: -time.Hour}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Max duration is negative",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.StoppingPolicy = &experimentsv1beta1.StoppingPolicy{Patience: 0}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Stopping policy patience is zero",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.StoppingPolicy = &experimentsv1beta1.StoppingPolicy{Patience: 5, Tolerance: -0.1}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Stopping policy tolerance is negative",
+		},
 		// Validate Resume Experiment
 		{
 			Instance:        newFakeInstance(),
@@ -199,6 +279,86 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid resume policy",
 		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.SuspendPolicy = "invalid-policy"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid suspend policy",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				i.Spec.Suspended = true
+				i.Spec.SuspendPolicy = experimentsv1beta1.DeleteTrials
+				return i
+			}(),
+			Err: false,
+			oldInstance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				return i
+			}(),
+			testDescription: "Suspend running experiment",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ResumePolicy = experimentsv1beta1.LongRunning
+				i.Spec.Suspended = true
+				return i
+			}(),
+			Err:             true,
+			oldInstance:     newFakeInstance(),
+			testDescription: "Suspend experiment without suggestion volume",
+		},
+		// Validate early stopping
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+					AlgorithmName: "asha",
+					AlgorithmSettings: []commonv1beta1.EarlyStoppingSetting{
+						{Name: "reduction_factor", Value: "3"},
+						{Name: "max_rungs", Value: "3"},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid early stopping settings",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+					AlgorithmName: "asha",
+					AlgorithmSettings: []commonv1beta1.EarlyStoppingSetting{
+						{Name: "reduction_factor", Value: "1"},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid early stopping settings",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+					AlgorithmName: "custom-early-stopping",
+					AlgorithmSettings: []commonv1beta1.EarlyStoppingSetting{
+						{Name: "custom-setting", Value: "value"},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Settings of custom early stopping are validated by the service",
+		},
 		// Validate NAS Config
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -243,6 +403,161 @@ func TestValidateExperiment(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid feasible space in parameters",
 		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters[0].Name = "num-layers"
+				i.Spec.Parameters[1].Name = "lr"
+				i.Spec.Constraints = []experimentsv1beta1.ParameterConstraint{
+					{
+						Name:       "small-model",
+						Expression: "${num-layers} * lr <= 10",
+					},
+				}
+				i.Spec.TrialTemplate.TrialParameters[1].Reference = ""
+				i.Spec.TrialTemplate.TrialParameters[1].Expression = "${num-layers} + 1"
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid constraints and derived Trial parameter",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters[0].Name = "num-layers"
+				i.Spec.Constraints = []experimentsv1beta1.ParameterConstraint{
+					{
+						Expression: "${num-layers} <=",
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid constraint expression",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters[0].Name = "num-layers"
+				i.Spec.Constraints = []experimentsv1beta1.ParameterConstraint{
+					{
+						Expression: "${num-layers} * batch_size <= 512",
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Constraint with unknown parameter",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters[0].Name = "num-layers"
+				i.Spec.TrialTemplate.TrialParameters[1].Reference = ""
+				i.Spec.TrialTemplate.TrialParameters[1].Expression = "round(0.1 * total_steps)"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Derived Trial parameter with unknown parameter",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					Experiments: []experimentsv1beta1.ExperimentReference{
+						{Name: "previous-experiment"},
+						{Name: "other-experiment", Namespace: i.Namespace},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid warm start",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					Experiments: []experimentsv1beta1.ExperimentReference{
+						{Name: "previous-experiment", Namespace: "other-namespace"},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Warm start from the experiment in other namespace",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					Experiments: []experimentsv1beta1.ExperimentReference{
+						{Namespace: i.Namespace},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Warm start experiment without name",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					Experiments: []experimentsv1beta1.ExperimentReference{
+						{Name: i.Name},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Warm start from the experiment itself",
+		},
+		{
+			Instance:        newFakeInstanceWithEnqueuedTrials("3", "2"),
+			Err:             false,
+			testDescription: "Valid enqueued trials",
+		},
+		{
+			Instance:        newFakeInstanceWithEnqueuedTrials("3", "5"),
+			Err:             true,
+			testDescription: "Enqueued trial with value out of the feasible space",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstanceWithEnqueuedTrials("3", "2")
+				i.Spec.EnqueuedTrials[0].ParameterAssignments = i.Spec.EnqueuedTrials[0].ParameterAssignments[:1]
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Enqueued trial without assignment of the parameter",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstanceWithEnqueuedTrials("3", "2")
+				i.Spec.EnqueuedTrials = append(i.Spec.EnqueuedTrials, newFakeInstanceWithEnqueuedTrials("1", "3").Spec.EnqueuedTrials...)
+				return i
+			}(),
+			Err:             false,
+			oldInstance:     newFakeInstanceWithEnqueuedTrials("3", "2"),
+			testDescription: "Append enqueued trial to running experiment",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstanceWithEnqueuedTrials("3", "2")
+				i.Spec.EnqueuedTrials = append(i.Spec.EnqueuedTrials, newFakeInstanceWithEnqueuedTrials("7", "3").Spec.EnqueuedTrials...)
+				return i
+			}(),
+			Err:             true,
+			oldInstance:     newFakeInstanceWithEnqueuedTrials("3", "2"),
+			testDescription: "Append invalid enqueued trial to running experiment",
+		},
+		{
+			Instance:        newFakeInstanceWithEnqueuedTrials("1", "2"),
+			Err:             true,
+			oldInstance:     newFakeInstanceWithEnqueuedTrials("3", "2"),
+			testDescription: "Change enqueued trial of running experiment",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -264,6 +579,7 @@ func TestValidateParameters(t *testing.T) {
 
 	tcs := []struct {
 		parameters      []experimentsv1beta1.ParameterSpec
+		algorithmName   string
 		err             bool
 		testDescription string
 	}{
@@ -316,10 +632,280 @@ func TestValidateParameters(t *testing.T) {
 			err:             true,
 			testDescription: "Not empty max for categorical parameter type",
 		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				ps[0].FeasibleSpace.Step = "2"
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             false,
+			testDescription: "Quantized log-uniform int parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			algorithmName:   "hyperband",
+			err:             true,
+			testDescription: "Log-uniform distribution for algorithm without distribution support",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				ps[0].FeasibleSpace.Min = "0"
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Not positive min for log-uniform distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = "invalid-distribution"
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Invalid distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             false,
+			testDescription: "Normal double parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				ps[0].FeasibleSpace.Max = ""
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Normal double parameter without max",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				ps[0].FeasibleSpace.Std = "-1"
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Not positive std for normal distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				ps[0].FeasibleSpace.Step = "0.1"
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Step for normal distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeInt
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Normal int parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				return ps
+			}(),
+			algorithmName:   "grid",
+			err:             true,
+			testDescription: "Normal distribution for grid algorithm",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace = experimentsv1beta1.FeasibleSpace{
+					Min:          "0",
+					Max:          "1",
+					Distribution: experimentsv1beta1.DistributionNormal,
+					Mean:         "0.5",
+					Std:          "0.1",
+				}
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionUniform
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Mean and std for uniform distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[1].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Distribution for categorical parameter type",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "optimizer"
+				ps[0].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: ps[1].Name,
+					Values:    []string{"1", "3"},
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             false,
+			testDescription: "Conditional int parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "optimizer"
+				ps[0].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: ps[1].Name,
+					Values:    []string{"1", "3"},
+				}
+				return ps
+			}(),
+			algorithmName:   "cmaes",
+			err:             true,
+			testDescription: "Conditional parameter for algorithm without condition support",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "optimizer"
+				ps[0].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: "invalid-parameter",
+					Values:    []string{"1"},
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Condition with unknown parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "optimizer"
+				ps[1].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: ps[0].Name,
+					Values:    []string{"1"},
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Condition with int parent parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "optimizer"
+				ps[0].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: ps[1].Name,
+					Values:    []string{"4"},
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Condition value is not in parent feasible space",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Name, ps[1].Name = "num-layers", "batch-size"
+				ps = append(ps, experimentsv1beta1.ParameterSpec{
+					Name:          "optimizer",
+					ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+					FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+						List: []string{"sgd", "adam"},
+					},
+					Condition: &experimentsv1beta1.ParameterCondition{
+						Parameter: ps[1].Name,
+						Values:    []string{"1"},
+					},
+				})
+				ps[1].Condition = &experimentsv1beta1.ParameterCondition{
+					Parameter: "optimizer",
+					Values:    []string{"sgd"},
+				}
+				return ps
+			}(),
+			algorithmName:   "tpe",
+			err:             true,
+			testDescription: "Cyclic parameter conditions",
+		},
 	}
 
 	for _, tc := range tcs {
-		err := g.(*DefaultValidator).validateParameters(tc.parameters)
+		err := g.(*DefaultValidator).validateParameters(tc.parameters, tc.algorithmName)
 		if !tc.err && err != nil {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
 		} else if tc.err && err == nil {
@@ -382,6 +968,7 @@ spec:
 	validTemplate2 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 	validTemplate3 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 	validTemplate4 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
+	validTemplate5 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 
 	missedParameterTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(missedParameterJobStr, nil)
 	oddParameterTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(oddParameterJobStr, nil)
@@ -396,6 +983,7 @@ spec:
 		validTemplate2,
 		validTemplate3,
 		validTemplate4,
+		validTemplate5,
 		missedParameterTemplate,
 		oddParameterTemplate,
 		invalidParameterTemplate,
@@ -514,6 +1102,16 @@ spec:
 			Err:             true,
 			testDescription: "Duplicate reference in Trial parameters",
 		},
+		// Both Reference and Expression in trialParameters
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.TrialParameters[1].Expression = "lr * 2"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Both reference and expression in Trial parameters",
+		},
 		// Trial Template doesn't contain parameter from trialParameters
 		// missedParameterTemplate case
 		{
@@ -597,6 +1195,28 @@ spec:
 			Err:             true,
 			testDescription: "Trial template doesn't have SuccessCondition",
 		},
+		// Trial Template has invalid RetryPolicy
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.RetryPolicy = &commonv1beta1.RetryPolicy{
+					Reasons: []string{"Evicted"},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Trial template has RetryPolicy without MaxRetries",
+		},
+		// Trial Template has invalid MaxTrialDuration
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.MaxTrialDuration = &metav1.Duration{}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Trial template has zero MaxTrialDuration",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -828,6 +1448,93 @@ func TestValidateMetricsCollector(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid path for File metrics collector",
 		},
+		// FileCollector JSON format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid JSON format for File metrics collector",
+		},
+		// FileCollector invalid format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: "YAML",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid format for File metrics collector",
+		},
+		// FileCollector JSON format with filter
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsFormat: []string{"([\\w|-]+)\\s*=\\s*([+-]?\\d*(\\.\\d+)?([Ee][+-]?\\d+)?)"},
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Metrics format filter for JSON format",
+		},
+		// TfEventCollector with format
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.TfEventCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.DirectoryKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "JSON format for TF event metrics collector",
+		},
 		// TfEventCollector invalid Path
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -890,6 +1597,52 @@ func TestValidateMetricsCollector(t *testing.T) {
 			Err:             true,
 			testDescription: "Invalid path for Prometheus metrics collector",
 		},
+		// PrometheusMetricCollector invalid metric name
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalMetricNames = []string{`accuracy{phase="validation"}`, "train-loss"}
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.IntOrString{
+								IntVal: 8888,
+							},
+							Path: "/metrics",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Invalid metric name for Prometheus metrics collector",
+		},
+		// PrometheusMetricCollector valid metric names with label selectors
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalMetricNames = []string{`accuracy{phase="validation"}`}
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.IntOrString{
+								IntVal: 8888,
+							},
+							Path: "/metrics",
+						},
+					},
+				}
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Valid metric names for Prometheus metrics collector",
+		},
 		//  CustomCollector empty CustomCollector
 		{
 			Instance: func() *experimentsv1beta1.Experiment {
@@ -1054,6 +1807,168 @@ func TestValidateConfigData(t *testing.T) {
 	}
 }
 
+func TestValidateSharedSuggestionService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	suggestionConfigData := katibconfig.SuggestionConfig{}
+	suggestionConfigData.SharedServiceEndpoint = "katib-suggestion-goptuna.kubeflow:6789"
+
+	p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil).AnyTimes()
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Shared service for cmaes algorithm",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "hyperband"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared service is not supported for hyperband algorithm",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared service with resume policy FromVolume",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+					AlgorithmName: "medianstop",
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared service with early stopping",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.Suspended = true
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared service with suspended experiment",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateAlgorithm(tc.Instance)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestValidateEmbeddedAlgorithm(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	suggestionConfigData := katibconfig.SuggestionConfig{}
+	suggestionConfigData.Embedded = true
+
+	p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil).AnyTimes()
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Embedded cmaes algorithm",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+					AlgorithmName: "medianstop",
+				}
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Embedded algorithm with early stopping",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Embedded algorithm with resume policy FromVolume",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "cmaes"
+				i.Spec.Suspended = true
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Embedded algorithm with suspended experiment",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateAlgorithm(tc.Instance)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func newFakeObjectives() []commonv1beta1.Objective {
+	return []commonv1beta1.Objective{
+		{
+			Type:                commonv1beta1.ObjectiveTypeMaximize,
+			ObjectiveMetricName: "testme",
+		},
+		{
+			Type:                commonv1beta1.ObjectiveTypeMinimize,
+			ObjectiveMetricName: "latency",
+		},
+	}
+}
+
 func newFakeInstance() *experimentsv1beta1.Experiment {
 	goal := 0.11
 	var maxTrialCount int32 = 6
@@ -1147,6 +2062,21 @@ func newFakeTrialParamters() []experimentsv1beta1.TrialParameterSpec {
 	}
 }
 
+func newFakeInstanceWithEnqueuedTrials(lr, numLayers string) *experimentsv1beta1.Experiment {
+	i := newFakeInstance()
+	i.Spec.Parameters[0].Name = "lr"
+	i.Spec.Parameters[1].Name = "num-layers"
+	i.Spec.EnqueuedTrials = []experimentsv1beta1.EnqueuedTrial{
+		{
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{
+				{Name: "lr", Value: lr},
+				{Name: "num-layers", Value: numLayers},
+			},
+		},
+	}
+	return i
+}
+
 func newFakeTrialTemplate(trialJob interface{}, trialParameters []experimentsv1beta1.TrialParameterSpec) *experimentsv1beta1.TrialTemplate {
 
 	trialSpec, err := util.ConvertObjectToUnstructured(trialJob)
@@ -34,5 +34,6 @@ var (
 		common.StdOutCollector,
 		common.TfEventCollector,
 		common.FileCollector,
+		common.PrometheusMetricCollector,
 	}
 )
@@ -154,10 +154,32 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 		return nil, err
 	}
 
-	injectContainer, err := s.getMetricsCollectorContainer(trial, pod)
+	// Custom metrics collector doesn't support early stopping.
+	earlyStoppingEndpoint, earlyStoppingTLS := "", false
+	if trial.Spec.MetricsCollector.Collector.Kind != common.CustomCollector {
+		earlyStoppingEndpoint, earlyStoppingTLS, err = s.getEarlyStoppingEndpoint(trial)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	injectContainer, err := s.getMetricsCollectorContainer(trial, pod, earlyStoppingEndpoint, earlyStoppingTLS)
 	if err != nil {
 		return nil, err
 	}
+
+	// Metrics collector reports metrics to the Katib DB Manager with the gRPC TLS Secret if TLS is configured.
+	if trial.Spec.MetricsCollector.Collector.Kind != common.CustomCollector {
+		tlsConfigData, err := katibconfig.GetGRPCTLSConfigData(s.client)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfigData.Enabled() {
+			injectContainer.VolumeMounts = append(injectContainer.VolumeMounts, tlsConfigData.VolumeMount())
+			injectContainer.Env = append(injectContainer.Env, tlsConfigData.Env()...)
+			mutatedPod.Spec.Volumes = append(mutatedPod.Spec.Volumes, tlsConfigData.Volume())
+		}
+	}
 	mutatedPod.Spec.Containers = append(mutatedPod.Spec.Containers, *injectContainer)
 
 	mutatedPod.Spec.ShareProcessNamespace = pointer.BoolPtr(true)
@@ -169,7 +191,7 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 		}
 	}
 	if needWrapWorkerContainer(trial.Spec.MetricsCollector) {
-		if err = wrapWorkerContainer(trial, mutatedPod, namespace, mountPath, pathKind); err != nil {
+		if err = wrapWorkerContainer(trial, mutatedPod, namespace, mountPath, pathKind, earlyStoppingEndpoint != ""); err != nil {
 			return nil, err
 		}
 	}
@@ -183,7 +205,7 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 	return mutatedPod, nil
 }
 
-func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Trial, originalPod *v1.Pod) (*v1.Container, error) {
+func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Trial, originalPod *v1.Pod, earlyStoppingEndpoint string, earlyStoppingTLS bool) (*v1.Container, error) {
 	mc := trial.Spec.MetricsCollector
 	if mc.Collector.Kind == common.CustomCollector {
 		return mc.Collector.CustomCollector, nil
@@ -203,7 +225,7 @@ func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 	}
 	metricsCollectorConfigData, err := katibconfig.GetMetricsCollectorConfigData(mc.Collector.Kind, s.client)
 
-	args, err := s.getMetricsCollectorArgs(trial, metricNames, mc, metricsCollectorConfigData, earlyStoppingRules)
+	args, err := s.getMetricsCollectorArgs(trial, metricNames, mc, metricsCollectorConfigData, earlyStoppingRules, earlyStoppingEndpoint, earlyStoppingTLS)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +306,7 @@ func (s *SidecarInjector) getKatibJob(object *unstructured.Unstructured, namespa
 	return jobKind, jobName, nil
 }
 
-func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, metricNames string, mc common.MetricsCollectorSpec, metricsCollectorConfigData katibconfig.MetricsCollectorConfig, esRules []string) ([]string, error) {
+func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, metricNames string, mc common.MetricsCollectorSpec, metricsCollectorConfigData katibconfig.MetricsCollectorConfig, esRules []string, earlyStoppingEndpoint string, earlyStoppingTLS bool) ([]string, error) {
 	args := []string{"-t", trial.Name, "-m", metricNames, "-o-type", string(trial.Spec.Objective.Type), "-s-db", katibmanagerv1beta1.GetDBManagerAddr()}
 	if mountPath, _ := getMountPath(mc); mountPath != "" {
 		args = append(args, "-path", mountPath)
@@ -292,25 +314,53 @@ func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, me
 	if mc.Source != nil && mc.Source.Filter != nil && len(mc.Source.Filter.MetricsFormat) > 0 {
 		args = append(args, "-f", strings.Join(mc.Source.Filter.MetricsFormat, ";"))
 	}
+	if mc.Source != nil && mc.Source.FileSystemPath != nil && mc.Source.FileSystemPath.Format != "" {
+		args = append(args, "-format", string(mc.Source.FileSystemPath.Format))
+	}
+	if mc.Collector.Kind == common.PrometheusMetricCollector {
+		args = append(args, "-scrape-url", getPrometheusScrapeURL(mc))
+	}
 	if metricsCollectorConfigData.WaitAllProcesses != nil {
 		args = append(args, "-w", strconv.FormatBool(*metricsCollectorConfigData.WaitAllProcesses))
 	}
 	// Add stop rules and service endpoint for Early Stopping
-	if len(esRules) > 0 {
-		for _, rule := range esRules {
-			args = append(args, "-stop-rule", rule)
-		}
-		// Suggestion name == Experiment name
-		// Suggestion namespace == Trial namespace
-		// Get suggestion to set early stopping service endpoint
-		suggestionName := trial.ObjectMeta.Labels[consts.LabelExperimentName]
-		suggestion := &suggestionsv1beta1.Suggestion{}
-		err := s.client.Get(context.TODO(), apitypes.NamespacedName{Name: suggestionName, Namespace: trial.Namespace}, suggestion)
-		if err != nil {
-			return nil, err
-		}
-		args = append(args, "-s-earlystop", util.GetEarlyStoppingEndpoint(suggestion))
+	for _, rule := range esRules {
+		args = append(args, "-stop-rule", rule)
+	}
+	if earlyStoppingEndpoint != "" {
+		args = append(args, "-s-earlystop", earlyStoppingEndpoint)
+	}
+	if earlyStoppingTLS {
+		args = append(args, "-s-earlystop-tls")
 	}
 
 	return args, nil
 }
+
+// getEarlyStoppingEndpoint returns the endpoint of the Early Stopping service, if early stopping is set for the Trial Experiment.
+// Endpoint is set for all Trials of the Experiment, so the metrics collector can refresh the rules
+// of the Trials, which don't get the rules at the start.
+// It also returns true if the Early Stopping service serves gRPC with TLS.
+func (s *SidecarInjector) getEarlyStoppingEndpoint(trial *trialsv1beta1.Trial) (string, bool, error) {
+	// Suggestion name == Experiment name
+	// Suggestion namespace == Trial namespace
+	suggestionName := trial.ObjectMeta.Labels[consts.LabelExperimentName]
+	suggestion := &suggestionsv1beta1.Suggestion{}
+	err := s.client.Get(context.TODO(), apitypes.NamespacedName{Name: suggestionName, Namespace: trial.Namespace}, suggestion)
+	if err != nil {
+		return "", false, err
+	}
+	if suggestion.Spec.EarlyStopping == nil || suggestion.Spec.EarlyStopping.AlgorithmName == "" {
+		return "", false, nil
+	}
+
+	earlyStoppingConfigData, err := katibconfig.GetEarlyStoppingConfigData(suggestion.Spec.EarlyStopping.AlgorithmName, s.client)
+	if err != nil {
+		return "", false, err
+	}
+	tlsConfigData, err := katibconfig.GetGRPCTLSConfigData(s.client)
+	if err != nil {
+		return "", false, err
+	}
+	return util.GetEarlyStoppingEndpoint(suggestion), tlsConfigData.EnabledFor(earlyStoppingConfigData.GRPCTLS), nil
+}
@@ -34,7 +34,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -80,6 +82,7 @@ func TestWrapWorkerContainer(t *testing.T) {
 		Pod             *v1.Pod
 		MetricsFile     string
 		PathKind        common.FileSystemKind
+		IsEarlyStopping bool
 		ExpectedPod     *v1.Pod
 		Err             bool
 		TestDescription string
@@ -169,17 +172,7 @@ func TestWrapWorkerContainer(t *testing.T) {
 			TestDescription: "Training pod doesn't have primary container",
 		},
 		{
-			Trial: func() *trialsv1beta1.Trial {
-				t := trial.DeepCopy()
-				t.Spec.EarlyStoppingRules = []common.EarlyStoppingRule{
-					{
-						Name:       "accuracy",
-						Value:      "0.6",
-						Comparison: common.ComparisonTypeLess,
-					},
-				}
-				return t
-			}(),
+			Trial: trial,
 			Pod: &v1.Pod{
 				Spec: v1.PodSpec{
 					Containers: []v1.Container{
@@ -192,8 +185,9 @@ func TestWrapWorkerContainer(t *testing.T) {
 					},
 				},
 			},
-			MetricsFile: metricsFile,
-			PathKind:    common.FileKind,
+			MetricsFile:     metricsFile,
+			PathKind:        common.FileKind,
+			IsEarlyStopping: true,
 			ExpectedPod: &v1.Pod{
 				Spec: v1.PodSpec{
 					Containers: []v1.Container{
@@ -219,7 +213,7 @@ func TestWrapWorkerContainer(t *testing.T) {
 	}
 
 	for _, c := range testCases {
-		err := wrapWorkerContainer(c.Trial, c.Pod, c.Trial.Namespace, c.MetricsFile, c.PathKind)
+		err := wrapWorkerContainer(c.Trial, c.Pod, c.Trial.Namespace, c.MetricsFile, c.PathKind, c.IsEarlyStopping)
 		if c.Err && err == nil {
 			t.Errorf("Case %s failed. Expected error, got nil", c.TestDescription)
 		} else if !c.Err {
@@ -289,18 +283,6 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 		"loss;2;greater",
 	}
 
-	testSuggestion := &suggestionsv1beta1.Suggestion{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      testSuggestionName,
-			Namespace: testNamespace,
-		},
-		Spec: suggestionsv1beta1.SuggestionSpec{
-			Algorithm: &common.AlgorithmSpec{
-				AlgorithmName: testAlgorithm,
-			},
-		},
-	}
-
 	testTrial := &trialsv1beta1.Trial{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      testTrialName,
@@ -317,14 +299,16 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 	}
 
 	testCases := []struct {
-		Trial              *trialsv1beta1.Trial
-		MetricNames        string
-		MCSpec             common.MetricsCollectorSpec
-		EarlyStoppingRules []string
-		KatibConfig        katibconfig.MetricsCollectorConfig
-		ExpectedArgs       []string
-		Name               string
-		Err                bool
+		Trial                 *trialsv1beta1.Trial
+		MetricNames           string
+		MCSpec                common.MetricsCollectorSpec
+		EarlyStoppingRules    []string
+		EarlyStoppingEndpoint string
+		EarlyStoppingTLS      bool
+		KatibConfig           katibconfig.MetricsCollectorConfig
+		ExpectedArgs          []string
+		Name                  string
+		Err                   bool
 	}{
 		{
 			Trial:       testTrial,
@@ -377,6 +361,31 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			},
 			Name: "File MC with Filter",
 		},
+		{
+			Trial:       testTrial,
+			MetricNames: testMetricName,
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:   testPath,
+						Format: common.JsonFormat,
+					},
+				},
+			},
+			KatibConfig: katibconfig.MetricsCollectorConfig{},
+			ExpectedArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.JsonFormat),
+			},
+			Name: "File MC with JSON format",
+		},
 		{
 			Trial:       testTrial,
 			MetricNames: testMetricName,
@@ -454,8 +463,36 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-path", filepath.Dir(common.DefaultFilePath),
+				"-scrape-url", "http://localhost:8080/metrics",
+			},
+			Name: "Prometheus MC with default endpoint",
+		},
+		{
+			Trial:       testTrial,
+			MetricNames: testMetricName,
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+				Source: &common.SourceSpec{
+					HttpGet: &v1.HTTPGetAction{
+						Path:   "/custom-metrics",
+						Port:   intstr.FromInt(9090),
+						Scheme: v1.URISchemeHTTPS,
+					},
+				},
+			},
+			KatibConfig: katibconfig.MetricsCollectorConfig{},
+			ExpectedArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", filepath.Dir(common.DefaultFilePath),
+				"-scrape-url", "https://localhost:9090/custom-metrics",
 			},
-			Name: "Prometheus MC without Path",
+			Name: "Prometheus MC with HttpGet",
 		},
 		{
 			Trial:       testTrial,
@@ -465,8 +502,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 					Kind: common.StdOutCollector,
 				},
 			},
-			EarlyStoppingRules: earlyStoppingRules,
-			KatibConfig:        katibconfig.MetricsCollectorConfig{},
+			EarlyStoppingRules:    earlyStoppingRules,
+			EarlyStoppingEndpoint: katibEarlyStopAddress,
+			KatibConfig:           katibconfig.MetricsCollectorConfig{},
 			ExpectedArgs: []string{
 				"-t", testTrialName,
 				"-m", testMetricName,
@@ -480,32 +518,51 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			Name: "Trial with EarlyStopping rules",
 		},
 		{
-			Trial: func() *trialsv1beta1.Trial {
-				trial := testTrial.DeepCopy()
-				trial.ObjectMeta.Labels[consts.LabelExperimentName] = "invalid-name"
-				return trial
-			}(),
+			Trial:       testTrial,
+			MetricNames: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
 					Kind: common.StdOutCollector,
 				},
 			},
-			EarlyStoppingRules: earlyStoppingRules,
-			KatibConfig:        katibconfig.MetricsCollectorConfig{},
-			Name:               "Trial with invalid Experiment label name. Suggestion is not created",
-			Err:                true,
+			EarlyStoppingEndpoint: katibEarlyStopAddress,
+			KatibConfig:           katibconfig.MetricsCollectorConfig{},
+			ExpectedArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", common.DefaultFilePath,
+				"-s-earlystop", katibEarlyStopAddress,
+			},
+			Name: "Trial without EarlyStopping rules. EarlyStopping service is set",
+		},
+		{
+			Trial:       testTrial,
+			MetricNames: testMetricName,
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.StdOutCollector,
+				},
+			},
+			EarlyStoppingEndpoint: katibEarlyStopAddress,
+			EarlyStoppingTLS:      true,
+			KatibConfig:           katibconfig.MetricsCollectorConfig{},
+			ExpectedArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", common.DefaultFilePath,
+				"-s-earlystop", katibEarlyStopAddress,
+				"-s-earlystop-tls",
+			},
+			Name: "EarlyStopping service with gRPC TLS",
 		},
 	}
 
-	g.Expect(c.Create(context.TODO(), testSuggestion)).NotTo(gomega.HaveOccurred())
-
-	// Wait that Suggestion is created
-	g.Eventually(func() error {
-		return c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testSuggestionName}, testSuggestion)
-	}, timeout).ShouldNot(gomega.HaveOccurred())
-
 	for _, tc := range testCases {
-		args, err := si.getMetricsCollectorArgs(tc.Trial, tc.MetricNames, tc.MCSpec, tc.KatibConfig, tc.EarlyStoppingRules)
+		args, err := si.getMetricsCollectorArgs(tc.Trial, tc.MetricNames, tc.MCSpec, tc.KatibConfig, tc.EarlyStoppingRules, tc.EarlyStoppingEndpoint, tc.EarlyStoppingTLS)
 
 		if !tc.Err && err != nil {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.Name, err)
@@ -517,6 +574,117 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 	}
 }
 
+func TestGetEarlyStoppingEndpoint(t *testing.T) {
+	testNamespace := "kubeflow"
+	testSuggestionName := "test-suggestion"
+	testAlgorithm := "random"
+	testEarlyStoppingAlgorithm := "medianstop"
+
+	testSuggestion := &suggestionsv1beta1.Suggestion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testSuggestionName,
+			Namespace: testNamespace,
+		},
+		Spec: suggestionsv1beta1.SuggestionSpec{
+			Algorithm: &common.AlgorithmSpec{
+				AlgorithmName: testAlgorithm,
+			},
+			EarlyStopping: &common.EarlyStoppingSpec{
+				AlgorithmName: testEarlyStoppingAlgorithm,
+			},
+		},
+	}
+
+	testTrial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-trial",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				consts.LabelExperimentName: testSuggestionName,
+			},
+		},
+	}
+
+	newKatibConfig := func(earlyStoppingGRPCTLS bool) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      consts.KatibConfigMapName,
+				Namespace: consts.DefaultKatibNamespace,
+			},
+			Data: map[string]string{
+				consts.LabelEarlyStoppingTag: fmt.Sprintf(`{%q: {"image": "medianstop-image", "grpcTLS": %v}}`,
+					testEarlyStoppingAlgorithm, earlyStoppingGRPCTLS),
+				consts.LabelGRPCTLSTag: `{"secretName": "katib-grpc-tls"}`,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Trial            *trialsv1beta1.Trial
+		Suggestion       *suggestionsv1beta1.Suggestion
+		KatibConfig      *v1.ConfigMap
+		ExpectedEndpoint string
+		ExpectedTLS      bool
+		Name             string
+		Err              bool
+	}{
+		{
+			Trial:            testTrial,
+			Suggestion:       testSuggestion,
+			KatibConfig:      newKatibConfig(false),
+			ExpectedEndpoint: util.GetEarlyStoppingEndpoint(testSuggestion),
+			Name:             "Suggestion with EarlyStopping",
+		},
+		{
+			Trial:            testTrial,
+			Suggestion:       testSuggestion,
+			KatibConfig:      newKatibConfig(true),
+			ExpectedEndpoint: util.GetEarlyStoppingEndpoint(testSuggestion),
+			ExpectedTLS:      true,
+			Name:             "Suggestion with EarlyStopping, which opts in gRPC TLS",
+		},
+		{
+			Trial: testTrial,
+			Suggestion: func() *suggestionsv1beta1.Suggestion {
+				suggestion := testSuggestion.DeepCopy()
+				suggestion.Spec.EarlyStopping = nil
+				return suggestion
+			}(),
+			KatibConfig:      newKatibConfig(true),
+			ExpectedEndpoint: "",
+			Name:             "Suggestion without EarlyStopping",
+		},
+		{
+			Trial: func() *trialsv1beta1.Trial {
+				trial := testTrial.DeepCopy()
+				trial.ObjectMeta.Labels[consts.LabelExperimentName] = "invalid-name"
+				return trial
+			}(),
+			Suggestion:  testSuggestion,
+			KatibConfig: newKatibConfig(true),
+			Name:        "Trial with invalid Experiment label name. Suggestion is not created",
+			Err:         true,
+		},
+	}
+
+	apis.AddToScheme(scheme.Scheme)
+	for _, tc := range testCases {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(tc.Suggestion, tc.KatibConfig).Build()
+		si := NewSidecarInjector(c)
+
+		endpoint, grpcTLS, err := si.getEarlyStoppingEndpoint(tc.Trial)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.Name, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.Name)
+		} else if !tc.Err && tc.ExpectedEndpoint != endpoint {
+			t.Errorf("Case %v failed. Expected endpoint: %v, got %v", tc.Name, tc.ExpectedEndpoint, endpoint)
+		} else if !tc.Err && tc.ExpectedTLS != grpcTLS {
+			t.Errorf("Case %v failed. Expected gRPC TLS: %v, got %v", tc.Name, tc.ExpectedTLS, grpcTLS)
+		}
+	}
+}
+
 func TestNeedWrapWorkerContainer(t *testing.T) {
 	testCases := []struct {
 		MCSpec   common.MetricsCollectorSpec
@@ -530,6 +698,14 @@ func TestNeedWrapWorkerContainer(t *testing.T) {
 			},
 			needWrap: true,
 		},
+		{
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+			},
+			needWrap: true,
+		},
 		{
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -548,6 +724,47 @@ func TestNeedWrapWorkerContainer(t *testing.T) {
 	}
 }
 
+func TestGetPrometheusScrapeURL(t *testing.T) {
+	testCases := []struct {
+		MCSpec      common.MetricsCollectorSpec
+		expectedURL string
+		testDesc    string
+	}{
+		{
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+			},
+			expectedURL: "http://localhost:8080/metrics",
+			testDesc:    "Default metrics endpoint",
+		},
+		{
+			MCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+				Source: &common.SourceSpec{
+					HttpGet: &v1.HTTPGetAction{
+						Host: "127.0.0.1",
+						Path: "/metrics",
+						Port: intstr.FromInt(8000),
+					},
+				},
+			},
+			expectedURL: "http://127.0.0.1:8000/metrics",
+			testDesc:    "Metrics endpoint with host and port",
+		},
+	}
+
+	for _, tc := range testCases {
+		url := getPrometheusScrapeURL(tc.MCSpec)
+		if url != tc.expectedURL {
+			t.Errorf("Case: %v. Expected URL %v, got %v", tc.testDesc, tc.expectedURL, url)
+		}
+	}
+}
+
 func TestMutateVolume(t *testing.T) {
 	tc := struct {
 		Pod                  v1.Pod
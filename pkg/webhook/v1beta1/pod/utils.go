@@ -19,7 +19,9 @@ package pod
 import (
 	"context"
 	"fmt"
+	"net"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -117,6 +119,9 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 		return mc.Source.FileSystemPath.Path, common.FileKind
 	} else if mc.Collector.Kind == common.TfEventCollector {
 		return mc.Source.FileSystemPath.Path, common.DirectoryKind
+	} else if mc.Collector.Kind == common.PrometheusMetricCollector {
+		// Directory is used to mark the completed training processes.
+		return filepath.Dir(common.DefaultFilePath), common.DirectoryKind
 	} else if mc.Collector.Kind == common.CustomCollector {
 		if mc.Source == nil || mc.Source.FileSystemPath == nil {
 			return "", common.InvalidKind
@@ -127,6 +132,29 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 	}
 }
 
+// getPrometheusScrapeURL returns URL of the training container metrics endpoint.
+// Sidecar shares the network namespace with the training container, so localhost is used by default.
+func getPrometheusScrapeURL(mc common.MetricsCollectorSpec) string {
+	scheme, host := "http", "localhost"
+	port, path := strconv.Itoa(common.DefaultPrometheusPort), common.DefaultPrometheusPath
+	if mc.Source != nil && mc.Source.HttpGet != nil {
+		httpGet := mc.Source.HttpGet
+		if httpGet.Scheme != "" {
+			scheme = strings.ToLower(string(httpGet.Scheme))
+		}
+		if httpGet.Host != "" {
+			host = httpGet.Host
+		}
+		if httpGet.Port.String() != "0" && httpGet.Port.String() != "" {
+			port = httpGet.Port.String()
+		}
+		if httpGet.Path != "" {
+			path = httpGet.Path
+		}
+	}
+	return fmt.Sprintf("%v://%v%v", scheme, net.JoinHostPort(host, port), path)
+}
+
 func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 	mcKind := mc.Collector.Kind
 	for _, kind := range NeedWrapWorkerMetricsCollecterList {
@@ -138,7 +166,7 @@ func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 }
 
 func wrapWorkerContainer(trial *trialsv1beta1.Trial, pod *v1.Pod, namespace,
-	metricsFile string, pathKind common.FileSystemKind) error {
+	metricsFile string, pathKind common.FileSystemKind, isEarlyStopping bool) error {
 	// Search for primary container.
 	index := -1
 	for i, c := range pod.Spec.Containers {
@@ -172,8 +200,9 @@ func wrapWorkerContainer(trial *trialsv1beta1.Trial, pod *v1.Pod, namespace,
 			metricsFileDir = filepath.Dir(metricsFile)
 		}
 
-		// If early stopping is set add appropriate command
-		if trial.Spec.EarlyStoppingRules != nil {
+		// If early stopping is set add appropriate command.
+		// Trial without the rules at the start can get them later from the Early Stopping service.
+		if isEarlyStopping {
 			args = append(args, "||", getEarlyStoppingCommand(metricsFileDir, pathKind))
 		}
 		// Add completed command to run without early stopping
@@ -59,13 +59,10 @@ echo -e "\nBuilding file metrics collector image...\n"
 docker build -t ${REGISTRY}/file-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/file-metricscollector/Dockerfile .
 
 echo -e "\nBuilding TF Event metrics collector image...\n"
-if [ $MACHINE_ARCH == "aarch64" ]; then
-    docker build -t ${REGISTRY}/tfevent-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile.aarch64 .
-elif [ $MACHINE_ARCH == "ppc64le" ]; then
-    docker build -t ${REGISTRY}/tfevent-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile.ppc64le .
-else
-    docker build -t ${REGISTRY}/tfevent-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile .
-fi
+docker build -t ${REGISTRY}/tfevent-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile .
+
+echo -e "\nBuilding Prometheus metrics collector image...\n"
+docker build -t ${REGISTRY}/prometheus-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/${VERSION}/prometheus-metricscollector/Dockerfile .
 
 # Suggestion images
 echo -e "\nBuilding suggestion images..."
@@ -101,6 +98,9 @@ echo -e "\nBuilding early stopping images...\n"
 echo -e "\nBuilding median stopping rule...\n"
 docker build -t ${REGISTRY}/earlystopping-medianstop:${TAG} -f ${CMD_PREFIX}/earlystopping/medianstop/${VERSION}/Dockerfile .
 
+echo -e "\nBuilding ASHA early stopping...\n"
+docker build -t ${REGISTRY}/earlystopping-asha:${TAG} -f ${CMD_PREFIX}/earlystopping/asha/${VERSION}/Dockerfile .
+
 # Training container images
 echo -e "\nBuilding training container images..."
 
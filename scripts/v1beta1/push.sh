@@ -56,6 +56,9 @@ docker push ${REGISTRY}/file-metrics-collector:${TAG}
 echo -e "\nPushing TF Event metrics collector image...\n"
 docker push ${REGISTRY}/tfevent-metrics-collector:${TAG}
 
+echo -e "\nPushing Prometheus metrics collector image...\n"
+docker push ${REGISTRY}/prometheus-metrics-collector:${TAG}
+
 # Suggestion images
 echo -e "\nPushing suggestion images..."
 
@@ -86,6 +89,9 @@ echo -e "\nPushing early stopping images...\n"
 echo -e "\nPushing median stopping rule...\n"
 docker push ${REGISTRY}/earlystopping-medianstop:${TAG}
 
+echo -e "\nPushing ASHA early stopping...\n"
+docker push ${REGISTRY}/earlystopping-asha:${TAG}
+
 # Training container images
 echo -e "\nPushing training container images..."
 
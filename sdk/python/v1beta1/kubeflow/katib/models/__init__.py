@@ -22,9 +22,11 @@ from kubeflow.katib.models.v1beta1_config_map_source import V1beta1ConfigMapSour
 from kubeflow.katib.models.v1beta1_early_stopping_rule import V1beta1EarlyStoppingRule
 from kubeflow.katib.models.v1beta1_early_stopping_setting import V1beta1EarlyStoppingSetting
 from kubeflow.katib.models.v1beta1_early_stopping_spec import V1beta1EarlyStoppingSpec
+from kubeflow.katib.models.v1beta1_enqueued_trial import V1beta1EnqueuedTrial
 from kubeflow.katib.models.v1beta1_experiment import V1beta1Experiment
 from kubeflow.katib.models.v1beta1_experiment_condition import V1beta1ExperimentCondition
 from kubeflow.katib.models.v1beta1_experiment_list import V1beta1ExperimentList
+from kubeflow.katib.models.v1beta1_experiment_reference import V1beta1ExperimentReference
 from kubeflow.katib.models.v1beta1_experiment_spec import V1beta1ExperimentSpec
 from kubeflow.katib.models.v1beta1_experiment_status import V1beta1ExperimentStatus
 from kubeflow.katib.models.v1beta1_feasible_space import V1beta1FeasibleSpace
@@ -35,13 +37,19 @@ from kubeflow.katib.models.v1beta1_metric import V1beta1Metric
 from kubeflow.katib.models.v1beta1_metric_strategy import V1beta1MetricStrategy
 from kubeflow.katib.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec
 from kubeflow.katib.models.v1beta1_nas_config import V1beta1NasConfig
+from kubeflow.katib.models.v1beta1_objective import V1beta1Objective
+from kubeflow.katib.models.v1beta1_objective_improvement import V1beta1ObjectiveImprovement
 from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow.katib.models.v1beta1_observation import V1beta1Observation
 from kubeflow.katib.models.v1beta1_operation import V1beta1Operation
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial
 from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment
+from kubeflow.katib.models.v1beta1_parameter_condition import V1beta1ParameterCondition
+from kubeflow.katib.models.v1beta1_parameter_constraint import V1beta1ParameterConstraint
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec
+from kubeflow.katib.models.v1beta1_retry_policy import V1beta1RetryPolicy
 from kubeflow.katib.models.v1beta1_source_spec import V1beta1SourceSpec
+from kubeflow.katib.models.v1beta1_stopping_policy import V1beta1StoppingPolicy
 from kubeflow.katib.models.v1beta1_suggestion import V1beta1Suggestion
 from kubeflow.katib.models.v1beta1_suggestion_condition import V1beta1SuggestionCondition
 from kubeflow.katib.models.v1beta1_suggestion_list import V1beta1SuggestionList
@@ -56,6 +64,7 @@ from kubeflow.katib.models.v1beta1_trial_source import V1beta1TrialSource
 from kubeflow.katib.models.v1beta1_trial_spec import V1beta1TrialSpec
 from kubeflow.katib.models.v1beta1_trial_status import V1beta1TrialStatus
 from kubeflow.katib.models.v1beta1_trial_template import V1beta1TrialTemplate
+from kubeflow.katib.models.v1beta1_warm_start_spec import V1beta1WarmStartSpec
 
 # Import Kubernetes models.
 from kubernetes.client import V1ObjectMeta
@@ -0,0 +1,119 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment  # noqa: F401,E501
+
+
+class V1beta1EnqueuedTrial(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'parameter_assignments': 'list[V1beta1ParameterAssignment]'
+    }
+
+    attribute_map = {
+        'parameter_assignments': 'parameterAssignments'
+    }
+
+    def __init__(self, parameter_assignments=None):  # noqa: E501
+        """V1beta1EnqueuedTrial - a model defined in Swagger"""  # noqa: E501
+
+        self._parameter_assignments = None
+        self.discriminator = None
+
+        if parameter_assignments is not None:
+            self.parameter_assignments = parameter_assignments
+
+    @property
+    def parameter_assignments(self):
+        """Gets the parameter_assignments of this V1beta1EnqueuedTrial.  # noqa: E501
+
+        Assignments of the parameters from the search space. Inactive conditional parameters must be omitted. Values of the parameters with step must be on the step.  # noqa: E501
+
+        :return: The parameter_assignments of this V1beta1EnqueuedTrial.  # noqa: E501
+        :rtype: list[V1beta1ParameterAssignment]
+        """
+        return self._parameter_assignments
+
+    @parameter_assignments.setter
+    def parameter_assignments(self, parameter_assignments):
+        """Sets the parameter_assignments of this V1beta1EnqueuedTrial.
+
+        Assignments of the parameters from the search space. Inactive conditional parameters must be omitted. Values of the parameters with step must be on the step.  # noqa: E501
+
+        :param parameter_assignments: The parameter_assignments of this V1beta1EnqueuedTrial.  # noqa: E501
+        :type: list[V1beta1ParameterAssignment]
+        """
+
+        self._parameter_assignments = parameter_assignments
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1EnqueuedTrial, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1EnqueuedTrial):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
@@ -18,11 +18,15 @@ import six
 
 from kubeflow.katib.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_early_stopping_spec import V1beta1EarlyStoppingSpec  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_enqueued_trial import V1beta1EnqueuedTrial  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_nas_config import V1beta1NasConfig  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_parameter_constraint import V1beta1ParameterConstraint  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_stopping_policy import V1beta1StoppingPolicy  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_trial_template import V1beta1TrialTemplate  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_warm_start_spec import V1beta1WarmStartSpec  # noqa: F401,E501
 
 
 class V1beta1ExperimentSpec(object):
@@ -40,7 +44,10 @@ class V1beta1ExperimentSpec(object):
     """
     swagger_types = {
         'algorithm': 'V1beta1AlgorithmSpec',
+        'constraints': 'list[V1beta1ParameterConstraint]',
         'early_stopping': 'V1beta1EarlyStoppingSpec',
+        'enqueued_trials': 'list[V1beta1EnqueuedTrial]',
+        'max_duration': 'str',
         'max_failed_trial_count': 'int',
         'max_trial_count': 'int',
         'metrics_collector_spec': 'V1beta1MetricsCollectorSpec',
@@ -49,12 +56,19 @@ class V1beta1ExperimentSpec(object):
         'parallel_trial_count': 'int',
         'parameters': 'list[V1beta1ParameterSpec]',
         'resume_policy': 'str',
-        'trial_template': 'V1beta1TrialTemplate'
+        'stopping_policy': 'V1beta1StoppingPolicy',
+        'suspend_policy': 'str',
+        'suspended': 'bool',
+        'trial_template': 'V1beta1TrialTemplate',
+        'warm_start': 'V1beta1WarmStartSpec'
     }
 
     attribute_map = {
         'algorithm': 'algorithm',
+        'constraints': 'constraints',
         'early_stopping': 'earlyStopping',
+        'enqueued_trials': 'enqueuedTrials',
+        'max_duration': 'maxDuration',
         'max_failed_trial_count': 'maxFailedTrialCount',
         'max_trial_count': 'maxTrialCount',
         'metrics_collector_spec': 'metricsCollectorSpec',
@@ -63,14 +77,21 @@ class V1beta1ExperimentSpec(object):
         'parallel_trial_count': 'parallelTrialCount',
         'parameters': 'parameters',
         'resume_policy': 'resumePolicy',
-        'trial_template': 'trialTemplate'
+        'stopping_policy': 'stoppingPolicy',
+        'suspend_policy': 'suspendPolicy',
+        'suspended': 'suspended',
+        'trial_template': 'trialTemplate',
+        'warm_start': 'warmStart'
     }
 
-    def __init__(self, algorithm=None, early_stopping=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, trial_template=None):  # noqa: E501
+    def __init__(self, algorithm=None, constraints=None, early_stopping=None, enqueued_trials=None, max_duration=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, stopping_policy=None, suspend_policy=None, suspended=None, trial_template=None, warm_start=None):  # noqa: E501
         """V1beta1ExperimentSpec - a model defined in Swagger"""  # noqa: E501
 
         self._algorithm = None
+        self._constraints = None
         self._early_stopping = None
+        self._enqueued_trials = None
+        self._max_duration = None
         self._max_failed_trial_count = None
         self._max_trial_count = None
         self._metrics_collector_spec = None
@@ -79,13 +100,23 @@ class V1beta1ExperimentSpec(object):
         self._parallel_trial_count = None
         self._parameters = None
         self._resume_policy = None
+        self._stopping_policy = None
+        self._suspend_policy = None
+        self._suspended = None
         self._trial_template = None
+        self._warm_start = None
         self.discriminator = None
 
         if algorithm is not None:
             self.algorithm = algorithm
+        if constraints is not None:
+            self.constraints = constraints
         if early_stopping is not None:
             self.early_stopping = early_stopping
+        if enqueued_trials is not None:
+            self.enqueued_trials = enqueued_trials
+        if max_duration is not None:
+            self.max_duration = max_duration
         if max_failed_trial_count is not None:
             self.max_failed_trial_count = max_failed_trial_count
         if max_trial_count is not None:
@@ -102,8 +133,16 @@ class V1beta1ExperimentSpec(object):
             self.parameters = parameters
         if resume_policy is not None:
             self.resume_policy = resume_policy
+        if stopping_policy is not None:
+            self.stopping_policy = stopping_policy
+        if suspend_policy is not None:
+            self.suspend_policy = suspend_policy
+        if suspended is not None:
+            self.suspended = suspended
         if trial_template is not None:
             self.trial_template = trial_template
+        if warm_start is not None:
+            self.warm_start = warm_start
 
     @property
     def algorithm(self):
@@ -128,6 +167,29 @@ class V1beta1ExperimentSpec(object):
 
         self._algorithm = algorithm
 
+    @property
+    def constraints(self):
+        """Gets the constraints of this V1beta1ExperimentSpec.  # noqa: E501
+
+        List of constraints on the hyperparameter assignments. Assignments, which don't satisfy the constraints, are rejected and new assignments are suggested.  # noqa: E501
+
+        :return: The constraints of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: list[V1beta1ParameterConstraint]
+        """
+        return self._constraints
+
+    @constraints.setter
+    def constraints(self, constraints):
+        """Sets the constraints of this V1beta1ExperimentSpec.
+
+        List of constraints on the hyperparameter assignments. Assignments, which don't satisfy the constraints, are rejected and new assignments are suggested.  # noqa: E501
+
+        :param constraints: The constraints of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: list[V1beta1ParameterConstraint]
+        """
+
+        self._constraints = constraints
+
     @property
     def early_stopping(self):
         """Gets the early_stopping of this V1beta1ExperimentSpec.  # noqa: E501
@@ -151,6 +213,52 @@ class V1beta1ExperimentSpec(object):
 
         self._early_stopping = early_stopping
 
+    @property
+    def enqueued_trials(self):
+        """Gets the enqueued_trials of this V1beta1ExperimentSpec.  # noqa: E501
+
+        List of trials with the explicit parameter assignments, which are created before the suggested trials. New trials can be appended to the list when experiment is running.  # noqa: E501
+
+        :return: The enqueued_trials of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: list[V1beta1EnqueuedTrial]
+        """
+        return self._enqueued_trials
+
+    @enqueued_trials.setter
+    def enqueued_trials(self, enqueued_trials):
+        """Sets the enqueued_trials of this V1beta1ExperimentSpec.
+
+        List of trials with the explicit parameter assignments, which are created before the suggested trials. New trials can be appended to the list when experiment is running.  # noqa: E501
+
+        :param enqueued_trials: The enqueued_trials of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: list[V1beta1EnqueuedTrial]
+        """
+
+        self._enqueued_trials = enqueued_trials
+
+    @property
+    def max_duration(self):
+        """Gets the max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Max duration of the experiment from its start time. New trials are not created and experiment is succeeded when duration is exceeded. Active trials are timed out with the observations collected so far.  # noqa: E501
+
+        :return: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._max_duration
+
+    @max_duration.setter
+    def max_duration(self, max_duration):
+        """Sets the max_duration of this V1beta1ExperimentSpec.
+
+        Max duration of the experiment from its start time. New trials are not created and experiment is succeeded when duration is exceeded. Active trials are timed out with the observations collected so far.  # noqa: E501
+
+        :param max_duration: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._max_duration = max_duration
+
     @property
     def max_failed_trial_count(self):
         """Gets the max_failed_trial_count of this V1beta1ExperimentSpec.  # noqa: E501
@@ -333,6 +441,75 @@ class V1beta1ExperimentSpec(object):
 
         self._resume_policy = resume_policy
 
+    @property
+    def stopping_policy(self):
+        """Gets the stopping_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Stops the experiment when the best objective value is not improved in the last completed trials.  # noqa: E501
+
+        :return: The stopping_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1StoppingPolicy
+        """
+        return self._stopping_policy
+
+    @stopping_policy.setter
+    def stopping_policy(self, stopping_policy):
+        """Sets the stopping_policy of this V1beta1ExperimentSpec.
+
+        Stops the experiment when the best objective value is not improved in the last completed trials.  # noqa: E501
+
+        :param stopping_policy: The stopping_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1StoppingPolicy
+        """
+
+        self._stopping_policy = stopping_policy
+
+    @property
+    def suspend_policy(self):
+        """Gets the suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes what happens with the active trials when experiment is suspended. Defaults to Drain.  # noqa: E501
+
+        :return: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._suspend_policy
+
+    @suspend_policy.setter
+    def suspend_policy(self, suspend_policy):
+        """Sets the suspend_policy of this V1beta1ExperimentSpec.
+
+        Describes what happens with the active trials when experiment is suspended. Defaults to Drain.  # noqa: E501
+
+        :param suspend_policy: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._suspend_policy = suspend_policy
+
+    @property
+    def suspended(self):
+        """Gets the suspended of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Suspends the experiment. Suspended experiment doesn't create new trials and its suggestion deployment and service are deleted until the experiment is resumed. Only experiment with resumePolicy = FromVolume can be suspended, because the suggestion state is restored from the volume when experiment is resumed.  # noqa: E501
+
+        :return: The suspended of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspended
+
+    @suspended.setter
+    def suspended(self, suspended):
+        """Sets the suspended of this V1beta1ExperimentSpec.
+
+        Suspends the experiment. Suspended experiment doesn't create new trials and its suggestion deployment and service are deleted until the experiment is resumed. Only experiment with resumePolicy = FromVolume can be suspended, because the suggestion state is restored from the volume when experiment is resumed.  # noqa: E501
+
+        :param suspended: The suspended of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspended = suspended
+
     @property
     def trial_template(self):
         """Gets the trial_template of this V1beta1ExperimentSpec.  # noqa: E501
@@ -356,6 +533,29 @@ class V1beta1ExperimentSpec(object):
 
         self._trial_template = trial_template
 
+    @property
+    def warm_start(self):
+        """Gets the warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes the previous experiments, which trials are used to warm start the search.  # noqa: E501
+
+        :return: The warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1WarmStartSpec
+        """
+        return self._warm_start
+
+    @warm_start.setter
+    def warm_start(self, warm_start):
+        """Sets the warm_start of this V1beta1ExperimentSpec.
+
+        Describes the previous experiments, which trials are used to warm start the search.  # noqa: E501
+
+        :param warm_start: The warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1WarmStartSpec
+        """
+
+        self._warm_start = warm_start
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
@@ -17,6 +17,7 @@ import re  # noqa: F401
 import six
 
 from kubeflow.katib.models.v1beta1_experiment_condition import V1beta1ExperimentCondition  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_objective_improvement import V1beta1ObjectiveImprovement  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial  # noqa: F401,E501
 
 
@@ -41,17 +42,22 @@ class V1beta1ExperimentStatus(object):
         'failed_trial_list': 'list[str]',
         'killed_trial_list': 'list[str]',
         'last_reconcile_time': 'datetime',
+        'objective_improvement_history': 'list[V1beta1ObjectiveImprovement]',
+        'pareto_optimal_trials': 'list[V1beta1OptimalTrial]',
         'pending_trial_list': 'list[str]',
         'running_trial_list': 'list[str]',
         'start_time': 'datetime',
         'succeeded_trial_list': 'list[str]',
+        'timed_out_trial_list': 'list[str]',
         'trials': 'int',
         'trials_early_stopped': 'int',
         'trials_failed': 'int',
         'trials_killed': 'int',
         'trials_pending': 'int',
         'trials_running': 'int',
-        'trials_succeeded': 'int'
+        'trials_succeeded': 'int',
+        'trials_timed_out': 'int',
+        'trials_without_improvement': 'int'
     }
 
     attribute_map = {
@@ -62,20 +68,25 @@ class V1beta1ExperimentStatus(object):
         'failed_trial_list': 'failedTrialList',
         'killed_trial_list': 'killedTrialList',
         'last_reconcile_time': 'lastReconcileTime',
+        'objective_improvement_history': 'objectiveImprovementHistory',
+        'pareto_optimal_trials': 'paretoOptimalTrials',
         'pending_trial_list': 'pendingTrialList',
         'running_trial_list': 'runningTrialList',
         'start_time': 'startTime',
         'succeeded_trial_list': 'succeededTrialList',
+        'timed_out_trial_list': 'timedOutTrialList',
         'trials': 'trials',
         'trials_early_stopped': 'trialsEarlyStopped',
         'trials_failed': 'trialsFailed',
         'trials_killed': 'trialsKilled',
         'trials_pending': 'trialsPending',
         'trials_running': 'trialsRunning',
-        'trials_succeeded': 'trialsSucceeded'
+        'trials_succeeded': 'trialsSucceeded',
+        'trials_timed_out': 'trialsTimedOut',
+        'trials_without_improvement': 'trialsWithoutImprovement'
     }
 
-    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, objective_improvement_history=None, pareto_optimal_trials=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, timed_out_trial_list=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, trials_timed_out=None, trials_without_improvement=None):  # noqa: E501
         """V1beta1ExperimentStatus - a model defined in Swagger"""  # noqa: E501
 
         self._completion_time = None
@@ -85,10 +96,13 @@ class V1beta1ExperimentStatus(object):
         self._failed_trial_list = None
         self._killed_trial_list = None
         self._last_reconcile_time = None
+        self._objective_improvement_history = None
+        self._pareto_optimal_trials = None
         self._pending_trial_list = None
         self._running_trial_list = None
         self._start_time = None
         self._succeeded_trial_list = None
+        self._timed_out_trial_list = None
         self._trials = None
         self._trials_early_stopped = None
         self._trials_failed = None
@@ -96,6 +110,8 @@ class V1beta1ExperimentStatus(object):
         self._trials_pending = None
         self._trials_running = None
         self._trials_succeeded = None
+        self._trials_timed_out = None
+        self._trials_without_improvement = None
         self.discriminator = None
 
         if completion_time is not None:
@@ -112,6 +128,10 @@ class V1beta1ExperimentStatus(object):
             self.killed_trial_list = killed_trial_list
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
+        if objective_improvement_history is not None:
+            self.objective_improvement_history = objective_improvement_history
+        if pareto_optimal_trials is not None:
+            self.pareto_optimal_trials = pareto_optimal_trials
         if pending_trial_list is not None:
             self.pending_trial_list = pending_trial_list
         if running_trial_list is not None:
@@ -120,6 +140,8 @@ class V1beta1ExperimentStatus(object):
             self.start_time = start_time
         if succeeded_trial_list is not None:
             self.succeeded_trial_list = succeeded_trial_list
+        if timed_out_trial_list is not None:
+            self.timed_out_trial_list = timed_out_trial_list
         if trials is not None:
             self.trials = trials
         if trials_early_stopped is not None:
@@ -134,6 +156,10 @@ class V1beta1ExperimentStatus(object):
             self.trials_running = trials_running
         if trials_succeeded is not None:
             self.trials_succeeded = trials_succeeded
+        if trials_timed_out is not None:
+            self.trials_timed_out = trials_timed_out
+        if trials_without_improvement is not None:
+            self.trials_without_improvement = trials_without_improvement
 
     @property
     def completion_time(self):
@@ -185,7 +211,7 @@ class V1beta1ExperimentStatus(object):
     def current_optimal_trial(self):
         """Gets the current_optimal_trial of this V1beta1ExperimentStatus.  # noqa: E501
 
-        Current optimal trial parameters and observations.  # noqa: E501
+        Current optimal trial parameters and observations. For multi-objective Experiment it is the Pareto optimal trial with the best value of the first objective.  # noqa: E501
 
         :return: The current_optimal_trial of this V1beta1ExperimentStatus.  # noqa: E501
         :rtype: V1beta1OptimalTrial
@@ -196,7 +222,7 @@ class V1beta1ExperimentStatus(object):
     def current_optimal_trial(self, current_optimal_trial):
         """Sets the current_optimal_trial of this V1beta1ExperimentStatus.
 
-        Current optimal trial parameters and observations.  # noqa: E501
+        Current optimal trial parameters and observations. For multi-objective Experiment it is the Pareto optimal trial with the best value of the first objective.  # noqa: E501
 
         :param current_optimal_trial: The current_optimal_trial of this V1beta1ExperimentStatus.  # noqa: E501
         :type: V1beta1OptimalTrial
@@ -296,6 +322,52 @@ class V1beta1ExperimentStatus(object):
 
         self._last_reconcile_time = last_reconcile_time
 
+    @property
+    def objective_improvement_history(self):
+        """Gets the objective_improvement_history of this V1beta1ExperimentStatus.  # noqa: E501
+
+        History of the best objective value improvements in the trials completion order. It is set only if the stopping policy is specified.  # noqa: E501
+
+        :return: The objective_improvement_history of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[V1beta1ObjectiveImprovement]
+        """
+        return self._objective_improvement_history
+
+    @objective_improvement_history.setter
+    def objective_improvement_history(self, objective_improvement_history):
+        """Sets the objective_improvement_history of this V1beta1ExperimentStatus.
+
+        History of the best objective value improvements in the trials completion order. It is set only if the stopping policy is specified.  # noqa: E501
+
+        :param objective_improvement_history: The objective_improvement_history of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[V1beta1ObjectiveImprovement]
+        """
+
+        self._objective_improvement_history = objective_improvement_history
+
+    @property
+    def pareto_optimal_trials(self):
+        """Gets the pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Current Pareto optimal trials of multi-objective Experiment. Trial is Pareto optimal, if no other trial is better in one objective and not worse in all other objectives.  # noqa: E501
+
+        :return: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[V1beta1OptimalTrial]
+        """
+        return self._pareto_optimal_trials
+
+    @pareto_optimal_trials.setter
+    def pareto_optimal_trials(self, pareto_optimal_trials):
+        """Sets the pareto_optimal_trials of this V1beta1ExperimentStatus.
+
+        Current Pareto optimal trials of multi-objective Experiment. Trial is Pareto optimal, if no other trial is better in one objective and not worse in all other objectives.  # noqa: E501
+
+        :param pareto_optimal_trials: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[V1beta1OptimalTrial]
+        """
+
+        self._pareto_optimal_trials = pareto_optimal_trials
+
     @property
     def pending_trial_list(self):
         """Gets the pending_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -388,6 +460,29 @@ class V1beta1ExperimentStatus(object):
 
         self._succeeded_trial_list = succeeded_trial_list
 
+    @property
+    def timed_out_trial_list(self):
+        """Gets the timed_out_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+
+        List of trial names which have been timed out.  # noqa: E501
+
+        :return: The timed_out_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._timed_out_trial_list
+
+    @timed_out_trial_list.setter
+    def timed_out_trial_list(self, timed_out_trial_list):
+        """Sets the timed_out_trial_list of this V1beta1ExperimentStatus.
+
+        List of trial names which have been timed out.  # noqa: E501
+
+        :param timed_out_trial_list: The timed_out_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._timed_out_trial_list = timed_out_trial_list
+
     @property
     def trials(self):
         """Gets the trials of this V1beta1ExperimentStatus.  # noqa: E501
@@ -549,6 +644,52 @@ class V1beta1ExperimentStatus(object):
 
         self._trials_succeeded = trials_succeeded
 
+    @property
+    def trials_timed_out(self):
+        """Gets the trials_timed_out of this V1beta1ExperimentStatus.  # noqa: E501
+
+        How many trials have been timed out.  # noqa: E501
+
+        :return: The trials_timed_out of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._trials_timed_out
+
+    @trials_timed_out.setter
+    def trials_timed_out(self, trials_timed_out):
+        """Sets the trials_timed_out of this V1beta1ExperimentStatus.
+
+        How many trials have been timed out.  # noqa: E501
+
+        :param trials_timed_out: The trials_timed_out of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._trials_timed_out = trials_timed_out
+
+    @property
+    def trials_without_improvement(self):
+        """Gets the trials_without_improvement of this V1beta1ExperimentStatus.  # noqa: E501
+
+        How many trials have succeeded after the last improvement of the best objective value. It is set only if the stopping policy is specified.  # noqa: E501
+
+        :return: The trials_without_improvement of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._trials_without_improvement
+
+    @trials_without_improvement.setter
+    def trials_without_improvement(self, trials_without_improvement):
+        """Sets the trials_without_improvement of this V1beta1ExperimentStatus.
+
+        How many trials have succeeded after the last improvement of the best objective value. It is set only if the stopping policy is specified.  # noqa: E501
+
+        :param trials_without_improvement: The trials_without_improvement of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._trials_without_improvement = trials_without_improvement
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
@@ -31,37 +31,75 @@ class V1beta1FeasibleSpace(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'distribution': 'str',
         'list': 'list[str]',
         'max': 'str',
+        'mean': 'str',
         'min': 'str',
+        'std': 'str',
         'step': 'str'
     }
 
     attribute_map = {
+        'distribution': 'distribution',
         'list': 'list',
         'max': 'max',
+        'mean': 'mean',
         'min': 'min',
+        'std': 'std',
         'step': 'step'
     }
 
-    def __init__(self, list=None, max=None, min=None, step=None):  # noqa: E501
+    def __init__(self, distribution=None, list=None, max=None, mean=None, min=None, std=None, step=None):  # noqa: E501
         """V1beta1FeasibleSpace - a model defined in Swagger"""  # noqa: E501
 
+        self._distribution = None
         self._list = None
         self._max = None
+        self._mean = None
         self._min = None
+        self._std = None
         self._step = None
         self.discriminator = None
 
+        if distribution is not None:
+            self.distribution = distribution
         if list is not None:
             self.list = list
         if max is not None:
             self.max = max
+        if mean is not None:
+            self.mean = mean
         if min is not None:
             self.min = min
+        if std is not None:
+            self.std = std
         if step is not None:
             self.step = step
 
+    @property
+    def distribution(self):
+        """Gets the distribution of this V1beta1FeasibleSpace.  # noqa: E501
+
+        Distribution of the int or double parameter values, uniform by default. Values of the log-uniform parameter with step are rounded to the multiple of the step.  # noqa: E501
+
+        :return: The distribution of this V1beta1FeasibleSpace.  # noqa: E501
+        :rtype: str
+        """
+        return self._distribution
+
+    @distribution.setter
+    def distribution(self, distribution):
+        """Sets the distribution of this V1beta1FeasibleSpace.
+
+        Distribution of the int or double parameter values, uniform by default. Values of the log-uniform parameter with step are rounded to the multiple of the step.  # noqa: E501
+
+        :param distribution: The distribution of this V1beta1FeasibleSpace.  # noqa: E501
+        :type: str
+        """
+
+        self._distribution = distribution
+
     @property
     def list(self):
         """Gets the list of this V1beta1FeasibleSpace.  # noqa: E501
@@ -104,6 +142,29 @@ class V1beta1FeasibleSpace(object):
 
         self._max = max
 
+    @property
+    def mean(self):
+        """Gets the mean of this V1beta1FeasibleSpace.  # noqa: E501
+
+        Mean of the normal distribution. Values of the normal parameter are sampled between min and max.  # noqa: E501
+
+        :return: The mean of this V1beta1FeasibleSpace.  # noqa: E501
+        :rtype: str
+        """
+        return self._mean
+
+    @mean.setter
+    def mean(self, mean):
+        """Sets the mean of this V1beta1FeasibleSpace.
+
+        Mean of the normal distribution. Values of the normal parameter are sampled between min and max.  # noqa: E501
+
+        :param mean: The mean of this V1beta1FeasibleSpace.  # noqa: E501
+        :type: str
+        """
+
+        self._mean = mean
+
     @property
     def min(self):
         """Gets the min of this V1beta1FeasibleSpace.  # noqa: E501
@@ -125,6 +186,29 @@ class V1beta1FeasibleSpace(object):
 
         self._min = min
 
+    @property
+    def std(self):
+        """Gets the std of this V1beta1FeasibleSpace.  # noqa: E501
+
+        Standard deviation of the normal distribution.  # noqa: E501
+
+        :return: The std of this V1beta1FeasibleSpace.  # noqa: E501
+        :rtype: str
+        """
+        return self._std
+
+    @std.setter
+    def std(self, std):
+        """Sets the std of this V1beta1FeasibleSpace.
+
+        Standard deviation of the normal distribution.  # noqa: E501
+
+        :param std: The std of this V1beta1FeasibleSpace.  # noqa: E501
+        :type: str
+        """
+
+        self._std = std
+
     @property
     def step(self):
         """Gets the step of this V1beta1FeasibleSpace.  # noqa: E501
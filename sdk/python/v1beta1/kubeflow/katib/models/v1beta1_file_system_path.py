@@ -31,27 +31,55 @@ class V1beta1FileSystemPath(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'format': 'str',
         'kind': 'str',
         'path': 'str'
     }
 
     attribute_map = {
+        'format': 'format',
         'kind': 'kind',
         'path': 'path'
     }
 
-    def __init__(self, kind=None, path=None):  # noqa: E501
+    def __init__(self, format=None, kind=None, path=None):  # noqa: E501
         """V1beta1FileSystemPath - a model defined in Swagger"""  # noqa: E501
 
+        self._format = None
         self._kind = None
         self._path = None
         self.discriminator = None
 
+        if format is not None:
+            self.format = format
         if kind is not None:
             self.kind = kind
         if path is not None:
             self.path = path
 
+    @property
+    def format(self):
+        """Gets the format of this V1beta1FileSystemPath.  # noqa: E501
+
+        Format of the metrics file lines, it is TEXT by default. Supported only for File metrics collector.  # noqa: E501
+
+        :return: The format of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._format
+
+    @format.setter
+    def format(self, format):
+        """Sets the format of this V1beta1FileSystemPath.
+
+        Format of the metrics file lines, it is TEXT by default. Supported only for File metrics collector.  # noqa: E501
+
+        :param format: The format of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._format = format
+
     @property
     def kind(self):
         """Gets the kind of this V1beta1FileSystemPath.  # noqa: E501
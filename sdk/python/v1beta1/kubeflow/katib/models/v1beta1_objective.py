@@ -0,0 +1,173 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1Objective(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'goal': 'float',
+        'objective_metric_name': 'str',
+        'type': 'str'
+    }
+
+    attribute_map = {
+        'goal': 'goal',
+        'objective_metric_name': 'objectiveMetricName',
+        'type': 'type'
+    }
+
+    def __init__(self, goal=None, objective_metric_name=None, type=None):  # noqa: E501
+        """V1beta1Objective - a model defined in Swagger"""  # noqa: E501
+
+        self._goal = None
+        self._objective_metric_name = None
+        self._type = None
+        self.discriminator = None
+
+        if goal is not None:
+            self.goal = goal
+        if objective_metric_name is not None:
+            self.objective_metric_name = objective_metric_name
+        if type is not None:
+            self.type = type
+
+    @property
+    def goal(self):
+        """Gets the goal of this V1beta1Objective.  # noqa: E501
+
+        Goal is the objective goal that should be reached. The goal of the Experiment is reached, when one Trial reaches the goals of all objectives.  # noqa: E501
+
+        :return: The goal of this V1beta1Objective.  # noqa: E501
+        :rtype: float
+        """
+        return self._goal
+
+    @goal.setter
+    def goal(self, goal):
+        """Sets the goal of this V1beta1Objective.
+
+        Goal is the objective goal that should be reached. The goal of the Experiment is reached, when one Trial reaches the goals of all objectives.  # noqa: E501
+
+        :param goal: The goal of this V1beta1Objective.  # noqa: E501
+        :type: float
+        """
+
+        self._goal = goal
+
+    @property
+    def objective_metric_name(self):
+        """Gets the objective_metric_name of this V1beta1Objective.  # noqa: E501
+
+        ObjectiveMetricName represents metric to optimize.  # noqa: E501
+
+        :return: The objective_metric_name of this V1beta1Objective.  # noqa: E501
+        :rtype: str
+        """
+        return self._objective_metric_name
+
+    @objective_metric_name.setter
+    def objective_metric_name(self, objective_metric_name):
+        """Sets the objective_metric_name of this V1beta1Objective.
+
+        ObjectiveMetricName represents metric to optimize.  # noqa: E501
+
+        :param objective_metric_name: The objective_metric_name of this V1beta1Objective.  # noqa: E501
+        :type: str
+        """
+
+        self._objective_metric_name = objective_metric_name
+
+    @property
+    def type(self):
+        """Gets the type of this V1beta1Objective.  # noqa: E501
+
+        Type for the objective optimization.  # noqa: E501
+
+        :return: The type of this V1beta1Objective.  # noqa: E501
+        :rtype: str
+        """
+        return self._type
+
+    @type.setter
+    def type(self, type):
+        """Sets the type of this V1beta1Objective.
+
+        Type for the objective optimization.  # noqa: E501
+
+        :param type: The type of this V1beta1Objective.  # noqa: E501
+        :type: str
+        """
+
+        self._type = type
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1Objective, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1Objective):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
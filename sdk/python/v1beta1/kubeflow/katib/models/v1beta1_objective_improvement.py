@@ -0,0 +1,176 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1ObjectiveImprovement(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'completed_trials': 'int',
+        'trial_name': 'str',
+        'value': 'float'
+    }
+
+    attribute_map = {
+        'completed_trials': 'completedTrials',
+        'trial_name': 'trialName',
+        'value': 'value'
+    }
+
+    def __init__(self, completed_trials=0, trial_name='', value=0):  # noqa: E501
+        """V1beta1ObjectiveImprovement - a model defined in Swagger"""  # noqa: E501
+
+        self._completed_trials = None
+        self._trial_name = None
+        self._value = None
+        self.discriminator = None
+
+        self.completed_trials = completed_trials
+        self.trial_name = trial_name
+        self.value = value
+
+    @property
+    def completed_trials(self):
+        """Gets the completed_trials of this V1beta1ObjectiveImprovement.  # noqa: E501
+
+        Number of the succeeded trials with the objective metric value, including this trial.  # noqa: E501
+
+        :return: The completed_trials of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :rtype: int
+        """
+        return self._completed_trials
+
+    @completed_trials.setter
+    def completed_trials(self, completed_trials):
+        """Sets the completed_trials of this V1beta1ObjectiveImprovement.
+
+        Number of the succeeded trials with the objective metric value, including this trial.  # noqa: E501
+
+        :param completed_trials: The completed_trials of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :type: int
+        """
+        if completed_trials is None:
+            raise ValueError("Invalid value for `completed_trials`, must not be `None`")  # noqa: E501
+
+        self._completed_trials = completed_trials
+
+    @property
+    def trial_name(self):
+        """Gets the trial_name of this V1beta1ObjectiveImprovement.  # noqa: E501
+
+        Name of the trial, which improved the best objective value.  # noqa: E501
+
+        :return: The trial_name of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :rtype: str
+        """
+        return self._trial_name
+
+    @trial_name.setter
+    def trial_name(self, trial_name):
+        """Sets the trial_name of this V1beta1ObjectiveImprovement.
+
+        Name of the trial, which improved the best objective value.  # noqa: E501
+
+        :param trial_name: The trial_name of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :type: str
+        """
+        if trial_name is None:
+            raise ValueError("Invalid value for `trial_name`, must not be `None`")  # noqa: E501
+
+        self._trial_name = trial_name
+
+    @property
+    def value(self):
+        """Gets the value of this V1beta1ObjectiveImprovement.  # noqa: E501
+
+        New best objective value.  # noqa: E501
+
+        :return: The value of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :rtype: float
+        """
+        return self._value
+
+    @value.setter
+    def value(self, value):
+        """Sets the value of this V1beta1ObjectiveImprovement.
+
+        New best objective value.  # noqa: E501
+
+        :param value: The value of this V1beta1ObjectiveImprovement.  # noqa: E501
+        :type: float
+        """
+        if value is None:
+            raise ValueError("Invalid value for `value`, must not be `None`")  # noqa: E501
+
+        self._value = value
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1ObjectiveImprovement, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ObjectiveImprovement):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
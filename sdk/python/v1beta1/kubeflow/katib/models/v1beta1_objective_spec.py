@@ -17,6 +17,7 @@ import re  # noqa: F401
 import six
 
 from kubeflow.katib.models.v1beta1_metric_strategy import V1beta1MetricStrategy  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_objective import V1beta1Objective  # noqa: F401,E501
 
 
 class V1beta1ObjectiveSpec(object):
@@ -37,6 +38,7 @@ class V1beta1ObjectiveSpec(object):
         'goal': 'float',
         'metric_strategies': 'list[V1beta1MetricStrategy]',
         'objective_metric_name': 'str',
+        'objectives': 'list[V1beta1Objective]',
         'type': 'str'
     }
 
@@ -45,16 +47,18 @@ class V1beta1ObjectiveSpec(object):
         'goal': 'goal',
         'metric_strategies': 'metricStrategies',
         'objective_metric_name': 'objectiveMetricName',
+        'objectives': 'objectives',
         'type': 'type'
     }
 
-    def __init__(self, additional_metric_names=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None):  # noqa: E501
+    def __init__(self, additional_metric_names=None, goal=None, metric_strategies=None, objective_metric_name=None, objectives=None, type=None):  # noqa: E501
         """V1beta1ObjectiveSpec - a model defined in Swagger"""  # noqa: E501
 
         self._additional_metric_names = None
         self._goal = None
         self._metric_strategies = None
         self._objective_metric_name = None
+        self._objectives = None
         self._type = None
         self.discriminator = None
 
@@ -66,6 +70,8 @@ class V1beta1ObjectiveSpec(object):
             self.metric_strategies = metric_strategies
         if objective_metric_name is not None:
             self.objective_metric_name = objective_metric_name
+        if objectives is not None:
+            self.objectives = objectives
         if type is not None:
             self.type = type
 
@@ -161,6 +167,29 @@ class V1beta1ObjectiveSpec(object):
 
         self._objective_metric_name = objective_metric_name
 
+    @property
+    def objectives(self):
+        """Gets the objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        Objectives represents Experiment's objectives for multi-objective optimization. Each objective has its own metric and direction. The first objective is the primary one, experiment defaulter (webhook) sets Type and ObjectiveMetricName from it and adds metrics of the other objectives to AdditionalMetricNames.  # noqa: E501
+
+        :return: The objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1Objective]
+        """
+        return self._objectives
+
+    @objectives.setter
+    def objectives(self, objectives):
+        """Sets the objectives of this V1beta1ObjectiveSpec.
+
+        Objectives represents Experiment's objectives for multi-objective optimization. Each objective has its own metric and direction. The first objective is the primary one, experiment defaulter (webhook) sets Type and ObjectiveMetricName from it and adds metrics of the other objectives to AdditionalMetricNames.  # noqa: E501
+
+        :param objectives: The objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1Objective]
+        """
+
+        self._objectives = objectives
+
     @property
     def type(self):
         """Gets the type of this V1beta1ObjectiveSpec.  # noqa: E501
@@ -0,0 +1,173 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1ParameterCondition(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'inactive_value': 'str',
+        'parameter': 'str',
+        'values': 'list[str]'
+    }
+
+    attribute_map = {
+        'inactive_value': 'inactiveValue',
+        'parameter': 'parameter',
+        'values': 'values'
+    }
+
+    def __init__(self, inactive_value=None, parameter=None, values=None):  # noqa: E501
+        """V1beta1ParameterCondition - a model defined in Swagger"""  # noqa: E501
+
+        self._inactive_value = None
+        self._parameter = None
+        self._values = None
+        self.discriminator = None
+
+        if inactive_value is not None:
+            self.inactive_value = inactive_value
+        if parameter is not None:
+            self.parameter = parameter
+        if values is not None:
+            self.values = values
+
+    @property
+    def inactive_value(self):
+        """Gets the inactive_value of this V1beta1ParameterCondition.  # noqa: E501
+
+        InactiveValue replaces the parameter in the Trial template when the parameter is inactive. Empty string is used by default.  # noqa: E501
+
+        :return: The inactive_value of this V1beta1ParameterCondition.  # noqa: E501
+        :rtype: str
+        """
+        return self._inactive_value
+
+    @inactive_value.setter
+    def inactive_value(self, inactive_value):
+        """Sets the inactive_value of this V1beta1ParameterCondition.
+
+        InactiveValue replaces the parameter in the Trial template when the parameter is inactive. Empty string is used by default.  # noqa: E501
+
+        :param inactive_value: The inactive_value of this V1beta1ParameterCondition.  # noqa: E501
+        :type: str
+        """
+
+        self._inactive_value = inactive_value
+
+    @property
+    def parameter(self):
+        """Gets the parameter of this V1beta1ParameterCondition.  # noqa: E501
+
+        Name of the categorical or discrete parameter, which this parameter depends on.  # noqa: E501
+
+        :return: The parameter of this V1beta1ParameterCondition.  # noqa: E501
+        :rtype: str
+        """
+        return self._parameter
+
+    @parameter.setter
+    def parameter(self, parameter):
+        """Sets the parameter of this V1beta1ParameterCondition.
+
+        Name of the categorical or discrete parameter, which this parameter depends on.  # noqa: E501
+
+        :param parameter: The parameter of this V1beta1ParameterCondition.  # noqa: E501
+        :type: str
+        """
+
+        self._parameter = parameter
+
+    @property
+    def values(self):
+        """Gets the values of this V1beta1ParameterCondition.  # noqa: E501
+
+        Values of the parent parameter, for which this parameter is active.  # noqa: E501
+
+        :return: The values of this V1beta1ParameterCondition.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._values
+
+    @values.setter
+    def values(self, values):
+        """Sets the values of this V1beta1ParameterCondition.
+
+        Values of the parent parameter, for which this parameter is active.  # noqa: E501
+
+        :param values: The values of this V1beta1ParameterCondition.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._values = values
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1ParameterCondition, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ParameterCondition):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
@@ -0,0 +1,145 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1ParameterConstraint(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'expression': 'str',
+        'name': 'str'
+    }
+
+    attribute_map = {
+        'expression': 'expression',
+        'name': 'name'
+    }
+
+    def __init__(self, expression=None, name=None):  # noqa: E501
+        """V1beta1ParameterConstraint - a model defined in Swagger"""  # noqa: E501
+
+        self._expression = None
+        self._name = None
+        self.discriminator = None
+
+        if expression is not None:
+            self.expression = expression
+        if name is not None:
+            self.name = name
+
+    @property
+    def expression(self):
+        """Gets the expression of this V1beta1ParameterConstraint.  # noqa: E501
+
+        Expression must be true for the feasible assignments.  # noqa: E501
+
+        :return: The expression of this V1beta1ParameterConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._expression
+
+    @expression.setter
+    def expression(self, expression):
+        """Sets the expression of this V1beta1ParameterConstraint.
+
+        Expression must be true for the feasible assignments.  # noqa: E501
+
+        :param expression: The expression of this V1beta1ParameterConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._expression = expression
+
+    @property
+    def name(self):
+        """Gets the name of this V1beta1ParameterConstraint.  # noqa: E501
+
+        Name of the constraint, which is reported when assignments are rejected.  # noqa: E501
+
+        :return: The name of this V1beta1ParameterConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V1beta1ParameterConstraint.
+
+        Name of the constraint, which is reported when assignments are rejected.  # noqa: E501
+
+        :param name: The name of this V1beta1ParameterConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._name = name
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1ParameterConstraint, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ParameterConstraint):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
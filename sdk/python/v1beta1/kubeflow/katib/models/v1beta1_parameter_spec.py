@@ -17,6 +17,7 @@ import re  # noqa: F401
 import six
 
 from kubeflow.katib.models.v1beta1_feasible_space import V1beta1FeasibleSpace  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_parameter_condition import V1beta1ParameterCondition  # noqa: F401,E501
 
 
 class V1beta1ParameterSpec(object):
@@ -33,25 +34,30 @@ class V1beta1ParameterSpec(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'condition': 'V1beta1ParameterCondition',
         'feasible_space': 'V1beta1FeasibleSpace',
         'name': 'str',
         'parameter_type': 'str'
     }
 
     attribute_map = {
+        'condition': 'condition',
         'feasible_space': 'feasibleSpace',
         'name': 'name',
         'parameter_type': 'parameterType'
     }
 
-    def __init__(self, feasible_space=None, name=None, parameter_type=None):  # noqa: E501
+    def __init__(self, condition=None, feasible_space=None, name=None, parameter_type=None):  # noqa: E501
         """V1beta1ParameterSpec - a model defined in Swagger"""  # noqa: E501
 
+        self._condition = None
         self._feasible_space = None
         self._name = None
         self._parameter_type = None
         self.discriminator = None
 
+        if condition is not None:
+            self.condition = condition
         if feasible_space is not None:
             self.feasible_space = feasible_space
         if name is not None:
@@ -59,6 +65,29 @@ class V1beta1ParameterSpec(object):
         if parameter_type is not None:
             self.parameter_type = parameter_type
 
+    @property
+    def condition(self):
+        """Gets the condition of this V1beta1ParameterSpec.  # noqa: E501
+
+        Condition makes the parameter active only for the given values of another parameter. Inactive parameters are not assigned in the Trial.  # noqa: E501
+
+        :return: The condition of this V1beta1ParameterSpec.  # noqa: E501
+        :rtype: V1beta1ParameterCondition
+        """
+        return self._condition
+
+    @condition.setter
+    def condition(self, condition):
+        """Sets the condition of this V1beta1ParameterSpec.
+
+        Condition makes the parameter active only for the given values of another parameter. Inactive parameters are not assigned in the Trial.  # noqa: E501
+
+        :param condition: The condition of this V1beta1ParameterSpec.  # noqa: E501
+        :type: V1beta1ParameterCondition
+        """
+
+        self._condition = condition
+
     @property
     def feasible_space(self):
         """Gets the feasible_space of this V1beta1ParameterSpec.  # noqa: E501
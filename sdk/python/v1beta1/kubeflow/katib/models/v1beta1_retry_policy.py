@@ -0,0 +1,173 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1RetryPolicy(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'exit_codes': 'list[int]',
+        'max_retries': 'int',
+        'reasons': 'list[str]'
+    }
+
+    attribute_map = {
+        'exit_codes': 'exitCodes',
+        'max_retries': 'maxRetries',
+        'reasons': 'reasons'
+    }
+
+    def __init__(self, exit_codes=None, max_retries=None, reasons=None):  # noqa: E501
+        """V1beta1RetryPolicy - a model defined in Swagger"""  # noqa: E501
+
+        self._exit_codes = None
+        self._max_retries = None
+        self._reasons = None
+        self.discriminator = None
+
+        if exit_codes is not None:
+            self.exit_codes = exit_codes
+        if max_retries is not None:
+            self.max_retries = max_retries
+        if reasons is not None:
+            self.reasons = reasons
+
+    @property
+    def exit_codes(self):
+        """Gets the exit_codes of this V1beta1RetryPolicy.  # noqa: E501
+
+        List of retryable exit codes. Failure is retryable if the terminated primary container of the trial run Pods has one of them. Only Pods owned by the trial run are checked, otherwise the exit code is taken from the message of the trial run failure condition, if it contains \"exit code\". If reasons and exit codes are omitted, all failures are retryable.  # noqa: E501
+
+        :return: The exit_codes of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: list[int]
+        """
+        return self._exit_codes
+
+    @exit_codes.setter
+    def exit_codes(self, exit_codes):
+        """Sets the exit_codes of this V1beta1RetryPolicy.
+
+        List of retryable exit codes. Failure is retryable if the terminated primary container of the trial run Pods has one of them. Only Pods owned by the trial run are checked, otherwise the exit code is taken from the message of the trial run failure condition, if it contains \"exit code\". If reasons and exit codes are omitted, all failures are retryable.  # noqa: E501
+
+        :param exit_codes: The exit_codes of this V1beta1RetryPolicy.  # noqa: E501
+        :type: list[int]
+        """
+
+        self._exit_codes = exit_codes
+
+    @property
+    def max_retries(self):
+        """Gets the max_retries of this V1beta1RetryPolicy.  # noqa: E501
+
+        Max number of times the failed trial run is created again.  # noqa: E501
+
+        :return: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_retries
+
+    @max_retries.setter
+    def max_retries(self, max_retries):
+        """Sets the max_retries of this V1beta1RetryPolicy.
+
+        Max number of times the failed trial run is created again.  # noqa: E501
+
+        :param max_retries: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :type: int
+        """
+
+        self._max_retries = max_retries
+
+    @property
+    def reasons(self):
+        """Gets the reasons of this V1beta1RetryPolicy.  # noqa: E501
+
+        List of retryable failure reasons. Failure is retryable if the reason or the message of the trial run failure condition contains one of them.  # noqa: E501
+
+        :return: The reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._reasons
+
+    @reasons.setter
+    def reasons(self, reasons):
+        """Sets the reasons of this V1beta1RetryPolicy.
+
+        List of retryable failure reasons. Failure is retryable if the reason or the message of the trial run failure condition contains one of them.  # noqa: E501
+
+        :param reasons: The reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._reasons = reasons
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1RetryPolicy, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1RetryPolicy):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
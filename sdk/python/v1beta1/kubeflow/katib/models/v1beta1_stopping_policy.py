@@ -0,0 +1,146 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1StoppingPolicy(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'patience': 'int',
+        'tolerance': 'float'
+    }
+
+    attribute_map = {
+        'patience': 'patience',
+        'tolerance': 'tolerance'
+    }
+
+    def __init__(self, patience=0, tolerance=None):  # noqa: E501
+        """V1beta1StoppingPolicy - a model defined in Swagger"""  # noqa: E501
+
+        self._patience = None
+        self._tolerance = None
+        self.discriminator = None
+
+        self.patience = patience
+        if tolerance is not None:
+            self.tolerance = tolerance
+
+    @property
+    def patience(self):
+        """Gets the patience of this V1beta1StoppingPolicy.  # noqa: E501
+
+        Number of the last succeeded trials without improvement of the best objective value to stop the experiment. Only trials with the objective metric value are counted.  # noqa: E501
+
+        :return: The patience of this V1beta1StoppingPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._patience
+
+    @patience.setter
+    def patience(self, patience):
+        """Sets the patience of this V1beta1StoppingPolicy.
+
+        Number of the last succeeded trials without improvement of the best objective value to stop the experiment. Only trials with the objective metric value are counted.  # noqa: E501
+
+        :param patience: The patience of this V1beta1StoppingPolicy.  # noqa: E501
+        :type: int
+        """
+        if patience is None:
+            raise ValueError("Invalid value for `patience`, must not be `None`")  # noqa: E501
+
+        self._patience = patience
+
+    @property
+    def tolerance(self):
+        """Gets the tolerance of this V1beta1StoppingPolicy.  # noqa: E501
+
+        Min change of the best objective value, which is counted as improvement. Defaults to 0, so any improvement is counted.  # noqa: E501
+
+        :return: The tolerance of this V1beta1StoppingPolicy.  # noqa: E501
+        :rtype: float
+        """
+        return self._tolerance
+
+    @tolerance.setter
+    def tolerance(self, tolerance):
+        """Sets the tolerance of this V1beta1StoppingPolicy.
+
+        Min change of the best objective value, which is counted as improvement. Defaults to 0, so any improvement is counted.  # noqa: E501
+
+        :param tolerance: The tolerance of this V1beta1StoppingPolicy.  # noqa: E501
+        :type: float
+        """
+
+        self._tolerance = tolerance
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1StoppingPolicy, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1StoppingPolicy):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
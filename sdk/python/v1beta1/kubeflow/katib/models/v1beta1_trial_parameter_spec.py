@@ -32,26 +32,31 @@ class V1beta1TrialParameterSpec(object):
     """
     swagger_types = {
         'description': 'str',
+        'expression': 'str',
         'name': 'str',
         'reference': 'str'
     }
 
     attribute_map = {
         'description': 'description',
+        'expression': 'expression',
         'name': 'name',
         'reference': 'reference'
     }
 
-    def __init__(self, description=None, name=None, reference=None):  # noqa: E501
+    def __init__(self, description=None, expression=None, name=None, reference=None):  # noqa: E501
         """V1beta1TrialParameterSpec - a model defined in Swagger"""  # noqa: E501
 
         self._description = None
+        self._expression = None
         self._name = None
         self._reference = None
         self.discriminator = None
 
         if description is not None:
             self.description = description
+        if expression is not None:
+            self.expression = expression
         if name is not None:
             self.name = name
         if reference is not None:
@@ -80,6 +85,29 @@ class V1beta1TrialParameterSpec(object):
 
         self._description = description
 
+    @property
+    def expression(self):
+        """Gets the expression of this V1beta1TrialParameterSpec.  # noqa: E501
+
+        Expression to derive the parameter value from the search space parameters, e.g. \"round(0.1 * total_steps)\". It is used instead of the Reference.  # noqa: E501
+
+        :return: The expression of this V1beta1TrialParameterSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._expression
+
+    @expression.setter
+    def expression(self, expression):
+        """Sets the expression of this V1beta1TrialParameterSpec.
+
+        Expression to derive the parameter value from the search space parameters, e.g. \"round(0.1 * total_steps)\". It is used instead of the Reference.  # noqa: E501
+
+        :param expression: The expression of this V1beta1TrialParameterSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._expression = expression
+
     @property
     def name(self):
         """Gets the name of this V1beta1TrialParameterSpec.  # noqa: E501
@@ -17,6 +17,7 @@ import re  # noqa: F401
 import six
 
 from kubeflow.katib.models.v1beta1_config_map_source import V1beta1ConfigMapSource  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_retry_policy import V1beta1RetryPolicy  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_trial_parameter_spec import V1beta1TrialParameterSpec  # noqa: F401,E501
 
 
@@ -36,9 +37,11 @@ class V1beta1TrialTemplate(object):
     swagger_types = {
         'config_map': 'V1beta1ConfigMapSource',
         'failure_condition': 'str',
+        'max_trial_duration': 'str',
         'primary_container_name': 'str',
         'primary_pod_labels': 'dict(str, str)',
         'retain': 'bool',
+        'retry_policy': 'V1beta1RetryPolicy',
         'success_condition': 'str',
         'trial_parameters': 'list[V1beta1TrialParameterSpec]',
         'trial_spec': 'object'
@@ -47,22 +50,26 @@ class V1beta1TrialTemplate(object):
     attribute_map = {
         'config_map': 'configMap',
         'failure_condition': 'failureCondition',
+        'max_trial_duration': 'maxTrialDuration',
         'primary_container_name': 'primaryContainerName',
         'primary_pod_labels': 'primaryPodLabels',
         'retain': 'retain',
+        'retry_policy': 'retryPolicy',
         'success_condition': 'successCondition',
         'trial_parameters': 'trialParameters',
         'trial_spec': 'trialSpec'
     }
 
-    def __init__(self, config_map=None, failure_condition=None, primary_container_name=None, primary_pod_labels=None, retain=None, success_condition=None, trial_parameters=None, trial_spec=None):  # noqa: E501
+    def __init__(self, config_map=None, failure_condition=None, max_trial_duration=None, primary_container_name=None, primary_pod_labels=None, retain=None, retry_policy=None, success_condition=None, trial_parameters=None, trial_spec=None):  # noqa: E501
         """V1beta1TrialTemplate - a model defined in Swagger"""  # noqa: E501
 
         self._config_map = None
         self._failure_condition = None
+        self._max_trial_duration = None
         self._primary_container_name = None
         self._primary_pod_labels = None
         self._retain = None
+        self._retry_policy = None
         self._success_condition = None
         self._trial_parameters = None
         self._trial_spec = None
@@ -72,12 +79,16 @@ class V1beta1TrialTemplate(object):
             self.config_map = config_map
         if failure_condition is not None:
             self.failure_condition = failure_condition
+        if max_trial_duration is not None:
+            self.max_trial_duration = max_trial_duration
         if primary_container_name is not None:
             self.primary_container_name = primary_container_name
         if primary_pod_labels is not None:
             self.primary_pod_labels = primary_pod_labels
         if retain is not None:
             self.retain = retain
+        if retry_policy is not None:
+            self.retry_policy = retry_policy
         if success_condition is not None:
             self.success_condition = success_condition
         if trial_parameters is not None:
@@ -131,6 +142,29 @@ class V1beta1TrialTemplate(object):
 
         self._failure_condition = failure_condition
 
+    @property
+    def max_trial_duration(self):
+        """Gets the max_trial_duration of this V1beta1TrialTemplate.  # noqa: E501
+
+        Max duration of each trial from its start time. Trial run is killed and trial is timed out when duration is exceeded.  # noqa: E501
+
+        :return: The max_trial_duration of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: str
+        """
+        return self._max_trial_duration
+
+    @max_trial_duration.setter
+    def max_trial_duration(self, max_trial_duration):
+        """Sets the max_trial_duration of this V1beta1TrialTemplate.
+
+        Max duration of each trial from its start time. Trial run is killed and trial is timed out when duration is exceeded.  # noqa: E501
+
+        :param max_trial_duration: The max_trial_duration of this V1beta1TrialTemplate.  # noqa: E501
+        :type: str
+        """
+
+        self._max_trial_duration = max_trial_duration
+
     @property
     def primary_container_name(self):
         """Gets the primary_container_name of this V1beta1TrialTemplate.  # noqa: E501
@@ -200,6 +234,29 @@ class V1beta1TrialTemplate(object):
 
         self._retain = retain
 
+    @property
+    def retry_policy(self):
+        """Gets the retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+
+        Describes how the failed trial run is retried. Trial is failed only after retries are exhausted.  # noqa: E501
+
+        :return: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: V1beta1RetryPolicy
+        """
+        return self._retry_policy
+
+    @retry_policy.setter
+    def retry_policy(self, retry_policy):
+        """Sets the retry_policy of this V1beta1TrialTemplate.
+
+        Describes how the failed trial run is retried. Trial is failed only after retries are exhausted.  # noqa: E501
+
+        :param retry_policy: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :type: V1beta1RetryPolicy
+        """
+
+        self._retry_policy = retry_policy
+
     @property
     def success_condition(self):
         """Gets the success_condition of this V1beta1TrialTemplate.  # noqa: E501
@@ -0,0 +1,119 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.models.v1beta1_experiment_reference import V1beta1ExperimentReference  # noqa: F401,E501
+
+
+class V1beta1WarmStartSpec(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'experiments': 'list[V1beta1ExperimentReference]'
+    }
+
+    attribute_map = {
+        'experiments': 'experiments'
+    }
+
+    def __init__(self, experiments=None):  # noqa: E501
+        """V1beta1WarmStartSpec - a model defined in Swagger"""  # noqa: E501
+
+        self._experiments = None
+        self.discriminator = None
+
+        if experiments is not None:
+            self.experiments = experiments
+
+    @property
+    def experiments(self):
+        """Gets the experiments of this V1beta1WarmStartSpec.  # noqa: E501
+
+        List of the previous experiments.  # noqa: E501
+
+        :return: The experiments of this V1beta1WarmStartSpec.  # noqa: E501
+        :rtype: list[V1beta1ExperimentReference]
+        """
+        return self._experiments
+
+    @experiments.setter
+    def experiments(self, experiments):
+        """Sets the experiments of this V1beta1WarmStartSpec.
+
+        List of the previous experiments.  # noqa: E501
+
+        :param experiments: The experiments of this V1beta1WarmStartSpec.  # noqa: E501
+        :type: list[V1beta1ExperimentReference]
+        """
+
+        self._experiments = experiments
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1WarmStartSpec, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1WarmStartSpec):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
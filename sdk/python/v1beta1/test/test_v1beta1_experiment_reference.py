@@ -0,0 +1,40 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+from __future__ import absolute_import
+
+import unittest
+
+import kubeflow.katib
+from kubeflow.katib.models.v1beta1_experiment_reference import V1beta1ExperimentReference  # noqa: E501
+from kubeflow.katib.rest import ApiException
+
+
+class TestV1beta1ExperimentReference(unittest.TestCase):
+    """V1beta1ExperimentReference unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testV1beta1ExperimentReference(self):
+        """Test V1beta1ExperimentReference"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = katib.models.v1beta1_experiment_reference.V1beta1ExperimentReference()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,40 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+from __future__ import absolute_import
+
+import unittest
+
+import kubeflow.katib
+from kubeflow.katib.models.v1beta1_objective import V1beta1Objective  # noqa: E501
+from kubeflow.katib.rest import ApiException
+
+
+class TestV1beta1Objective(unittest.TestCase):
+    """V1beta1Objective unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def testV1beta1Objective(self):
+        """Test V1beta1Objective"""
+        # FIXME: construct object with mandatory attributes with example values
+        # model = katib.models.v1beta1_objective.V1beta1Objective()  # noqa: E501
+        pass
+
+
+if __name__ == '__main__':
+    unittest.main()
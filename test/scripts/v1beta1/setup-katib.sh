@@ -60,6 +60,7 @@ sed -i -e "s@newName: docker.io/kubeflowkatib/cert-generator@newName: ${ECR_REGI
 # Change Katib metrics collector images.
 sed -i -e "s@docker.io/kubeflowkatib/file-metrics-collector@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/file-metrics-collector@" ${CONFIG_PATCH}
 sed -i -e "s@docker.io/kubeflowkatib/tfevent-metrics-collector@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/tfevent-metrics-collector@" ${CONFIG_PATCH}
+sed -i -e "s@docker.io/kubeflowkatib/prometheus-metrics-collector@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/prometheus-metrics-collector@" ${CONFIG_PATCH}
 
 # Change Katib Suggestion images.
 sed -i -e "s@docker.io/kubeflowkatib/suggestion-hyperopt@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/suggestion-hyperopt@" ${CONFIG_PATCH}
@@ -72,6 +73,7 @@ sed -i -e "s@docker.io/kubeflowkatib/suggestion-darts@${ECR_REGISTRY}/${REPO_NAM
 
 # Change Katib Early Stopping images.
 sed -i -e "s@docker.io/kubeflowkatib/earlystopping-medianstop@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/earlystopping-medianstop@" ${CONFIG_PATCH}
+sed -i -e "s@docker.io/kubeflowkatib/earlystopping-asha@${ECR_REGISTRY}/${REPO_NAME}/v1beta1/earlystopping-asha@" ${CONFIG_PATCH}
 
 echo "Katib images have been updated"
 cat ${KUSTOMIZE_PATH}
@@ -297,6 +297,38 @@ class TestHyperopt(unittest.TestCase):
         self.assertEqual(code, grpc.StatusCode.INVALID_ARGUMENT)
         self.assertTrue(details.startswith('failed to validate prior_weight(aaa)'))
 
+        experiment_spec[0] = api_pb2.ExperimentSpec(
+            algorithm=api_pb2.AlgorithmSpec(algorithm_name="tpe"),
+            parameter_specs=api_pb2.ExperimentSpec.ParameterSpecs(
+                parameters=[
+                    api_pb2.ParameterSpec(
+                        name="param-1",
+                        parameter_type=api_pb2.DOUBLE,
+                        feasible_space=api_pb2.FeasibleSpace(
+                            max="0.1", min="0.0001", distribution=api_pb2.LOG_UNIFORM))]
+            ))
+        _, _, code, details = call_validate()
+        self.assertEqual(code, grpc.StatusCode.INVALID_ARGUMENT)
+        self.assertEqual(details, 'distribution LOG_UNIFORM of parameter param-1 is not supported')
+
+        experiment_spec[0] = api_pb2.ExperimentSpec(
+            algorithm=api_pb2.AlgorithmSpec(algorithm_name="tpe"),
+            parameter_specs=api_pb2.ExperimentSpec.ParameterSpecs(
+                parameters=[
+                    api_pb2.ParameterSpec(
+                        name="param-1",
+                        parameter_type=api_pb2.CATEGORICAL,
+                        feasible_space=api_pb2.FeasibleSpace(list=["cat1", "cat2"])),
+                    api_pb2.ParameterSpec(
+                        name="param-2",
+                        parameter_type=api_pb2.INT,
+                        feasible_space=api_pb2.FeasibleSpace(max="5", min="1"),
+                        condition=api_pb2.ParameterCondition(parameter="param-1", values=["cat1"]))]
+            ))
+        _, _, code, details = call_validate()
+        self.assertEqual(code, grpc.StatusCode.INVALID_ARGUMENT)
+        self.assertEqual(details, 'condition of parameter param-2 is not supported')
+
 
 if __name__ == '__main__':
     unittest.main()
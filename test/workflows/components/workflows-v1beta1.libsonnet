@@ -249,6 +249,10 @@
                     name: "build-tfevent-metrics-collector",
                     template: "build-tfevent-metrics-collector",
                   },
+                  {
+                    name: "build-prometheus-metrics-collector",
+                    template: "build-prometheus-metrics-collector",
+                  },
                   {
                     name: "build-suggestion-hyperopt",
                     template: "build-suggestion-hyperopt",
@@ -281,6 +285,10 @@
                     name: "build-earlystopping-medianstop",
                     template: "build-earlystopping-medianstop",
                   },
+                  {
+                    name: "build-earlystopping-asha",
+                    template: "build-earlystopping-asha",
+                  },
                   {
                     name: "build-trial-mxnet-mnist",
                     template: "build-trial-mxnet-mnist",
@@ -454,6 +462,12 @@
               "--context=dir://" + katibDir,
               "--destination=" + registry + "/katib/v1beta1/tfevent-metrics-collector:$(PULL_BASE_SHA)",
             ]),  // build tfevent metrics collector
+            $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-prometheus-metrics-collector", kanikoExecutorImage, [
+              "/kaniko/executor",
+              "--dockerfile=" + katibDir + "/cmd/metricscollector/v1beta1/prometheus-metricscollector/Dockerfile",
+              "--context=dir://" + katibDir,
+              "--destination=" + registry + "/katib/v1beta1/prometheus-metrics-collector:$(PULL_BASE_SHA)",
+            ]),  // build prometheus metrics collector
             $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-suggestion-hyperopt", kanikoExecutorImage, [
               "/kaniko/executor",
               "--dockerfile=" + katibDir + "/cmd/suggestion/hyperopt/v1beta1/Dockerfile",
@@ -502,6 +516,12 @@
               "--context=dir://" + katibDir,
               "--destination=" + registry + "/katib/v1beta1/earlystopping-medianstop:$(PULL_BASE_SHA)",
             ]),  // build early stopping median stop
+            $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-earlystopping-asha", kanikoExecutorImage, [
+              "/kaniko/executor",
+              "--dockerfile=" + katibDir + "/cmd/earlystopping/asha/v1beta1/Dockerfile",
+              "--context=dir://" + katibDir,
+              "--destination=" + registry + "/katib/v1beta1/earlystopping-asha:$(PULL_BASE_SHA)",
+            ]),  // build early stopping asha
             $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-trial-mxnet-mnist", kanikoExecutorImage, [
               "/kaniko/executor",
               "--dockerfile=" + katibDir + "/examples/v1beta1/mxnet-mnist/Dockerfile",